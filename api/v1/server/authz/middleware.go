@@ -143,6 +143,7 @@ func (a *AuthZ) ensureVerifiedEmail(c echo.Context, r *middleware.RouteInfo) err
 }
 
 var adminAndOwnerOnly = []string{
+	"TenantUpdate",
 	"TenantInviteList",
 	"TenantInviteCreate",
 	"TenantInviteUpdate",
@@ -0,0 +1,48 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateRequestObject) (gen.TenantUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	// construct the database query
+	updateOpts := &repository.UpdateTenantOpts{}
+
+	if request.Body.WorkerSelectionStrategy != nil {
+		switch *request.Body.WorkerSelectionStrategy {
+		case gen.LEASTLOADED, gen.ROUNDROBIN, gen.RANDOM, gen.MOSTRECENTHEARTBEAT:
+		default:
+			return gen.TenantUpdate400JSONResponse(
+				apierrors.NewAPIErrors("invalid worker selection strategy"),
+			), nil
+		}
+
+		updateOpts.WorkerSelectionStrategy = repository.StringPtr(string(*request.Body.WorkerSelectionStrategy))
+	}
+
+	// update the tenant
+	tenant, err := t.config.Repository.Tenant().UpdateTenant(tenant.ID, updateOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantUpdate200JSONResponse(
+		*transformers.ToTenant(tenant),
+	), nil
+}
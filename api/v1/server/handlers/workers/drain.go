@@ -0,0 +1,20 @@
+package workers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkerService) WorkerUpdateDrain(ctx echo.Context, request gen.WorkerUpdateDrainRequestObject) (gen.WorkerUpdateDrainResponseObject, error) {
+	worker := ctx.Get("worker").(*db.WorkerModel)
+
+	resp, err := t.updateSchedulingStatus(worker, db.WorkerSchedulingStatusDraining)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkerUpdateDrain200JSONResponse(*resp), nil
+}
@@ -29,9 +29,16 @@ func (t *WorkerService) WorkerGet(ctx echo.Context, request gen.WorkerGetRequest
 		respStepRuns[i] = *genStepRun
 	}
 
+	activeStepRuns, err := t.config.Repository.Worker().CountActiveStepRuns(worker.TenantID, worker.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
 	workerResp := *transformers.ToWorker(worker)
 
 	workerResp.RecentStepRuns = &respStepRuns
+	workerResp.ActiveStepRuns = &activeStepRuns
 
 	return gen.WorkerGet200JSONResponse(workerResp), nil
 }
@@ -0,0 +1,20 @@
+package workers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkerService) WorkerUpdatePause(ctx echo.Context, request gen.WorkerUpdatePauseRequestObject) (gen.WorkerUpdatePauseResponseObject, error) {
+	worker := ctx.Get("worker").(*db.WorkerModel)
+
+	resp, err := t.updateSchedulingStatus(worker, db.WorkerSchedulingStatusPaused)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkerUpdatePause200JSONResponse(*resp), nil
+}
@@ -1,7 +1,11 @@
 package workers
 
 import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
 type WorkerService struct {
@@ -13,3 +17,26 @@ func NewWorkerService(config *server.ServerConfig) *WorkerService {
 		config: config,
 	}
 }
+
+// updateSchedulingStatus sets whether the worker is assigned new step runs, and returns the worker with the number of
+// step runs which are still active on it.
+func (t *WorkerService) updateSchedulingStatus(worker *db.WorkerModel, status db.WorkerSchedulingStatus) (*gen.Worker, error) {
+	worker, err := t.config.Repository.Worker().UpdateWorker(worker.TenantID, worker.ID, &repository.UpdateWorkerOpts{
+		SchedulingStatus: &status,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	activeStepRuns, err := t.config.Repository.Worker().CountActiveStepRuns(worker.TenantID, worker.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := transformers.ToWorker(worker)
+	resp.ActiveStepRuns = &activeStepRuns
+
+	return resp, nil
+}
@@ -0,0 +1,21 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowScheduledDelete(ctx echo.Context, request gen.WorkflowScheduledDeleteRequestObject) (gen.WorkflowScheduledDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	scheduled := ctx.Get("scheduled-workflow").(*db.WorkflowTriggerScheduledRefModel)
+
+	err := t.config.Repository.Workflow().DeleteScheduledWorkflow(tenant.ID, scheduled.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowScheduledDelete204Response{}, nil
+}
@@ -0,0 +1,44 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/workflowdiff"
+)
+
+func (t *WorkflowService) WorkflowVersionGetDiff(ctx echo.Context, request gen.WorkflowVersionGetDiffRequestObject) (gen.WorkflowVersionGetDiffResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	versions := make([]*db.WorkflowVersionModel, 2)
+
+	for i, versionId := range []string{request.Params.From.String(), request.Params.To.String()} {
+		version, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, versionId)
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+
+		if err != nil || version.WorkflowID != workflow.ID {
+			return gen.WorkflowVersionGetDiff404JSONResponse(
+				apierrors.NewAPIErrors("version not found"),
+			), nil
+		}
+
+		versions[i] = version
+	}
+
+	diff, err := workflowdiff.Compare(workflow, versions[0], versions[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowVersionGetDiff200JSONResponse(*transformers.ToWorkflowVersionDiff(diff)), nil
+}
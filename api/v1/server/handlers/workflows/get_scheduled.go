@@ -0,0 +1,21 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowScheduledGet(ctx echo.Context, request gen.WorkflowScheduledGetRequestObject) (gen.WorkflowScheduledGetResponseObject, error) {
+	scheduled := ctx.Get("scheduled-workflow").(*db.WorkflowTriggerScheduledRefModel)
+
+	resp, err := transformers.ToScheduledWorkflow(scheduled)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowScheduledGet200JSONResponse(*resp), nil
+}
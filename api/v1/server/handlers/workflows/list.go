@@ -37,6 +37,9 @@ func (t *WorkflowService) WorkflowList(ctx echo.Context, request gen.WorkflowLis
 			return nil, err
 		}
 
+		nextFireTimes := transformers.ToWorkflowNextFireTimes(listResp.Rows[i].WorkflowModel, listResp.Rows[i].Crons)
+		workflow.NextFireTimes = &nextFireTimes
+
 		rows[i] = *workflow
 	}
 
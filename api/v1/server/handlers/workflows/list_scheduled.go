@@ -0,0 +1,78 @@
+package workflows
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowScheduledList(ctx echo.Context, request gen.WorkflowScheduledListRequestObject) (gen.WorkflowScheduledListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListScheduledWorkflowsOpts{
+		Limit:     &limit,
+		Offset:    &offset,
+		Triggered: request.Params.Triggered,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	if request.Params.WorkflowId != nil {
+		workflowIdStr := request.Params.WorkflowId.String()
+		listOpts.WorkflowId = &workflowIdStr
+	}
+
+	scheduled, err := t.config.Repository.Workflow().ListScheduledWorkflows(tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ScheduledWorkflow, len(scheduled.Rows))
+
+	for i, row := range scheduled.Rows {
+		scheduledWorkflow, err := transformers.ToScheduledWorkflowFromSQLC(row)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = *scheduledWorkflow
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(scheduled.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.WorkflowScheduledList200JSONResponse(
+		gen.ScheduledWorkflowList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				CurrentPage: &currPage,
+				NextPage:    &nextPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,35 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowUpdatePause(ctx echo.Context, request gen.WorkflowUpdatePauseRequestObject) (gen.WorkflowUpdatePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	opts := &repository.PauseWorkflowOpts{}
+
+	if request.Body.QueueEvents != nil {
+		opts.QueueEvents = *request.Body.QueueEvents
+	}
+
+	workflow, err := t.config.Repository.Workflow().PauseWorkflow(tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdatePause200JSONResponse(*resp), nil
+}
@@ -0,0 +1,86 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+)
+
+func (t *WorkflowService) WorkflowCronPreview(ctx echo.Context, request gen.WorkflowCronPreviewRequestObject) (gen.WorkflowCronPreviewResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	var timezone, jitter string
+
+	if request.Body.Timezone != nil {
+		timezone = *request.Body.Timezone
+	}
+
+	if request.Body.Jitter != nil {
+		jitter = *request.Body.Jitter
+	}
+
+	// report every invalid field, so the cron can be fixed at once
+	if errs := cronutils.Validate(request.Body.Cron, timezone, jitter); len(errs) > 0 {
+		apiErrors := make([]gen.APIError, len(errs))
+
+		for i, err := range errs {
+			field := err.Field
+			apiErrors[i] = gen.APIError{
+				Description: err.Error(),
+				Field:       &field,
+			}
+		}
+
+		return gen.WorkflowCronPreview400JSONResponse(gen.APIErrors{Errors: apiErrors}), nil
+	}
+
+	count := defaults.DefaultCronNextFireTimes
+
+	if request.Body.Count != nil {
+		if *request.Body.Count < 1 || *request.Body.Count > defaults.MaxCronNextFireTimes {
+			return gen.WorkflowCronPreview400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("count must be between 1 and %d", defaults.MaxCronNextFireTimes)),
+			), nil
+		}
+
+		count = *request.Body.Count
+	}
+
+	var workflowId string
+
+	if request.Body.WorkflowId != nil {
+		workflow, err := t.config.Repository.Workflow().GetWorkflowById(request.Body.WorkflowId.String())
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+
+		if err != nil || workflow.TenantID != tenant.ID {
+			return gen.WorkflowCronPreview400JSONResponse(
+				apierrors.NewAPIErrors("workflow not found"),
+			), nil
+		}
+
+		workflowId = workflow.ID
+	}
+
+	fireTimes, err := cronutils.Preview(workflowId, request.Body.Cron, timezone, jitter, time.Now(), count)
+
+	if err != nil {
+		return gen.WorkflowCronPreview400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	return gen.WorkflowCronPreview200JSONResponse(gen.CronPreview{
+		NextFireTimes: fireTimes,
+	}), nil
+}
@@ -0,0 +1,38 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowUpdateRolloutPromote(ctx echo.Context, request gen.WorkflowUpdateRolloutPromoteRequestObject) (gen.WorkflowUpdateRolloutPromoteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	workflow, err := t.config.Repository.Workflow().PromoteWorkflowCanary(tenant.ID, workflow.ID)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNoCanaryVersion) {
+			return gen.WorkflowUpdateRolloutPromote400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateRolloutPromote200JSONResponse(*resp), nil
+}
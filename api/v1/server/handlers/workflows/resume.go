@@ -0,0 +1,50 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+)
+
+func (t *WorkflowService) WorkflowUpdateResume(ctx echo.Context, request gen.WorkflowUpdateResumeRequestObject) (gen.WorkflowUpdateResumeResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	opts := &repository.ResumeWorkflowOpts{}
+
+	if request.Body.ReplayEvents != nil {
+		opts.ReplayEvents = *request.Body.ReplayEvents
+	}
+
+	workflow, err := t.config.Repository.Workflow().ResumeWorkflow(tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// replay the events which were held back during the pause
+	err = t.config.TaskQueue.AddTask(
+		ctx.Request().Context(),
+		taskqueue.EVENT_PROCESSING_QUEUE,
+		tasktypes.WorkflowResumedToTask(workflow),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add workflow resumed task: %w", err)
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateResume200JSONResponse(*resp), nil
+}
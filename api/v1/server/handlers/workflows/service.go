@@ -2,14 +2,17 @@ package workflows
 
 import (
 	"github.com/hatchet-dev/hatchet/internal/config/server"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 )
 
 type WorkflowService struct {
 	config *server.ServerConfig
+	events *runevents.Resolver
 }
 
 func NewWorkflowService(config *server.ServerConfig) *WorkflowService {
 	return &WorkflowService{
 		config: config,
+		events: runevents.NewResolver(config.Repository, config.Logger),
 	}
 }
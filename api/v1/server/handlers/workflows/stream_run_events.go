@@ -0,0 +1,88 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
+)
+
+func (t *WorkflowService) WorkflowRunStreamEvents(ctx echo.Context, request gen.WorkflowRunStreamEventsRequestObject) (gen.WorkflowRunStreamEventsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	filter := &runevents.Filter{}
+
+	if request.Params.Workflows != nil {
+		filter.WorkflowIds = append(filter.WorkflowIds, *request.Params.Workflows...)
+	}
+
+	if request.Params.EventTypes != nil {
+		for _, eventType := range *request.Params.EventTypes {
+			filter.EventTypes = append(filter.EventTypes, runevents.EventType(eventType))
+		}
+	}
+
+	if request.Params.ResourceTypes != nil {
+		for _, resourceType := range *request.Params.ResourceTypes {
+			filter.ResourceTypes = append(filter.ResourceTypes, runevents.ResourceType(resourceType))
+		}
+	}
+
+	return &workflowRunEventsResponse{
+		ctx: ctx.Request().Context(),
+		subscribe: func(ctx context.Context, send func(e *runevents.Event) error) error {
+			return t.events.Subscribe(ctx, t.config.TaskQueue, tenant.ID, filter, send)
+		},
+	}, nil
+}
+
+// workflowRunEventsResponse writes workflow run events as server-sent events until the client disconnects. The
+// generated text/event-stream response copies a reader, so it can't flush each event.
+type workflowRunEventsResponse struct {
+	ctx       context.Context
+	subscribe func(ctx context.Context, send func(e *runevents.Event) error) error
+}
+
+func (r *workflowRunEventsResponse) VisitWorkflowRunStreamEventsResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		return fmt.Errorf("response writer does not support flushing")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := r.subscribe(r.ctx, func(e *runevents.Event) error {
+		data, err := json.Marshal(transformers.ToWorkflowRunEvent(e))
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+
+		return nil
+	})
+
+	// the client disconnecting is the expected way for the stream to end
+	if r.ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
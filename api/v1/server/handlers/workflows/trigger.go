@@ -20,6 +20,12 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 	workflow := ctx.Get("workflow").(*db.WorkflowModel)
 
+	if workflow.IsPaused {
+		return gen.WorkflowRunCreate400JSONResponse(
+			apierrors.NewAPIErrors("workflow is paused"),
+		), nil
+	}
+
 	var workflowVersionId string
 
 	if request.Params.Version != nil {
@@ -33,7 +39,8 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 			), nil
 		}
 
-		workflowVersionId = versions[0].ID
+		// the run uses the pinned or canary version of the workflow if it has one
+		workflowVersionId = repository.SelectWorkflowVersionId(workflow, versions[0].ID)
 	}
 
 	workflowVersion, err := t.config.Repository.Workflow().GetWorkflowVersionById(tenant.ID, workflowVersionId)
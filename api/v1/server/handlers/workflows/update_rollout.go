@@ -0,0 +1,64 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowUpdateRollout(ctx echo.Context, request gen.WorkflowUpdateRolloutRequestObject) (gen.WorkflowUpdateRolloutResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*db.WorkflowModel)
+
+	if (request.Body.CanaryVersionId == nil) != (request.Body.CanaryPercentage == nil) {
+		return gen.WorkflowUpdateRollout400JSONResponse(
+			apierrors.NewAPIErrors("canaryVersionId and canaryPercentage must be set together"),
+		), nil
+	}
+
+	if request.Body.CanaryPercentage != nil && (*request.Body.CanaryPercentage < 1 || *request.Body.CanaryPercentage > 99) {
+		return gen.WorkflowUpdateRollout400JSONResponse(
+			apierrors.NewAPIErrors("canaryPercentage must be between 1 and 99"),
+		), nil
+	}
+
+	opts := &repository.UpdateWorkflowRolloutOpts{
+		CanaryPercentage: request.Body.CanaryPercentage,
+	}
+
+	if request.Body.PinnedVersionId != nil {
+		pinnedVersionId := request.Body.PinnedVersionId.String()
+		opts.PinnedVersionId = &pinnedVersionId
+	}
+
+	if request.Body.CanaryVersionId != nil {
+		canaryVersionId := request.Body.CanaryVersionId.String()
+		opts.CanaryVersionId = &canaryVersionId
+	}
+
+	workflow, err := t.config.Repository.Workflow().UpdateWorkflowRollout(tenant.ID, workflow.ID, opts)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrWorkflowVersionNotInWorkflow) {
+			return gen.WorkflowUpdateRollout400JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflow(workflow, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateRollout200JSONResponse(*resp), nil
+}
@@ -0,0 +1,65 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func (t *WorkflowService) WorkflowScheduledUpdate(ctx echo.Context, request gen.WorkflowScheduledUpdateRequestObject) (gen.WorkflowScheduledUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	scheduled := ctx.Get("scheduled-workflow").(*db.WorkflowTriggerScheduledRefModel)
+
+	opts := &repository.UpdateScheduledWorkflowOpts{}
+
+	if request.Body.TriggerAt != nil {
+		// schedules are only triggered if they come due after they were created, so they can't be moved to the past
+		if !request.Body.TriggerAt.After(time.Now()) {
+			return gen.WorkflowScheduledUpdate400JSONResponse(
+				apierrors.NewAPIErrors("triggerAt must be in the future"),
+			), nil
+		}
+
+		opts.TriggerAt = request.Body.TriggerAt
+	}
+
+	if request.Body.Input != nil {
+		inputBytes, err := json.Marshal(request.Body.Input)
+
+		if err != nil {
+			return gen.WorkflowScheduledUpdate400JSONResponse(
+				apierrors.NewAPIErrors("Invalid input"),
+			), nil
+		}
+
+		opts.Input = inputBytes
+	}
+
+	scheduled, err := t.config.Repository.Workflow().UpdateScheduledWorkflow(tenant.ID, scheduled.ID, opts)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrScheduledWorkflowTriggered) {
+			return gen.WorkflowScheduledUpdate400JSONResponse(
+				apierrors.NewAPIErrors("scheduled workflow has already triggered a workflow run"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp, err := transformers.ToScheduledWorkflow(scheduled)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowScheduledUpdate200JSONResponse(*resp), nil
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -89,6 +90,21 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for WorkerSchedulingStatus.
+const (
+	ACTIVE   WorkerSchedulingStatus = "ACTIVE"
+	DRAINING WorkerSchedulingStatus = "DRAINING"
+	PAUSED   WorkerSchedulingStatus = "PAUSED"
+)
+
+// Defines values for WorkerSelectionStrategy.
+const (
+	LEASTLOADED         WorkerSelectionStrategy = "LEAST_LOADED"
+	MOSTRECENTHEARTBEAT WorkerSelectionStrategy = "MOST_RECENT_HEARTBEAT"
+	RANDOM              WorkerSelectionStrategy = "RANDOM"
+	ROUNDROBIN          WorkerSelectionStrategy = "ROUND_ROBIN"
+)
+
 // Defines values for WorkflowConcurrencyLimitStrategy.
 const (
 	CANCELINPROGRESS WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
@@ -97,13 +113,50 @@ const (
 	QUEUENEWEST      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
 )
 
+// Defines values for WorkflowRunEventResourceType.
+const (
+	STEPRUN     WorkflowRunEventResourceType = "STEP_RUN"
+	WORKFLOWRUN WorkflowRunEventResourceType = "WORKFLOW_RUN"
+)
+
+// Defines values for WorkflowRunEventType.
+const (
+	WorkflowRunEventTypeCANCELLED WorkflowRunEventType = "CANCELLED"
+	WorkflowRunEventTypeCOMPLETED WorkflowRunEventType = "COMPLETED"
+	WorkflowRunEventTypeFAILED    WorkflowRunEventType = "FAILED"
+	WorkflowRunEventTypePROGRESS  WorkflowRunEventType = "PROGRESS"
+	WorkflowRunEventTypeSTARTED   WorkflowRunEventType = "STARTED"
+	WorkflowRunEventTypeSTREAM    WorkflowRunEventType = "STREAM"
+	WorkflowRunEventTypeTIMEDOUT  WorkflowRunEventType = "TIMED_OUT"
+)
+
 // Defines values for WorkflowRunStatus.
 const (
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+)
+
+// Defines values for WorkflowVersionChangeKind.
+const (
+	ACTION       WorkflowVersionChangeKind = "ACTION"
+	CONCURRENCY  WorkflowVersionChangeKind = "CONCURRENCY"
+	CRONTRIGGER  WorkflowVersionChangeKind = "CRON_TRIGGER"
+	EVENTTRIGGER WorkflowVersionChangeKind = "EVENT_TRIGGER"
+	JOB          WorkflowVersionChangeKind = "JOB"
+	PARENT       WorkflowVersionChangeKind = "PARENT"
+	RETRIES      WorkflowVersionChangeKind = "RETRIES"
+	STEP         WorkflowVersionChangeKind = "STEP"
+	TIMEOUT      WorkflowVersionChangeKind = "TIMEOUT"
+)
+
+// Defines values for WorkflowVersionChangeType.
+const (
+	ADDED   WorkflowVersionChangeType = "ADDED"
+	CHANGED WorkflowVersionChangeType = "CHANGED"
+	REMOVED WorkflowVersionChangeType = "REMOVED"
 )
 
 // APIError defines model for APIError.
@@ -212,6 +265,30 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CronPreview defines model for CronPreview.
+type CronPreview struct {
+	// NextFireTimes The next times the cron fires.
+	NextFireTimes []time.Time `json:"nextFireTimes"`
+}
+
+// CronPreviewRequest defines model for CronPreviewRequest.
+type CronPreviewRequest struct {
+	// Count The number of fire times to return, defaults to 5.
+	Count *int `json:"count,omitempty"`
+
+	// Cron The cron expression, or one of the shorthands random_15_min, random_hourly and random_daily.
+	Cron string `json:"cron"`
+
+	// Jitter The window every fire is delayed within, such as 15m.
+	Jitter *string `json:"jitter,omitempty"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in, defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// WorkflowId The workflow the cron belongs to. Jittered and random crons fire at the start of each window unless it is set, as the delay is derived from the workflow.
+	WorkflowId *openapi_types.UUID `json:"workflowId,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// Key The key for the event.
@@ -423,6 +500,12 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PauseWorkflowRequest defines model for PauseWorkflowRequest.
+type PauseWorkflowRequest struct {
+	// QueueEvents Whether events which trigger the workflow while it is paused are replayed when it is resumed.
+	QueueEvents *bool `json:"queueEvents,omitempty"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	PullRequestBaseBranch string           `json:"pullRequestBaseBranch"`
@@ -453,6 +536,12 @@ type RerunStepRunRequest struct {
 	Input map[string]interface{} `json:"input"`
 }
 
+// ResumeWorkflowRequest defines model for ResumeWorkflowRequest.
+type ResumeWorkflowRequest struct {
+	// ReplayEvents Whether to replay the events which triggered the workflow while it was paused.
+	ReplayEvents *bool `json:"replayEvents,omitempty"`
+}
+
 // SNSIntegration defines model for SNSIntegration.
 type SNSIntegration struct {
 	// IngestUrl The URL to send SNS messages to.
@@ -466,6 +555,34 @@ type SNSIntegration struct {
 	TopicArn string `json:"topicArn"`
 }
 
+// ScheduledWorkflow defines model for ScheduledWorkflow.
+type ScheduledWorkflow struct {
+	// Input The input to the workflow run.
+	Input    *map[string]interface{} `json:"input,omitempty"`
+	Metadata APIResourceMeta         `json:"metadata"`
+
+	// TriggerAt The time the workflow is triggered.
+	TriggerAt time.Time `json:"triggerAt"`
+
+	// WorkflowId The id of the workflow.
+	WorkflowId string `json:"workflowId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowRunId The id of the workflow run triggered by the schedule, if it has fired.
+	WorkflowRunId *string `json:"workflowRunId,omitempty"`
+
+	// WorkflowVersionId The id of the workflow version which is triggered.
+	WorkflowVersionId string `json:"workflowVersionId"`
+}
+
+// ScheduledWorkflowList defines model for ScheduledWorkflowList.
+type ScheduledWorkflowList struct {
+	Pagination *PaginationResponse  `json:"pagination,omitempty"`
+	Rows       *[]ScheduledWorkflow `json:"rows,omitempty"`
+}
+
 // Step defines model for Step.
 type Step struct {
 	Action   string          `json:"action"`
@@ -484,31 +601,37 @@ type Step struct {
 
 // StepRun defines model for StepRun.
 type StepRun struct {
-	CancelledAt      *time.Time              `json:"cancelledAt,omitempty"`
-	CancelledAtEpoch *int                    `json:"cancelledAtEpoch,omitempty"`
-	CancelledError   *string                 `json:"cancelledError,omitempty"`
-	CancelledReason  *string                 `json:"cancelledReason,omitempty"`
-	Children         *[]string               `json:"children,omitempty"`
-	Error            *string                 `json:"error,omitempty"`
-	FinishedAt       *time.Time              `json:"finishedAt,omitempty"`
-	FinishedAtEpoch  *int                    `json:"finishedAtEpoch,omitempty"`
-	Input            *string                 `json:"input,omitempty"`
-	JobRun           *JobRun                 `json:"jobRun,omitempty"`
-	JobRunId         string                  `json:"jobRunId"`
-	Metadata         APIResourceMeta         `json:"metadata"`
-	Output           *string                 `json:"output,omitempty"`
-	Parents          *[]string               `json:"parents,omitempty"`
-	RequeueAfter     *time.Time              `json:"requeueAfter,omitempty"`
-	Result           *map[string]interface{} `json:"result,omitempty"`
-	StartedAt        *time.Time              `json:"startedAt,omitempty"`
-	StartedAtEpoch   *int                    `json:"startedAtEpoch,omitempty"`
-	Status           StepRunStatus           `json:"status"`
-	Step             *Step                   `json:"step,omitempty"`
-	StepId           string                  `json:"stepId"`
-	TenantId         string                  `json:"tenantId"`
-	TimeoutAt        *time.Time              `json:"timeoutAt,omitempty"`
-	TimeoutAtEpoch   *int                    `json:"timeoutAtEpoch,omitempty"`
-	WorkerId         *string                 `json:"workerId,omitempty"`
+	CancelledAt      *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledAtEpoch *int            `json:"cancelledAtEpoch,omitempty"`
+	CancelledError   *string         `json:"cancelledError,omitempty"`
+	CancelledReason  *string         `json:"cancelledReason,omitempty"`
+	Children         *[]string       `json:"children,omitempty"`
+	Error            *string         `json:"error,omitempty"`
+	FinishedAt       *time.Time      `json:"finishedAt,omitempty"`
+	FinishedAtEpoch  *int            `json:"finishedAtEpoch,omitempty"`
+	Input            *string         `json:"input,omitempty"`
+	JobRun           *JobRun         `json:"jobRun,omitempty"`
+	JobRunId         string          `json:"jobRunId"`
+	Metadata         APIResourceMeta `json:"metadata"`
+	Output           *string         `json:"output,omitempty"`
+	Parents          *[]string       `json:"parents,omitempty"`
+
+	// Progress The progress of the step run in percent, as last reported by the worker.
+	Progress *int `json:"progress,omitempty"`
+
+	// ProgressMessage The message of the last progress report.
+	ProgressMessage *string                 `json:"progressMessage,omitempty"`
+	RequeueAfter    *time.Time              `json:"requeueAfter,omitempty"`
+	Result          *map[string]interface{} `json:"result,omitempty"`
+	StartedAt       *time.Time              `json:"startedAt,omitempty"`
+	StartedAtEpoch  *int                    `json:"startedAtEpoch,omitempty"`
+	Status          StepRunStatus           `json:"status"`
+	Step            *Step                   `json:"step,omitempty"`
+	StepId          string                  `json:"stepId"`
+	TenantId        string                  `json:"tenantId"`
+	TimeoutAt       *time.Time              `json:"timeoutAt,omitempty"`
+	TimeoutAtEpoch  *int                    `json:"timeoutAtEpoch,omitempty"`
+	WorkerId        *string                 `json:"workerId,omitempty"`
 }
 
 // StepRunDiff defines model for StepRunDiff.
@@ -529,7 +652,8 @@ type Tenant struct {
 	Name string `json:"name"`
 
 	// Slug The slug of the tenant.
-	Slug string `json:"slug"`
+	Slug                    string                  `json:"slug"`
+	WorkerSelectionStrategy WorkerSelectionStrategy `json:"workerSelectionStrategy"`
 }
 
 // TenantInvite defines model for TenantInvite.
@@ -577,11 +701,37 @@ type TriggerWorkflowRunRequest struct {
 	Input map[string]interface{} `json:"input"`
 }
 
+// UpdateScheduledWorkflowRequest defines model for UpdateScheduledWorkflowRequest.
+type UpdateScheduledWorkflowRequest struct {
+	// Input The new input to the workflow run.
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// TriggerAt The new time to trigger the workflow, which must be in the future.
+	TriggerAt *time.Time `json:"triggerAt,omitempty"`
+}
+
 // UpdateTenantInviteRequest defines model for UpdateTenantInviteRequest.
 type UpdateTenantInviteRequest struct {
 	Role TenantMemberRole `json:"role"`
 }
 
+// UpdateTenantRequest defines model for UpdateTenantRequest.
+type UpdateTenantRequest struct {
+	WorkerSelectionStrategy *WorkerSelectionStrategy `json:"workerSelectionStrategy,omitempty"`
+}
+
+// UpdateWorkflowRolloutRequest defines model for UpdateWorkflowRolloutRequest.
+type UpdateWorkflowRolloutRequest struct {
+	// CanaryPercentage The percentage of runs which use the canary version. Required with canaryVersionId.
+	CanaryPercentage *int `json:"canaryPercentage,omitempty"`
+
+	// CanaryVersionId The version which is rolled out to a percentage of runs. Required with canaryPercentage.
+	CanaryVersionId *openapi_types.UUID `json:"canaryVersionId,omitempty"`
+
+	// PinnedVersionId The version runs use instead of the latest version. Runs use the latest version if unset.
+	PinnedVersionId *openapi_types.UUID `json:"pinnedVersionId,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -636,6 +786,9 @@ type Worker struct {
 	// Actions The actions this worker can perform.
 	Actions *[]string `json:"actions,omitempty"`
 
+	// ActiveStepRuns The number of step runs which are assigned to or running on this worker. A draining worker is drained once this is 0.
+	ActiveStepRuns *int `json:"activeStepRuns,omitempty"`
+
 	// LastHeartbeatAt The time this worker last sent a heartbeat.
 	LastHeartbeatAt *time.Time      `json:"lastHeartbeatAt,omitempty"`
 	Metadata        APIResourceMeta `json:"metadata"`
@@ -645,6 +798,9 @@ type Worker struct {
 
 	// RecentStepRuns The recent step runs for this worker.
 	RecentStepRuns *[]StepRun `json:"recentStepRuns,omitempty"`
+
+	// SchedulingStatus Whether the worker is assigned new step runs. A paused worker is not assigned new step runs until it is resumed, and a draining worker stops once its in-flight step runs have finished.
+	SchedulingStatus *WorkerSchedulingStatus `json:"schedulingStatus,omitempty"`
 }
 
 // WorkerList defines model for WorkerList.
@@ -653,13 +809,27 @@ type WorkerList struct {
 	Rows       *[]Worker           `json:"rows,omitempty"`
 }
 
+// WorkerSchedulingStatus Whether the worker is assigned new step runs. A paused worker is not assigned new step runs until it is resumed, and a draining worker stops once its in-flight step runs have finished.
+type WorkerSchedulingStatus string
+
+// WorkerSelectionStrategy defines model for WorkerSelectionStrategy.
+type WorkerSelectionStrategy string
+
 // Workflow defines model for Workflow.
 type Workflow struct {
-	Deployment *WorkflowDeploymentConfig `json:"deployment,omitempty"`
+	// CanaryPercentage The percentage of runs which use the canary version.
+	CanaryPercentage *int `json:"canaryPercentage,omitempty"`
+
+	// CanaryVersionId The version which is rolled out to a percentage of runs.
+	CanaryVersionId *openapi_types.UUID       `json:"canaryVersionId,omitempty"`
+	Deployment      *WorkflowDeploymentConfig `json:"deployment,omitempty"`
 
 	// Description The description of the workflow.
 	Description *string `json:"description,omitempty"`
 
+	// IsPaused Whether the workflow is paused.
+	IsPaused *bool `json:"isPaused,omitempty"`
+
 	// Jobs The jobs of the workflow.
 	Jobs     *[]Job          `json:"jobs,omitempty"`
 	LastRun  *WorkflowRun    `json:"lastRun,omitempty"`
@@ -668,6 +838,15 @@ type Workflow struct {
 	// Name The name of the workflow.
 	Name string `json:"name"`
 
+	// NextFireTimes The next times the crons of the latest workflow version fire, which is empty while the workflow is paused.
+	NextFireTimes *[]time.Time `json:"nextFireTimes,omitempty"`
+
+	// PausedAt The time the workflow was paused.
+	PausedAt *time.Time `json:"pausedAt,omitempty"`
+
+	// PinnedVersionId The version runs use instead of the latest version.
+	PinnedVersionId *openapi_types.UUID `json:"pinnedVersionId,omitempty"`
+
 	// Tags The tags of the workflow.
 	Tags     *[]WorkflowTag         `json:"tags,omitempty"`
 	Versions *[]WorkflowVersionMeta `json:"versions,omitempty"`
@@ -675,6 +854,9 @@ type Workflow struct {
 
 // WorkflowConcurrency defines model for WorkflowConcurrency.
 type WorkflowConcurrency struct {
+	// Expression An expression over the workflow input which is evaluated by the engine to get the concurrency group for the WorkflowRun.
+	Expression *string `json:"expression,omitempty"`
+
 	// GetConcurrencyGroup An action which gets the concurrency group for the WorkflowRun.
 	GetConcurrencyGroup string `json:"getConcurrencyGroup"`
 
@@ -731,6 +913,26 @@ type WorkflowRun struct {
 	WorkflowVersionId string                  `json:"workflowVersionId"`
 }
 
+// WorkflowRunEvent defines model for WorkflowRunEvent.
+type WorkflowRunEvent struct {
+	// EventId The id of the event within the workflow run, or 0 if the event is not persisted.
+	EventId   int64                `json:"eventId"`
+	EventType WorkflowRunEventType `json:"eventType"`
+
+	// Payload The event payload. For stream events, this is the base64-encoded chunk.
+	Payload       *string                      `json:"payload,omitempty"`
+	ResourceId    openapi_types.UUID           `json:"resourceId"`
+	ResourceType  WorkflowRunEventResourceType `json:"resourceType"`
+	Timestamp     *time.Time                   `json:"timestamp,omitempty"`
+	WorkflowRunId openapi_types.UUID           `json:"workflowRunId"`
+}
+
+// WorkflowRunEventResourceType defines model for WorkflowRunEventResourceType.
+type WorkflowRunEventResourceType string
+
+// WorkflowRunEventType defines model for WorkflowRunEventType.
+type WorkflowRunEventType string
+
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -745,6 +947,8 @@ type WorkflowRunStatusList = []WorkflowRunStatus
 
 // WorkflowRunTriggeredBy defines model for WorkflowRunTriggeredBy.
 type WorkflowRunTriggeredBy struct {
+	// CronFireAt The time the cron was scheduled to fire, which is earlier than the creation time for missed fires.
+	CronFireAt   *time.Time      `json:"cronFireAt,omitempty"`
 	CronParentId *string         `json:"cronParentId,omitempty"`
 	CronSchedule *string         `json:"cronSchedule,omitempty"`
 	Event        *Event          `json:"event,omitempty"`
@@ -764,8 +968,18 @@ type WorkflowTag struct {
 
 // WorkflowTriggerCronRef defines model for WorkflowTriggerCronRef.
 type WorkflowTriggerCronRef struct {
-	Cron     *string `json:"cron,omitempty"`
-	ParentId *string `json:"parent_id,omitempty"`
+	Cron  *string                 `json:"cron,omitempty"`
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// Jitter The window every fire is delayed within, by an amount which is stable for the workflow.
+	Jitter *string `json:"jitter,omitempty"`
+
+	// NextFireTimes The next times the cron fires.
+	NextFireTimes *[]time.Time `json:"nextFireTimes,omitempty"`
+	ParentId      *string      `json:"parent_id,omitempty"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in.
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // WorkflowTriggerEventRef defines model for WorkflowTriggerEventRef.
@@ -797,12 +1011,52 @@ type WorkflowVersion struct {
 	WorkflowId string    `json:"workflowId"`
 }
 
+// WorkflowVersionChange defines model for WorkflowVersionChange.
+type WorkflowVersionChange struct {
+	// From The value before the change, not set if it was added.
+	From *string `json:"from,omitempty"`
+
+	// Job The job which changed, or which the step belongs to. Not set for changes to the triggers or concurrency of the workflow.
+	Job *string `json:"job,omitempty"`
+
+	// Kind The part of the workflow which changed.
+	Kind WorkflowVersionChangeKind `json:"kind"`
+
+	// Step The step which changed, or which the parent belongs to.
+	Step *string `json:"step,omitempty"`
+
+	// To The value after the change, not set if it was removed.
+	To   *string                   `json:"to,omitempty"`
+	Type WorkflowVersionChangeType `json:"type"`
+}
+
+// WorkflowVersionChangeKind The part of the workflow which changed.
+type WorkflowVersionChangeKind string
+
+// WorkflowVersionChangeType defines model for WorkflowVersionChange.Type.
+type WorkflowVersionChangeType string
+
 // WorkflowVersionDefinition defines model for WorkflowVersionDefinition.
 type WorkflowVersionDefinition struct {
 	// RawDefinition The raw YAML definition of the workflow.
 	RawDefinition string `json:"rawDefinition"`
 }
 
+// WorkflowVersionDiff defines model for WorkflowVersionDiff.
+type WorkflowVersionDiff struct {
+	// Changes The changes, ordered from the workflow concurrency and triggers to the jobs and steps by name.
+	Changes []WorkflowVersionChange `json:"changes"`
+
+	// FromVersionId The version compared from.
+	FromVersionId openapi_types.UUID `json:"fromVersionId"`
+
+	// RawDiff A unified diff of the YAML definitions of the versions, which is empty if they are equal.
+	RawDiff string `json:"rawDiff"`
+
+	// ToVersionId The version compared to.
+	ToVersionId openapi_types.UUID `json:"toVersionId"`
+}
+
 // WorkflowVersionMeta defines model for WorkflowVersionMeta.
 type WorkflowVersionMeta struct {
 	Metadata APIResourceMeta `json:"metadata"`
@@ -883,6 +1137,33 @@ type WorkflowRunListParams struct {
 	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
 }
 
+// WorkflowRunStreamEventsParams defines parameters for WorkflowRunStreamEvents.
+type WorkflowRunStreamEventsParams struct {
+	// Workflows A list of workflow IDs to filter by
+	Workflows *[]WorkflowID `form:"workflows,omitempty" json:"workflows,omitempty"`
+
+	// EventTypes A list of event types to filter by
+	EventTypes *[]WorkflowRunEventType `form:"eventTypes,omitempty" json:"eventTypes,omitempty"`
+
+	// ResourceTypes A list of resource types to filter by
+	ResourceTypes *[]WorkflowRunEventResourceType `form:"resourceTypes,omitempty" json:"resourceTypes,omitempty"`
+}
+
+// WorkflowScheduledListParams defines parameters for WorkflowScheduledList.
+type WorkflowScheduledListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// WorkflowId The workflow id to get scheduled workflows for.
+	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
+
+	// Triggered Whether to get only the scheduled workflows which have triggered a workflow run, or only the ones which have not.
+	Triggered *bool `form:"triggered,omitempty" json:"triggered,omitempty"`
+}
+
 // WorkflowRunCreateParams defines parameters for WorkflowRunCreate.
 type WorkflowRunCreateParams struct {
 	// Version The workflow version. If not supplied, the latest version is fetched.
@@ -901,12 +1182,24 @@ type WorkflowVersionGetDefinitionParams struct {
 	Version *openapi_types.UUID `form:"version,omitempty" json:"version,omitempty"`
 }
 
+// WorkflowVersionGetDiffParams defines parameters for WorkflowVersionGetDiff.
+type WorkflowVersionGetDiffParams struct {
+	// From The version to compare from
+	From openapi_types.UUID `form:"from" json:"from"`
+
+	// To The version to compare to
+	To openapi_types.UUID `form:"to" json:"to"`
+}
+
 // StepRunUpdateCreatePrJSONRequestBody defines body for StepRunUpdateCreatePr for application/json ContentType.
 type StepRunUpdateCreatePrJSONRequestBody = CreatePullRequestFromStepRun
 
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
+// TenantUpdateJSONRequestBody defines body for TenantUpdate for application/json ContentType.
+type TenantUpdateJSONRequestBody = UpdateTenantRequest
+
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
@@ -925,6 +1218,12 @@ type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// WorkflowCronPreviewJSONRequestBody defines body for WorkflowCronPreview for application/json ContentType.
+type WorkflowCronPreviewJSONRequestBody = CronPreviewRequest
+
+// WorkflowScheduledUpdateJSONRequestBody defines body for WorkflowScheduledUpdate for application/json ContentType.
+type WorkflowScheduledUpdateJSONRequestBody = UpdateScheduledWorkflowRequest
+
 // TenantInviteAcceptJSONRequestBody defines body for TenantInviteAccept for application/json ContentType.
 type TenantInviteAcceptJSONRequestBody = AcceptInviteRequest
 
@@ -940,6 +1239,15 @@ type UserCreateJSONRequestBody = UserRegisterRequest
 // WorkflowUpdateLinkGithubJSONRequestBody defines body for WorkflowUpdateLinkGithub for application/json ContentType.
 type WorkflowUpdateLinkGithubJSONRequestBody = LinkGithubRepositoryRequest
 
+// WorkflowUpdatePauseJSONRequestBody defines body for WorkflowUpdatePause for application/json ContentType.
+type WorkflowUpdatePauseJSONRequestBody = PauseWorkflowRequest
+
+// WorkflowUpdateResumeJSONRequestBody defines body for WorkflowUpdateResume for application/json ContentType.
+type WorkflowUpdateResumeJSONRequestBody = ResumeWorkflowRequest
+
+// WorkflowUpdateRolloutJSONRequestBody defines body for WorkflowUpdateRollout for application/json ContentType.
+type WorkflowUpdateRolloutJSONRequestBody = UpdateWorkflowRolloutRequest
+
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
@@ -996,6 +1304,9 @@ type ServerInterface interface {
 	// Create tenant
 	// (POST /api/v1/tenants)
 	TenantCreate(ctx echo.Context) error
+	// Update tenant
+	// (PATCH /api/v1/tenants/{tenant})
+	TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List API Tokens
 	// (GET /api/v1/tenants/{tenant}/api-tokens)
 	ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1053,9 +1364,27 @@ type ServerInterface interface {
 	// Get workflows
 	// (GET /api/v1/tenants/{tenant}/workflows)
 	WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Preview cron
+	// (POST /api/v1/tenants/{tenant}/workflows/crons/preview)
+	WorkflowCronPreview(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get workflow runs
 	// (GET /api/v1/tenants/{tenant}/workflows/runs)
 	WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error
+	// Stream workflow run events
+	// (GET /api/v1/tenants/{tenant}/workflows/runs/events)
+	WorkflowRunStreamEvents(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunStreamEventsParams) error
+	// Get scheduled workflows
+	// (GET /api/v1/tenants/{tenant}/workflows/scheduled)
+	WorkflowScheduledList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowScheduledListParams) error
+	// Delete scheduled workflow
+	// (DELETE /api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow})
+	WorkflowScheduledDelete(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error
+	// Get scheduled workflow
+	// (GET /api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow})
+	WorkflowScheduledGet(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error
+	// Update scheduled workflow
+	// (PATCH /api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow})
+	WorkflowScheduledUpdate(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error
 	// Get current user
 	// (GET /api/v1/users/current)
 	UserGetCurrent(ctx echo.Context) error
@@ -1095,6 +1424,15 @@ type ServerInterface interface {
 	// Get worker
 	// (GET /api/v1/workers/{worker})
 	WorkerGet(ctx echo.Context, worker openapi_types.UUID) error
+	// Drain worker
+	// (POST /api/v1/workers/{worker}/drain)
+	WorkerUpdateDrain(ctx echo.Context, worker openapi_types.UUID) error
+	// Pause worker
+	// (POST /api/v1/workers/{worker}/pause)
+	WorkerUpdatePause(ctx echo.Context, worker openapi_types.UUID) error
+	// Resume worker
+	// (POST /api/v1/workers/{worker}/resume)
+	WorkerUpdateResume(ctx echo.Context, worker openapi_types.UUID) error
 	// Delete workflow
 	// (DELETE /api/v1/workflows/{workflow})
 	WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error
@@ -1104,6 +1442,21 @@ type ServerInterface interface {
 	// Link github repository
 	// (POST /api/v1/workflows/{workflow}/link-github)
 	WorkflowUpdateLinkGithub(ctx echo.Context, workflow openapi_types.UUID) error
+	// Pause workflow
+	// (POST /api/v1/workflows/{workflow}/pause)
+	WorkflowUpdatePause(ctx echo.Context, workflow openapi_types.UUID) error
+	// Resume workflow
+	// (POST /api/v1/workflows/{workflow}/resume)
+	WorkflowUpdateResume(ctx echo.Context, workflow openapi_types.UUID) error
+	// Update workflow rollout
+	// (PUT /api/v1/workflows/{workflow}/rollout)
+	WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// Promote workflow canary
+	// (POST /api/v1/workflows/{workflow}/rollout/promote)
+	WorkflowUpdateRolloutPromote(ctx echo.Context, workflow openapi_types.UUID) error
+	// Roll back workflow
+	// (POST /api/v1/workflows/{workflow}/rollout/rollback)
+	WorkflowUpdateRolloutRollback(ctx echo.Context, workflow openapi_types.UUID) error
 	// Trigger workflow run
 	// (POST /api/v1/workflows/{workflow}/trigger)
 	WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error
@@ -1113,6 +1466,9 @@ type ServerInterface interface {
 	// Get workflow version definition
 	// (GET /api/v1/workflows/{workflow}/versions/definition)
 	WorkflowVersionGetDefinition(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetDefinitionParams) error
+	// Get workflow version diff
+	// (GET /api/v1/workflows/{workflow}/versions/diff)
+	WorkflowVersionGetDiff(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetDiffParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1449,6 +1805,26 @@ func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
 	return err
 }
 
+// TenantUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantUpdate(ctx, tenant)
+	return err
+}
+
 // ApiTokenList converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
 	var err error
@@ -1952,6 +2328,26 @@ func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 	return err
 }
 
+// WorkflowCronPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronPreview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowCronPreview(ctx, tenant)
+	return err
+}
+
 // WorkflowRunList converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	var err error
@@ -2002,83 +2398,260 @@ func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	return err
 }
 
-// UserGetCurrent converts echo context to params.
-func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+// WorkflowRunStreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunStreamEvents(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserGetCurrent(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunStreamEventsParams
+	// ------------- Optional query parameter "workflows" -------------
 
-// UserUpdateGithubOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
 
-	ctx.Set(CookieAuthScopes, []string{})
+	// ------------- Optional query parameter "eventTypes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventTypes", ctx.QueryParams(), &params.EventTypes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventTypes: %s", err))
+	}
+
+	// ------------- Optional query parameter "resourceTypes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceTypes", ctx.QueryParams(), &params.ResourceTypes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceTypes: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
+	err = w.Handler.WorkflowRunStreamEvents(ctx, tenant, params)
 	return err
 }
 
-// UserUpdateGithubOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
+// WorkflowScheduledList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthStart(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowScheduledListParams
+	// ------------- Optional query parameter "offset" -------------
 
-// UserUpdateGoogleOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
-	return err
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// UserUpdateGoogleOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
-	return err
-}
+	// ------------- Optional query parameter "workflowId" -------------
 
-// UserListTenantInvites converts echo context to params.
-func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
 
-	ctx.Set(CookieAuthScopes, []string{})
+	// ------------- Optional query parameter "triggered" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "triggered", ctx.QueryParams(), &params.Triggered)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter triggered: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserListTenantInvites(ctx)
+	err = w.Handler.WorkflowScheduledList(ctx, tenant, params)
 	return err
 }
 
-// TenantInviteAccept converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+// WorkflowScheduledDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow" -------------
+	var scheduledWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow", runtime.ParamLocationPath, ctx.Param("scheduled-workflow"), &scheduledWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteAccept(ctx)
+	err = w.Handler.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflow)
 	return err
 }
 
-// TenantInviteReject converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
+// WorkflowScheduledGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow" -------------
+	var scheduledWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow", runtime.ParamLocationPath, ctx.Param("scheduled-workflow"), &scheduledWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledGet(ctx, tenant, scheduledWorkflow)
+	return err
+}
+
+// WorkflowScheduledUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow" -------------
+	var scheduledWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow", runtime.ParamLocationPath, ctx.Param("scheduled-workflow"), &scheduledWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledUpdate(ctx, tenant, scheduledWorkflow)
+	return err
+}
+
+// UserGetCurrent converts echo context to params.
+func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserGetCurrent(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthStart(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
+	return err
+}
+
+// UserListTenantInvites converts echo context to params.
+func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserListTenantInvites(ctx)
+	return err
+}
+
+// TenantInviteAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteAccept(ctx)
+	return err
+}
+
+// TenantInviteReject converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2150,6 +2723,66 @@ func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
 	return err
 }
 
+// WorkerUpdateDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerUpdateDrain(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerUpdateDrain(ctx, worker)
+	return err
+}
+
+// WorkerUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerUpdatePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerUpdatePause(ctx, worker)
+	return err
+}
+
+// WorkerUpdateResume converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerUpdateResume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerUpdateResume(ctx, worker)
+	return err
+}
+
 // WorkflowDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
 	var err error
@@ -2210,6 +2843,106 @@ func (w *ServerInterfaceWrapper) WorkflowUpdateLinkGithub(ctx echo.Context) erro
 	return err
 }
 
+// WorkflowUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdatePause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdatePause(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateResume converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateResume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateResume(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateRolloutPromote converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateRolloutPromote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateRolloutPromote(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateRolloutRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateRolloutRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateRolloutRollback(ctx, workflow)
+	return err
+}
+
 // WorkflowRunCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
 	var err error
@@ -2297,18 +3030,54 @@ func (w *ServerInterfaceWrapper) WorkflowVersionGetDefinition(ctx echo.Context)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// WorkflowVersionGetDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionGetDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionGetDiffParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionGetDiff(ctx, workflow, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
@@ -2342,6 +3111,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/step-runs/:step-run/diff", wrapper.StepRunGetDiff)
 	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs", wrapper.LogLineList)
 	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
@@ -2361,7 +3131,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run", wrapper.WorkflowRunGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/prs", wrapper.WorkflowRunListPullRequests)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows", wrapper.WorkflowList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflows/crons/preview", wrapper.WorkflowCronPreview)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs", wrapper.WorkflowRunList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs/events", wrapper.WorkflowRunStreamEvents)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled", wrapper.WorkflowScheduledList)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow", wrapper.WorkflowScheduledDelete)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow", wrapper.WorkflowScheduledGet)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow", wrapper.WorkflowScheduledUpdate)
 	router.GET(baseURL+"/api/v1/users/current", wrapper.UserGetCurrent)
 	router.GET(baseURL+"/api/v1/users/github/callback", wrapper.UserUpdateGithubOauthCallback)
 	router.GET(baseURL+"/api/v1/users/github/start", wrapper.UserUpdateGithubOauthStart)
@@ -2375,12 +3151,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
 	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
 	router.GET(baseURL+"/api/v1/workers/:worker", wrapper.WorkerGet)
+	router.POST(baseURL+"/api/v1/workers/:worker/drain", wrapper.WorkerUpdateDrain)
+	router.POST(baseURL+"/api/v1/workers/:worker/pause", wrapper.WorkerUpdatePause)
+	router.POST(baseURL+"/api/v1/workers/:worker/resume", wrapper.WorkerUpdateResume)
 	router.DELETE(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowDelete)
 	router.GET(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowGet)
 	router.POST(baseURL+"/api/v1/workflows/:workflow/link-github", wrapper.WorkflowUpdateLinkGithub)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/pause", wrapper.WorkflowUpdatePause)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/resume", wrapper.WorkflowUpdateResume)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowUpdateRollout)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/rollout/promote", wrapper.WorkflowUpdateRolloutPromote)
+	router.POST(baseURL+"/api/v1/workflows/:workflow/rollout/rollback", wrapper.WorkflowUpdateRolloutRollback)
 	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger", wrapper.WorkflowRunCreate)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/versions/definition", wrapper.WorkflowVersionGetDefinition)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/versions/diff", wrapper.WorkflowVersionGetDiff)
 
 }
 
@@ -3014,6 +3799,42 @@ func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type TenantUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantUpdateJSONRequestBody
+}
+
+type TenantUpdateResponseObject interface {
+	VisitTenantUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantUpdate200JSONResponse Tenant
+
+func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate400JSONResponse APIErrors
+
+func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate403JSONResponse APIError
+
+func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type ApiTokenListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -3712,6 +4533,42 @@ func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowCronPreviewRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WorkflowCronPreviewJSONRequestBody
+}
+
+type WorkflowCronPreviewResponseObject interface {
+	VisitWorkflowCronPreviewResponse(w http.ResponseWriter) error
+}
+
+type WorkflowCronPreview200JSONResponse CronPreview
+
+func (response WorkflowCronPreview200JSONResponse) VisitWorkflowCronPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronPreview400JSONResponse APIErrors
+
+func (response WorkflowCronPreview400JSONResponse) VisitWorkflowCronPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronPreview403JSONResponse APIErrors
+
+func (response WorkflowCronPreview403JSONResponse) VisitWorkflowCronPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params WorkflowRunListParams
@@ -3748,6 +4605,223 @@ func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowRunStreamEventsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunStreamEventsParams
+}
+
+type WorkflowRunStreamEventsResponseObject interface {
+	VisitWorkflowRunStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type WorkflowRunStreamEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response WorkflowRunStreamEvents200TexteventStreamResponse) VisitWorkflowRunStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WorkflowRunStreamEvents400JSONResponse APIErrors
+
+func (response WorkflowRunStreamEvents400JSONResponse) VisitWorkflowRunStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowRunStreamEvents403JSONResponse APIErrors
+
+func (response WorkflowRunStreamEvents403JSONResponse) VisitWorkflowRunStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowScheduledListParams
+}
+
+type WorkflowScheduledListResponseObject interface {
+	VisitWorkflowScheduledListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowScheduledList200JSONResponse ScheduledWorkflowList
+
+func (response WorkflowScheduledList200JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledList400JSONResponse APIErrors
+
+func (response WorkflowScheduledList400JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledList403JSONResponse APIErrors
+
+func (response WorkflowScheduledList403JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledDeleteRequestObject struct {
+	Tenant            openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflow openapi_types.UUID `json:"scheduled-workflow"`
+}
+
+type WorkflowScheduledDeleteResponseObject interface {
+	VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error
+}
+
+type WorkflowScheduledDelete204Response struct {
+}
+
+func (response WorkflowScheduledDelete204Response) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WorkflowScheduledDelete400JSONResponse APIErrors
+
+func (response WorkflowScheduledDelete400JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledDelete403JSONResponse APIErrors
+
+func (response WorkflowScheduledDelete403JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledDelete404JSONResponse APIErrors
+
+func (response WorkflowScheduledDelete404JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledGetRequestObject struct {
+	Tenant            openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflow openapi_types.UUID `json:"scheduled-workflow"`
+}
+
+type WorkflowScheduledGetResponseObject interface {
+	VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowScheduledGet200JSONResponse ScheduledWorkflow
+
+func (response WorkflowScheduledGet200JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledGet400JSONResponse APIErrors
+
+func (response WorkflowScheduledGet400JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledGet403JSONResponse APIErrors
+
+func (response WorkflowScheduledGet403JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledGet404JSONResponse APIErrors
+
+func (response WorkflowScheduledGet404JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledUpdateRequestObject struct {
+	Tenant            openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflow openapi_types.UUID `json:"scheduled-workflow"`
+	Body              *WorkflowScheduledUpdateJSONRequestBody
+}
+
+type WorkflowScheduledUpdateResponseObject interface {
+	VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowScheduledUpdate200JSONResponse ScheduledWorkflow
+
+func (response WorkflowScheduledUpdate200JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledUpdate400JSONResponse APIErrors
+
+func (response WorkflowScheduledUpdate400JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledUpdate403JSONResponse APIErrors
+
+func (response WorkflowScheduledUpdate403JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowScheduledUpdate404JSONResponse APIErrors
+
+func (response WorkflowScheduledUpdate404JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type UserGetCurrentRequestObject struct {
 }
 
@@ -3833,467 +4907,822 @@ func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauth
 	return nil
 }
 
-type UserUpdateGoogleOauthCallbackRequestObject struct {
+type UserUpdateGoogleOauthCallbackRequestObject struct {
+}
+
+type UserUpdateGoogleOauthCallbackResponseObject interface {
+	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGoogleOauthCallback302Response struct {
+	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
+}
+
+func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateGoogleOauthStartRequestObject struct {
+}
+
+type UserUpdateGoogleOauthStartResponseObject interface {
+	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGoogleOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGoogleOauthStart302Response struct {
+	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserListTenantInvitesRequestObject struct {
+}
+
+type UserListTenantInvitesResponseObject interface {
+	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+}
+
+type UserListTenantInvites200JSONResponse TenantInviteList
+
+func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserListTenantInvites400JSONResponse APIErrors
+
+func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserListTenantInvites403JSONResponse APIErrors
+
+func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteAcceptRequestObject struct {
+	Body *TenantInviteAcceptJSONRequestBody
+}
+
+type TenantInviteAcceptResponseObject interface {
+	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteAccept200Response struct {
+}
+
+func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type TenantInviteAccept400JSONResponse APIErrors
+
+func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteAccept403JSONResponse APIError
+
+func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteRejectRequestObject struct {
+	Body *TenantInviteRejectJSONRequestBody
+}
+
+type TenantInviteRejectResponseObject interface {
+	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+}
+
+type TenantInviteReject200Response struct {
+}
+
+func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type TenantInviteReject400JSONResponse APIErrors
+
+func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantInviteReject403JSONResponse APIError
+
+func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLoginRequestObject struct {
+	Body *UserUpdateLoginJSONRequestBody
+}
+
+type UserUpdateLoginResponseObject interface {
+	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateLogin200JSONResponse User
+
+func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin400JSONResponse APIErrors
+
+func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin401JSONResponse APIErrors
+
+func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogin405JSONResponse APIErrors
+
+func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogoutRequestObject struct {
+}
+
+type UserUpdateLogoutResponseObject interface {
+	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateLogout200JSONResponse User
+
+func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout400JSONResponse APIErrors
+
+func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout401JSONResponse APIErrors
+
+func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateLogout405JSONResponse APIErrors
+
+func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsListRequestObject struct {
+}
+
+type TenantMembershipsListResponseObject interface {
+	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+}
+
+type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+
+func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList400JSONResponse APIErrors
+
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList403JSONResponse APIErrors
+
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreateRequestObject struct {
+	Body *UserCreateJSONRequestBody
+}
+
+type UserCreateResponseObject interface {
+	VisitUserCreateResponse(w http.ResponseWriter) error
+}
+
+type UserCreate200JSONResponse User
+
+func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate400JSONResponse APIErrors
+
+func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate401JSONResponse APIErrors
+
+func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserCreate405JSONResponse APIErrors
+
+func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthCallbackResponseObject interface {
-	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
+type WorkerGetRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
 }
 
-type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
-	Location string
+type WorkerGetResponseObject interface {
+	VisitWorkerGetResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateGoogleOauthCallback302Response struct {
-	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
-}
+type WorkerGet200JSONResponse Worker
 
-func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
+func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type UserUpdateGoogleOauthStartRequestObject struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthStartResponseObject interface {
-	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
-}
+type WorkerGet400JSONResponse APIErrors
 
-type UserUpdateGoogleOauthStart302ResponseHeaders struct {
-	Location string
-}
+func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type UserUpdateGoogleOauthStart302Response struct {
-	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+type WorkerGet403JSONResponse APIErrors
+
+func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvitesRequestObject struct {
+type WorkerUpdateDrainRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
 }
 
-type UserListTenantInvitesResponseObject interface {
-	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+type WorkerUpdateDrainResponseObject interface {
+	VisitWorkerUpdateDrainResponse(w http.ResponseWriter) error
 }
 
-type UserListTenantInvites200JSONResponse TenantInviteList
+type WorkerUpdateDrain200JSONResponse Worker
 
-func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkerUpdateDrain200JSONResponse) VisitWorkerUpdateDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites400JSONResponse APIErrors
+type WorkerUpdateDrain400JSONResponse APIErrors
 
-func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkerUpdateDrain400JSONResponse) VisitWorkerUpdateDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites403JSONResponse APIErrors
+type WorkerUpdateDrain403JSONResponse APIErrors
 
-func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkerUpdateDrain403JSONResponse) VisitWorkerUpdateDrainResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAcceptRequestObject struct {
-	Body *TenantInviteAcceptJSONRequestBody
+type WorkerUpdateDrain404JSONResponse APIErrors
+
+func (response WorkerUpdateDrain404JSONResponse) VisitWorkerUpdateDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAcceptResponseObject interface {
-	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+type WorkerUpdatePauseRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
 }
 
-type TenantInviteAccept200Response struct {
+type WorkerUpdatePauseResponseObject interface {
+	VisitWorkerUpdatePauseResponse(w http.ResponseWriter) error
 }
 
-func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+type WorkerUpdatePause200JSONResponse Worker
+
+func (response WorkerUpdatePause200JSONResponse) VisitWorkerUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept400JSONResponse APIErrors
+type WorkerUpdatePause400JSONResponse APIErrors
 
-func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkerUpdatePause400JSONResponse) VisitWorkerUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept403JSONResponse APIError
+type WorkerUpdatePause403JSONResponse APIErrors
 
-func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkerUpdatePause403JSONResponse) VisitWorkerUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteRejectRequestObject struct {
-	Body *TenantInviteRejectJSONRequestBody
+type WorkerUpdatePause404JSONResponse APIErrors
+
+func (response WorkerUpdatePause404JSONResponse) VisitWorkerUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteRejectResponseObject interface {
-	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+type WorkerUpdateResumeRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
 }
 
-type TenantInviteReject200Response struct {
+type WorkerUpdateResumeResponseObject interface {
+	VisitWorkerUpdateResumeResponse(w http.ResponseWriter) error
 }
 
-func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+type WorkerUpdateResume200JSONResponse Worker
+
+func (response WorkerUpdateResume200JSONResponse) VisitWorkerUpdateResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteReject400JSONResponse APIErrors
+type WorkerUpdateResume400JSONResponse APIErrors
 
-func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response WorkerUpdateResume400JSONResponse) VisitWorkerUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteReject403JSONResponse APIError
+type WorkerUpdateResume403JSONResponse APIErrors
 
-func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response WorkerUpdateResume403JSONResponse) VisitWorkerUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLoginRequestObject struct {
-	Body *UserUpdateLoginJSONRequestBody
+type WorkerUpdateResume404JSONResponse APIErrors
+
+func (response WorkerUpdateResume404JSONResponse) VisitWorkerUpdateResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLoginResponseObject interface {
-	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+type WorkflowDeleteRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type UserUpdateLogin200JSONResponse User
+type WorkflowDeleteResponseObject interface {
+	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type WorkflowDelete204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type UserUpdateLogin400JSONResponse APIErrors
+type WorkflowDelete400JSONResponse APIErrors
 
-func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin401JSONResponse APIErrors
+type WorkflowDelete403JSONResponse APIErrors
 
-func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin405JSONResponse APIErrors
+type WorkflowDelete404JSONResponse APIErrors
 
-func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogoutRequestObject struct {
+type WorkflowGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type UserUpdateLogoutResponseObject interface {
-	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+type WorkflowGetResponseObject interface {
+	VisitWorkflowGetResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateLogout200JSONResponse User
+type WorkflowGet200JSONResponse Workflow
 
-func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout400JSONResponse APIErrors
+type WorkflowGet400JSONResponse APIErrors
 
-func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout401JSONResponse APIErrors
-
-func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UserUpdateLogout405JSONResponse APIErrors
+type WorkflowGet403JSONResponse APIErrors
 
-func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsListRequestObject struct {
+type WorkflowUpdateLinkGithubRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateLinkGithubJSONRequestBody
 }
 
-type TenantMembershipsListResponseObject interface {
-	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+type WorkflowUpdateLinkGithubResponseObject interface {
+	VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error
 }
 
-type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+type WorkflowUpdateLinkGithub200JSONResponse Workflow
 
-func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateLinkGithub200JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+type WorkflowUpdateLinkGithub400JSONResponse APIErrors
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateLinkGithub400JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList403JSONResponse APIErrors
+type WorkflowUpdateLinkGithub403JSONResponse APIErrors
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateLinkGithub403JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreateRequestObject struct {
-	Body *UserCreateJSONRequestBody
+type WorkflowUpdateLinkGithub404JSONResponse APIErrors
+
+func (response WorkflowUpdateLinkGithub404JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreateResponseObject interface {
-	VisitUserCreateResponse(w http.ResponseWriter) error
+type WorkflowUpdatePauseRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdatePauseJSONRequestBody
 }
 
-type UserCreate200JSONResponse User
+type WorkflowUpdatePauseResponseObject interface {
+	VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error
+}
 
-func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+type WorkflowUpdatePause200JSONResponse Workflow
+
+func (response WorkflowUpdatePause200JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate400JSONResponse APIErrors
+type WorkflowUpdatePause400JSONResponse APIErrors
 
-func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdatePause400JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate401JSONResponse APIErrors
+type WorkflowUpdatePause403JSONResponse APIErrors
 
-func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdatePause403JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreate405JSONResponse APIErrors
+type WorkflowUpdatePause404JSONResponse APIErrors
 
-func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdatePause404JSONResponse) VisitWorkflowUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerGetRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
+type WorkflowUpdateResumeRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateResumeJSONRequestBody
 }
 
-type WorkerGetResponseObject interface {
-	VisitWorkerGetResponse(w http.ResponseWriter) error
+type WorkflowUpdateResumeResponseObject interface {
+	VisitWorkflowUpdateResumeResponse(w http.ResponseWriter) error
 }
 
-type WorkerGet200JSONResponse Worker
+type WorkflowUpdateResume200JSONResponse Workflow
 
-func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateResume200JSONResponse) VisitWorkflowUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerGet400JSONResponse APIErrors
+type WorkflowUpdateResume400JSONResponse APIErrors
 
-func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateResume400JSONResponse) VisitWorkflowUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerGet403JSONResponse APIErrors
+type WorkflowUpdateResume403JSONResponse APIErrors
 
-func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateResume403JSONResponse) VisitWorkflowUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDeleteRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
+type WorkflowUpdateResume404JSONResponse APIErrors
+
+func (response WorkflowUpdateResume404JSONResponse) VisitWorkflowUpdateResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDeleteResponseObject interface {
-	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
+type WorkflowUpdateRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateRolloutJSONRequestBody
 }
 
-type WorkflowDelete204Response struct {
+type WorkflowUpdateRolloutResponseObject interface {
+	VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error
 }
 
-func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type WorkflowUpdateRollout200JSONResponse Workflow
+
+func (response WorkflowUpdateRollout200JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete400JSONResponse APIErrors
+type WorkflowUpdateRollout400JSONResponse APIErrors
 
-func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout400JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete403JSONResponse APIErrors
+type WorkflowUpdateRollout403JSONResponse APIErrors
 
-func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout403JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowDelete404JSONResponse APIErrors
+type WorkflowUpdateRollout404JSONResponse APIErrors
 
-func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout404JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetRequestObject struct {
+type WorkflowUpdateRolloutPromoteRequestObject struct {
 	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type WorkflowGetResponseObject interface {
-	VisitWorkflowGetResponse(w http.ResponseWriter) error
+type WorkflowUpdateRolloutPromoteResponseObject interface {
+	VisitWorkflowUpdateRolloutPromoteResponse(w http.ResponseWriter) error
 }
 
-type WorkflowGet200JSONResponse Workflow
+type WorkflowUpdateRolloutPromote200JSONResponse Workflow
 
-func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutPromote200JSONResponse) VisitWorkflowUpdateRolloutPromoteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGet400JSONResponse APIErrors
+type WorkflowUpdateRolloutPromote400JSONResponse APIErrors
 
-func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutPromote400JSONResponse) VisitWorkflowUpdateRolloutPromoteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGet403JSONResponse APIErrors
+type WorkflowUpdateRolloutPromote403JSONResponse APIErrors
 
-func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutPromote403JSONResponse) VisitWorkflowUpdateRolloutPromoteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateLinkGithubRequestObject struct {
+type WorkflowUpdateRolloutPromote404JSONResponse APIErrors
+
+func (response WorkflowUpdateRolloutPromote404JSONResponse) VisitWorkflowUpdateRolloutPromoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowUpdateRolloutRollbackRequestObject struct {
 	Workflow openapi_types.UUID `json:"workflow"`
-	Body     *WorkflowUpdateLinkGithubJSONRequestBody
 }
 
-type WorkflowUpdateLinkGithubResponseObject interface {
-	VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error
+type WorkflowUpdateRolloutRollbackResponseObject interface {
+	VisitWorkflowUpdateRolloutRollbackResponse(w http.ResponseWriter) error
 }
 
-type WorkflowUpdateLinkGithub200JSONResponse Workflow
+type WorkflowUpdateRolloutRollback200JSONResponse Workflow
 
-func (response WorkflowUpdateLinkGithub200JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutRollback200JSONResponse) VisitWorkflowUpdateRolloutRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateLinkGithub400JSONResponse APIErrors
+type WorkflowUpdateRolloutRollback400JSONResponse APIErrors
 
-func (response WorkflowUpdateLinkGithub400JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutRollback400JSONResponse) VisitWorkflowUpdateRolloutRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateLinkGithub403JSONResponse APIErrors
+type WorkflowUpdateRolloutRollback403JSONResponse APIErrors
 
-func (response WorkflowUpdateLinkGithub403JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutRollback403JSONResponse) VisitWorkflowUpdateRolloutRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowUpdateLinkGithub404JSONResponse APIErrors
+type WorkflowUpdateRolloutRollback404JSONResponse APIErrors
 
-func (response WorkflowUpdateLinkGithub404JSONResponse) VisitWorkflowUpdateLinkGithubResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRolloutRollback404JSONResponse) VisitWorkflowUpdateRolloutRollbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
@@ -4436,6 +5865,51 @@ func (response WorkflowVersionGetDefinition404JSONResponse) VisitWorkflowVersion
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowVersionGetDiffRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionGetDiffParams
+}
+
+type WorkflowVersionGetDiffResponseObject interface {
+	VisitWorkflowVersionGetDiffResponse(w http.ResponseWriter) error
+}
+
+type WorkflowVersionGetDiff200JSONResponse WorkflowVersionDiff
+
+func (response WorkflowVersionGetDiff200JSONResponse) VisitWorkflowVersionGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGetDiff400JSONResponse APIErrors
+
+func (response WorkflowVersionGetDiff400JSONResponse) VisitWorkflowVersionGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGetDiff403JSONResponse APIErrors
+
+func (response WorkflowVersionGetDiff403JSONResponse) VisitWorkflowVersionGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowVersionGetDiff404JSONResponse APIErrors
+
+func (response WorkflowVersionGetDiff404JSONResponse) VisitWorkflowVersionGetDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type StrictServerInterface interface {
 	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
 
@@ -4471,6 +5945,8 @@ type StrictServerInterface interface {
 
 	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
 
+	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
+
 	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
 
 	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
@@ -4509,8 +5985,20 @@ type StrictServerInterface interface {
 
 	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
 
+	WorkflowCronPreview(ctx echo.Context, request WorkflowCronPreviewRequestObject) (WorkflowCronPreviewResponseObject, error)
+
 	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
 
+	WorkflowRunStreamEvents(ctx echo.Context, request WorkflowRunStreamEventsRequestObject) (WorkflowRunStreamEventsResponseObject, error)
+
+	WorkflowScheduledList(ctx echo.Context, request WorkflowScheduledListRequestObject) (WorkflowScheduledListResponseObject, error)
+
+	WorkflowScheduledDelete(ctx echo.Context, request WorkflowScheduledDeleteRequestObject) (WorkflowScheduledDeleteResponseObject, error)
+
+	WorkflowScheduledGet(ctx echo.Context, request WorkflowScheduledGetRequestObject) (WorkflowScheduledGetResponseObject, error)
+
+	WorkflowScheduledUpdate(ctx echo.Context, request WorkflowScheduledUpdateRequestObject) (WorkflowScheduledUpdateResponseObject, error)
+
 	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
 
 	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
@@ -4537,17 +6025,35 @@ type StrictServerInterface interface {
 
 	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
 
+	WorkerUpdateDrain(ctx echo.Context, request WorkerUpdateDrainRequestObject) (WorkerUpdateDrainResponseObject, error)
+
+	WorkerUpdatePause(ctx echo.Context, request WorkerUpdatePauseRequestObject) (WorkerUpdatePauseResponseObject, error)
+
+	WorkerUpdateResume(ctx echo.Context, request WorkerUpdateResumeRequestObject) (WorkerUpdateResumeResponseObject, error)
+
 	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
 
 	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
 
 	WorkflowUpdateLinkGithub(ctx echo.Context, request WorkflowUpdateLinkGithubRequestObject) (WorkflowUpdateLinkGithubResponseObject, error)
 
+	WorkflowUpdatePause(ctx echo.Context, request WorkflowUpdatePauseRequestObject) (WorkflowUpdatePauseResponseObject, error)
+
+	WorkflowUpdateResume(ctx echo.Context, request WorkflowUpdateResumeRequestObject) (WorkflowUpdateResumeResponseObject, error)
+
+	WorkflowUpdateRollout(ctx echo.Context, request WorkflowUpdateRolloutRequestObject) (WorkflowUpdateRolloutResponseObject, error)
+
+	WorkflowUpdateRolloutPromote(ctx echo.Context, request WorkflowUpdateRolloutPromoteRequestObject) (WorkflowUpdateRolloutPromoteResponseObject, error)
+
+	WorkflowUpdateRolloutRollback(ctx echo.Context, request WorkflowUpdateRolloutRollbackRequestObject) (WorkflowUpdateRolloutRollbackResponseObject, error)
+
 	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
 
 	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
 
 	WorkflowVersionGetDefinition(ctx echo.Context, request WorkflowVersionGetDefinitionRequestObject) (WorkflowVersionGetDefinitionResponseObject, error)
+
+	WorkflowVersionGetDiff(ctx echo.Context, request WorkflowVersionGetDiffRequestObject) (WorkflowVersionGetDiffResponseObject, error)
 }
 type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
 type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
@@ -4988,6 +6494,37 @@ func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
 	return nil
 }
 
+// TenantUpdate operation middleware
+func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
+		return validResponse.VisitTenantUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ApiTokenList operation middleware
 func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request ApiTokenListRequestObject
@@ -5332,202 +6869,369 @@ func (sh *strictHandler) StepRunGet(ctx echo.Context, tenant openapi_types.UUID,
 		return sh.ssi.StepRunGet(ctx, request.(StepRunGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGet")
+		handler = middleware(handler, "StepRunGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
+		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StepRunUpdateRerun operation middleware
+func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateRerunRequestObject
+
+	request.Tenant = tenant
+	request.StepRun = stepRun
+
+	var body StepRunUpdateRerunJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunUpdateRerun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
+		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StepRunGetSchema operation middleware
+func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunGetSchemaRequestObject
+
+	request.Tenant = tenant
+	request.StepRun = stepRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunGetSchema")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
+		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkerList operation middleware
+func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkerListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerList(ctx, request.(WorkerListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkerList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerListResponseObject); ok {
+		return validResponse.VisitWorkerListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowRunGet operation middleware
+func (sh *strictHandler) WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunGet(ctx, request.(WorkflowRunGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunGetResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowRunListPullRequests operation middleware
+func (sh *strictHandler) WorkflowRunListPullRequests(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListPullRequestsParams) error {
+	var request WorkflowRunListPullRequestsRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunListPullRequests(ctx, request.(WorkflowRunListPullRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunListPullRequests")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunListPullRequestsResponseObject); ok {
+		return validResponse.VisitWorkflowRunListPullRequestsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowList operation middleware
+func (sh *strictHandler) WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowList(ctx, request.(WorkflowListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
-		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowListResponseObject); ok {
+		return validResponse.VisitWorkflowListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunUpdateRerun operation middleware
-func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunUpdateRerunRequestObject
+// WorkflowCronPreview operation middleware
+func (sh *strictHandler) WorkflowCronPreview(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkflowCronPreviewRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
 
-	var body StepRunUpdateRerunJSONRequestBody
+	var body WorkflowCronPreviewJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+		return sh.ssi.WorkflowCronPreview(ctx, request.(WorkflowCronPreviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunUpdateRerun")
+		handler = middleware(handler, "WorkflowCronPreview")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
-		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowCronPreviewResponseObject); ok {
+		return validResponse.VisitWorkflowCronPreviewResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunGetSchema operation middleware
-func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunGetSchemaRequestObject
+// WorkflowRunList operation middleware
+func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
+	var request WorkflowRunListRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+		return sh.ssi.WorkflowRunList(ctx, request.(WorkflowRunListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGetSchema")
+		handler = middleware(handler, "WorkflowRunList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
-		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunListResponseObject); ok {
+		return validResponse.VisitWorkflowRunListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkerList operation middleware
-func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request WorkerListRequestObject
+// WorkflowRunStreamEvents operation middleware
+func (sh *strictHandler) WorkflowRunStreamEvents(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunStreamEventsParams) error {
+	var request WorkflowRunStreamEventsRequestObject
 
 	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkerList(ctx, request.(WorkerListRequestObject))
+		return sh.ssi.WorkflowRunStreamEvents(ctx, request.(WorkflowRunStreamEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkerList")
+		handler = middleware(handler, "WorkflowRunStreamEvents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkerListResponseObject); ok {
-		return validResponse.VisitWorkerListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowRunStreamEventsResponseObject); ok {
+		return validResponse.VisitWorkflowRunStreamEventsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunGet operation middleware
-func (sh *strictHandler) WorkflowRunGet(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
-	var request WorkflowRunGetRequestObject
+// WorkflowScheduledList operation middleware
+func (sh *strictHandler) WorkflowScheduledList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowScheduledListParams) error {
+	var request WorkflowScheduledListRequestObject
 
 	request.Tenant = tenant
-	request.WorkflowRun = workflowRun
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunGet(ctx, request.(WorkflowRunGetRequestObject))
+		return sh.ssi.WorkflowScheduledList(ctx, request.(WorkflowScheduledListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunGet")
+		handler = middleware(handler, "WorkflowScheduledList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunGetResponseObject); ok {
-		return validResponse.VisitWorkflowRunGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowScheduledListResponseObject); ok {
+		return validResponse.VisitWorkflowScheduledListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunListPullRequests operation middleware
-func (sh *strictHandler) WorkflowRunListPullRequests(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListPullRequestsParams) error {
-	var request WorkflowRunListPullRequestsRequestObject
+// WorkflowScheduledDelete operation middleware
+func (sh *strictHandler) WorkflowScheduledDelete(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error {
+	var request WorkflowScheduledDeleteRequestObject
 
 	request.Tenant = tenant
-	request.WorkflowRun = workflowRun
-	request.Params = params
+	request.ScheduledWorkflow = scheduledWorkflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunListPullRequests(ctx, request.(WorkflowRunListPullRequestsRequestObject))
+		return sh.ssi.WorkflowScheduledDelete(ctx, request.(WorkflowScheduledDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunListPullRequests")
+		handler = middleware(handler, "WorkflowScheduledDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunListPullRequestsResponseObject); ok {
-		return validResponse.VisitWorkflowRunListPullRequestsResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowScheduledDeleteResponseObject); ok {
+		return validResponse.VisitWorkflowScheduledDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowList operation middleware
-func (sh *strictHandler) WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request WorkflowListRequestObject
+// WorkflowScheduledGet operation middleware
+func (sh *strictHandler) WorkflowScheduledGet(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error {
+	var request WorkflowScheduledGetRequestObject
 
 	request.Tenant = tenant
+	request.ScheduledWorkflow = scheduledWorkflow
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowList(ctx, request.(WorkflowListRequestObject))
+		return sh.ssi.WorkflowScheduledGet(ctx, request.(WorkflowScheduledGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowList")
+		handler = middleware(handler, "WorkflowScheduledGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowListResponseObject); ok {
-		return validResponse.VisitWorkflowListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowScheduledGetResponseObject); ok {
+		return validResponse.VisitWorkflowScheduledGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// WorkflowRunList operation middleware
-func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
-	var request WorkflowRunListRequestObject
+// WorkflowScheduledUpdate operation middleware
+func (sh *strictHandler) WorkflowScheduledUpdate(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflow openapi_types.UUID) error {
+	var request WorkflowScheduledUpdateRequestObject
 
 	request.Tenant = tenant
-	request.Params = params
+	request.ScheduledWorkflow = scheduledWorkflow
+
+	var body WorkflowScheduledUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.WorkflowRunList(ctx, request.(WorkflowRunListRequestObject))
+		return sh.ssi.WorkflowScheduledUpdate(ctx, request.(WorkflowScheduledUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "WorkflowRunList")
+		handler = middleware(handler, "WorkflowScheduledUpdate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(WorkflowRunListResponseObject); ok {
-		return validResponse.VisitWorkflowRunListResponse(ctx.Response())
+	} else if validResponse, ok := response.(WorkflowScheduledUpdateResponseObject); ok {
+		return validResponse.VisitWorkflowScheduledUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -5859,6 +7563,81 @@ func (sh *strictHandler) WorkerGet(ctx echo.Context, worker openapi_types.UUID)
 	return nil
 }
 
+// WorkerUpdateDrain operation middleware
+func (sh *strictHandler) WorkerUpdateDrain(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerUpdateDrainRequestObject
+
+	request.Worker = worker
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerUpdateDrain(ctx, request.(WorkerUpdateDrainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkerUpdateDrain")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerUpdateDrainResponseObject); ok {
+		return validResponse.VisitWorkerUpdateDrainResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkerUpdatePause operation middleware
+func (sh *strictHandler) WorkerUpdatePause(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerUpdatePauseRequestObject
+
+	request.Worker = worker
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerUpdatePause(ctx, request.(WorkerUpdatePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkerUpdatePause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerUpdatePauseResponseObject); ok {
+		return validResponse.VisitWorkerUpdatePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkerUpdateResume operation middleware
+func (sh *strictHandler) WorkerUpdateResume(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerUpdateResumeRequestObject
+
+	request.Worker = worker
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerUpdateResume(ctx, request.(WorkerUpdateResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkerUpdateResume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerUpdateResumeResponseObject); ok {
+		return validResponse.VisitWorkerUpdateResumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowDelete operation middleware
 func (sh *strictHandler) WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error {
 	var request WorkflowDeleteRequestObject
@@ -5940,6 +7719,149 @@ func (sh *strictHandler) WorkflowUpdateLinkGithub(ctx echo.Context, workflow ope
 	return nil
 }
 
+// WorkflowUpdatePause operation middleware
+func (sh *strictHandler) WorkflowUpdatePause(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdatePauseRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdatePauseJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdatePause(ctx, request.(WorkflowUpdatePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdatePause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdatePauseResponseObject); ok {
+		return validResponse.VisitWorkflowUpdatePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowUpdateResume operation middleware
+func (sh *strictHandler) WorkflowUpdateResume(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateResumeRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdateResumeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdateResume(ctx, request.(WorkflowUpdateResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdateResume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdateResumeResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateResumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowUpdateRollout operation middleware
+func (sh *strictHandler) WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateRolloutRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdateRolloutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdateRollout(ctx, request.(WorkflowUpdateRolloutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdateRollout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdateRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateRolloutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowUpdateRolloutPromote operation middleware
+func (sh *strictHandler) WorkflowUpdateRolloutPromote(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateRolloutPromoteRequestObject
+
+	request.Workflow = workflow
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdateRolloutPromote(ctx, request.(WorkflowUpdateRolloutPromoteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdateRolloutPromote")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdateRolloutPromoteResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateRolloutPromoteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowUpdateRolloutRollback operation middleware
+func (sh *strictHandler) WorkflowUpdateRolloutRollback(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateRolloutRollbackRequestObject
+
+	request.Workflow = workflow
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdateRolloutRollback(ctx, request.(WorkflowUpdateRolloutRollbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdateRolloutRollback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdateRolloutRollbackResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateRolloutRollbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunCreate operation middleware
 func (sh *strictHandler) WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error {
 	var request WorkflowRunCreateRequestObject
@@ -6024,128 +7946,202 @@ func (sh *strictHandler) WorkflowVersionGetDefinition(ctx echo.Context, workflow
 	return nil
 }
 
+// WorkflowVersionGetDiff operation middleware
+func (sh *strictHandler) WorkflowVersionGetDiff(ctx echo.Context, workflow openapi_types.UUID, params WorkflowVersionGetDiffParams) error {
+	var request WorkflowVersionGetDiffRequestObject
+
+	request.Workflow = workflow
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowVersionGetDiff(ctx, request.(WorkflowVersionGetDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowVersionGetDiff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowVersionGetDiffResponseObject); ok {
+		return validResponse.VisitWorkflowVersionGetDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9WXPbOLbwX2Hx+x5mquQ1SU9fV82DE7vTnnHkXMnu1L1dLhdMQhLaFMEGQDuelP77",
-	"LWwkSAIkKEuK3NGTF2I5ODsOzgG+hRGeZziFKaPhybeQRjM4B+LX088X54Rgwn/PCM4gYQiKLxGOIf8Z",
-	"QxoRlDGE0/AkBEGUU4bnwa+ARTPIAsh7B6LxIIRfwTxLYHhy9PbwcBBOMJkDFp6EOUrZT2/DQcieMxie",
-	"hChlcApJuBhUh2/OZvwdTDAJ2AxROac5XXhaNnyECqY5pBRMYTkrZQSlUzEpjuhdgtIH25T8/wHDAZvB",
-	"IMZRPocpAxYABgGaBIgF8CuijFbAmSI2y+/3Izw/mEk87cXwUf9ug2iCYBI3oeEwiE8BmwFmTB4gGgBK",
-	"cYQAg3HwhNhMwAOyLEERuE8q5AhTMLcgYjEICfwzRwTG4cnvlalvi8b4/g8YMQ6j5hXaZBZY/B8xOBe/",
-	"/H8CJ+FJ+P8OSt47UIx3UHDdopgGEAKeGyCpcR3QfIIMNGEBOZt5AMA7n/Kmi4V79FM1VnUGMYr8tUku",
-	"mmcZJpwofFAa4EnAIYIpQ5FgI5Mwv4f3gKIoHIRTjKcJ5CstMNhgkgaqXGBfcPkiQAtVjVYpZw8Lsz3N",
-	"IJtBxeKoHILzmuoU4FTIBUopA2lk8NQ9xgkEKQdCMJsVN/wLR4gcooSxKTudzKo4Wi/GwSEjSHFOImjn",
-	"lIhALj2nzA4tQ3NoyB1RYwVPgAaqawXy48Pj472j472jN9fHhyeHP528/Xn/559//t/Q0IQxYHCPD2xT",
-	"AsihAVAskWYAMQhQGtzcXJwFamgTkPv746O3Px/+Y+/47U9w7+0b8G4PHL+L994e/eOno/gomkz+C5pA",
-	"5TniK5mDr5cwnXKOf/PTIJyj1PyzAW2exctiLwGUBar/KlFY4xGxqpLIJsgOfrnGD9AmMl8zRCC1LfXL",
-	"DEqROP18ETDePVCt973pPocMxEByaIfWqjC0U9aua7JWwLZfJfPxu3ddOCxgGxQiVyDDisQoghm7SB8R",
-	"gyP4Zw4pa+ITic8Ssz2Ztg+TDsKvexhkaI+7J1OY7sGvjIA9BqYCikeQIE6X8KRY8UCIwqLBSBJe23o/",
-	"CPbSrONcsZ1Op5JK0q94EZnE+D7w0QynFDYBZJrzm5xUAasdDDmKG47PeZIoHP1C8HzMYDbKLQJ3T0Aa",
-	"zYYKae1zGm1vi4nGw7FhBJ1kYThD0SlxLXwO/oPTQMtcwOcI/nY6Gv5dC9Z4OA7EGE3ULMF8c5T+82gw",
-	"B1//efzupyYXFsC68XsNU5B2SR+cA5TYVyw+6cXllDsDOJDcv5IVyqnFwnACu/SdXM0nOL+HZMTbN9xD",
-	"MZwarAsrPWWzrkOZGGQVWBDLoEk+tU/Kv6x+0oHafAg5WTi8KQGUDY/njzC1YO4BPtvX8ACfC60Ged/9",
-	"Fds9iRg/BirbX8R2cC/Oqgivb63Uxsu5kCdMHiYJfhrl6TifzwF57oJMIPRLs1uL+eXINhZyq8lyBmy+",
-	"rcZrc7H8S5U4wd/+Nb4aBvfPDNK/dyt5MXQx/b9fxgN6jEtkE80MTFFa7GPaEPq5aFnYOKFlnvx3pcVy",
-	"mlstDei2QNkC4hWJIXn/fIYIjDRIMM3nnHKA8q0mJ5Uh5TVaqP6/6ICE7lv60c6uYwhINLNuXV383sDl",
-	"BCDr5lSo45xbAi6qslVA8rTqZrvjTBlMYw5Lx8CqWZ+RSZ6mHiOrZn1GpnkUQRh3o6No6D8655ePkCkP",
-	"7AxNJm7fMEaTiT+DGkN2xnfkyFyXfBTb/tMsu0gpA0niCF6AKMJ5yu7AI2CA3OUksbKbbpbaPchBiIxZ",
-	"7ihkDKVT6hxuaUPl1uZuAGrQD2xrttloicH3wht2edQtCKF3MZyAPGHG5yKoY3W5NXxGVzdcI5jhJlQE",
-	"ZtgNk/iKn1JIuncBRtuBMawNoH/hewuPt8Whhdk0ItHKWfgD3++vaT/fGJMymPWTwabwVd2gZngRzSHO",
-	"mX356mPX0h8hoQin1hncwlCAZQ5QBBzk0h2UtG4fI5BGMEl0cMovClN0Kg5E3E1GEFDJKJZIfororN/U",
-	"f0iObKMoZ1rZ0kG9FzAdgbQq9yWGKQOE9VsMZYDl1GM93A2QbRV/j/K0t5lZgsujB0jaRaDPcg3fvwtk",
-	"w/+p9VxeXqqDaAYpqOCWmnFBJu3hfT4fnl0MP4aDcHQzHMrfxjcfPpyfn52fhYPwl9OLS/HLh9Phh/NL",
-	"/rvNFbxE6UOp8ylimDw7995TxHir0mo1NQ8pRgmk3bEqHjXQ0LmXN4bheqVtkCttclpHEcbGOoxp211b",
-	"Tgs4veLvjQhlZcoqPmoLG9SwbuMRvtGxHyb5HvDVu1rkVE0iIpPU7X5udHtVHAJYd1gcYqunui3g293o",
-	"LjfcAFHN5+IJ08mElUX3AE/xnYMjDN2x5PjC13SMbkSg22hmtPKe3Bi6G+PmBLcKtmrQmn5nVqpCsyoe",
-	"wtNLlMJeZ7FcXYrP3PXmtlg7oQmeBglKYZ+TNpkTYp2DD6cadLr1rt6yhdFdL72GLfNUskxUKWa4LVF1",
-	"CR9hYprps/P3N9w0Xwx/uQoH4ZfT0TAchOej0dXIbo+NcYqojhcHVCCwyZP6/v2DYpqt7EpbfnxBYKw6",
-	"Qs/QmOrcEhyzIMA8Gv0WRjkhMGV3meDd40GYwq/6rzeDMM3n4g8anhwdLgZ1yap0th3VqxZBJrmwmPjY",
-	"K0plwGLN+YBfmyO/8Ru5XJc1wwAzkJixO95UhJwTRJk8Jikz0g59gmIWjWVq9TY78R5QWLqxDRobLX+F",
-	"IPZreXFmtDCDmWWToVh+ZzPu7cMeBky2r45xjVjiDtRIZ3bYFsuRTa78Azpmh8YsdUxZYLVhykWKgYOY",
-	"FjTeVtmiwK3WBziD3AhGCaaV1KQSGyPI2evHyZIYwSwBz+IQwH0szb9exFWlv+mkpvZsRA3hrVgS4Zto",
-	"EYRoIWGW2wIrDczxZnzUmtNlGXAKKbshjvP7m9FlwHBAYRqL3ATlWtCA4fWcwLq2t3mK/sxhgGKYMjRB",
-	"kBRHgeqEVWWGyRQKM9nwHiY4nWqI6+RsEmx9GRx+AZjWrAwRh7UcYmjiNiOMM5TEBFZ32h1cuqaoYAaI",
-	"Thj3h4RAEIP7BLrDHvJ7kdMIA8pgZmXOlQWrHTO4yWusokJrHVxTBJS+z0XsJP0agtOn7DzDFc/BMPgr",
-	"CmEvx4TQOecyIfGyT8t66+q1ElH3CMiq84Oi/eqFCOfMBeKS8vVnDnN4OmHSh/JD5soD/LJLC2X8DgGU",
-	"jFRPAXzPtnhbl3Lw0Bx9Vlx0aVnxEyaucwUvO1JwYLGy1iC+ecruystqMjKOuTG24wUTxLehSfcCZCZS",
-	"0d4Y97aErO18Qf12dzoeX3wcfjofXoeDUP4hDhhedP5wXaSGVZGy9ixrV7Lei7P9unOynYl7ZkLo+jJB",
-	"F0VWuNseG7UAcpiN5skvl27a5eUqXxbF9oRB/dmNNdnCfWilRqhkiS/BJZU82ZJWZjZhB+9sQYyvwsp1",
-	"08g3sVO8JwU1HPFxxf7UpOlKVcLLGMo/cZWLXlfrGwqJ7PE5v09Q1MYKYryWjGkT5q0huqLfMkQfKTpp",
-	"I3T1ZXg+4tbm7NPFMByEn84/vT+3B86vCZpOITFO7Ve3z78RlUhe6foryZR30vuG2gSj0zCAOCaQUtNA",
-	"VPS41jhNO8E//AZJ4Yc0yqlUDaKyOjNAg0fVnP8XkSoE+9YSxLXY+hjRLAHPFZuvF95bFVfx4KLMJZ6i",
-	"dPlCjuWo9KK6jgxQ+oSJw2Dqr+3oWwKAYtqFq0akaOHC9QhOEWX85ytCt59n6uDSLaSWrjH0JZqp+OgM",
-	"ZfS12qyGDd+gTl6HypOT2cj2RWyUXVFRxw5CfZR+tdxqBxFIgwwSvj4Oj38QJQHi5IewewjYKWvds5TT",
-	"iaJlClMWgGCme++vtgJ87XtUuZZ9e5AogqkuCKCu8C1vIwKqotSgvBKjHPhl2ZsdW103Q22B4CvOtiYh",
-	"aC/SlnmeJfh5Drt3BXqMs6LHB5xO0LTzHhVH/rrOHd135CQ7mIB/sQ3hhSOVx2wTyf4ZtBsRFyeGtIWz",
-	"6A4wXR5Deo3XwKq8VIJ8P67k4/0mOxbnd6sQOz7uB5zKBJLIUs41hcz4/pHgPLOUwadKvwdPMxTNgilk",
-	"VOAuKrsGU963CIIYjGClTYLmiI0ZAQxOHfWJVH0NGOY2LXjSNzmYs4pxAnF/BYhmMBb6Xm0lZRjy7mJ4",
-	"93l09XF0Ph6Hg/BsdPX5bnj+5Xx8HQ7C/745vzkv//w4urr5fDe6uhme3Y2u3l8MrfvOOfjq1sBz8BXN",
-	"87mR9lKAywpes5aBvTm2Z7xU6K6mriNwYCVkG1c0dNSPkfo9dZWxLZW0ax3NFYwsT1TleMFplgVmXrjX",
-	"efoaSt16pKK7l3xr8JZMi6pfpVEw/8WZlTS6t91ReNFB+YZ9DOFHeN0H9aVanFIv6xTOvTNla7UHukWI",
-	"DMQx4igAyWcDHEZyaFmAPBXzR095olu3my8g8NqKoMyC6OIMtP3wUsYkYfz+ucfg10Yvo/BIeQQ9HQjL",
-	"CC8vX/rNKPtTuKsu9radu7fE+zd8017CubZirMYcGlF9l2TwZ02wHHxmyfDH6WeR8+Dgbd5gzJ2s3JFm",
-	"Ch89NknF/QgqW289aVE9Ob7o1MbG3OG3XMOZYLKaHd2Ltzz2WJ2EsHVhki0+EC5dEztntKTI3CEHsrsm",
-	"VPmmE0eu6Z0rTeKF01L7CvtrkhrebDlXj40Uoh4DF/hZrbmU6t2OvlLj36mNbH80G2arLiuVnagPJszN",
-	"qxH0eEko4yXZYiSupXW59m2FcexLc2rEEOzKQH30UilPRlTL12vVfXqqUA2zxlJloNtudjmD3Gu1Z1UT",
-	"8FT9bNn9gafgf04/XQZx0bC/xqzO4wG0/d7SDXHYD8Al3FOHUU4Qex6Xl/reQ0Ag0Xf/ytt+wxP173KB",
-	"M8ZEImKE8QOCujniGJL/0tGzk7Bx8zPIkLhpaiF2RhNsR7K+ZPv08wXvKutvwup/CyqFR/uH+4eCyBlM",
-	"QYbCk/DN/tH+ofA/2Ews7QBk6CBBj1AF55rzftTBN94qhZQGhT/OebAIQYSX6vtHsS6i3GYxy/HhYXPg",
-	"XyFI2EyoyHe270PMijkrlAlPfr8dhFTfGMUhLBvqMOzvavxoBqOH8Jb3F2slEMTP3YvlzVDbake6wSqX",
-	"K4ALGA6AuDU1YARMJip/p231BbSdy3884j/2xL2c9OBb8ftCaBVMLTgZwUf8AAOQGlfaTjAJgMo8a6Dm",
-	"NEOiZF7mtMju0ucFc8iEifq99V5RcR1SeCK4tJSZAtbQlHYZJ5Aao6LHlqn3Wdw2KPm2iZBxHkWQ0kme",
-	"JM8BEcuLZSaevijgrSRwhFOmdijqHnY+wsEfKs++BNrnbnR1LlyPcs1BwpcM4wCT4B7EASkrzt8evtkM",
-	"GL9gco/iGMqboUreVKzDCXutKKfZs/zf7SD8uqevgxbfCr4qSV7hYOnlHnwTPxcH2vS5JFre3K9vOQRp",
-	"eftglW+L2xOlSHfyq7wrUXCZhV3l5nSTrLo6nivvkbQQu8b+jCD4qARAYkTQYycFFQ1tYKaUARmaaOF/",
-	"yUMV3pfx8D2QZQdmLJ86BeASUeY6AWiateLogXe7qDVdG795XKPSjxGri9wmXjzaDBg3KcjZDBP0HxjL",
-	"id9tZuJPkM1wHKSYBSBJ8JO+3rj0Xr5VHOTfbxcVd6aLXbXsyCZ+snHwbTrbM/+zOBCHd94yUxz1Idgh",
-	"MuKaGh/jYYLjtCE1sF+pNXFd4tNPpCs02En065XomjDVBbphDetC8CKRF//nv+2JM/tF+TcXucXBvbrJ",
-	"yls1FB1a1cL7stVr0wwDn9wHJ5AlqltB7DupvmnWPadq4T/lZjRg46a0fkqw4LadAny9CtBQGatQfgdP",
-	"8H6G8YM7gmPMPU3wPUgC3cWutGTg5qNo+qVo2R3iqjBuRjD/A8bFZDue3SaerQYRJYcAG4d0e9yaAw++",
-	"qV8WXryoill9eFFWQpS82GlE1aBO+/lksPVGPeqdxPzlJKbBx20SM4ftwUpaXBpZ5Dbr8x3jkcWqpHxS",
-	"PdxHEatCn0rt7OOyFIdx28LMHWcpZl6aouOn8hrOGiUPUO1+VveeASRJUGntoqKMvFUartUxtd3N3IvC",
-	"CV8enlRXt03UrnpiNSK0E5nyrSRN6UJSNYHMkjJ1Jv5fv7msQeBxSmVLHwNWvwbNZcioYpBtOQ+TOIob",
-	"yNiZsu9vygo5cDKsFobxcNx2LsGZrikm8vNCn8u5fUA+rz4ea4iIdPh8RKS4bcUuGQW0G42MyIMeeSPS",
-	"UqeC9Xc+DSCOdl7mzsv08jIpg9keyYXxUr8uDuS11HsZcUumfB8zAEGWJ4mmjMr2KLK2GkIra3el4Kp3",
-	"XYmPAOuyYbdxU7Cv28LJO36xTAxaCRO0Pm9r4QsZ+M1yFjCsHhOvUaGBg8Ua/cK+4Fc0jLr+XPiGlRX8",
-	"2DkBfNa3m5l1iLnQ5mnd7ivxrrGVViRFumWb5dcS2a1uYnUVYXtaDppMlH4ptME9ZE9Q1d7OMWX6lgH+",
-	"DaSSryaIUPGffZc6+giZuAzxNemhNUmz413Hfru8WL3fuJPg7ynBXG5iydZrEtsET9sjGbR4Z4XWJLcp",
-	"i+aLIK9EEActT6syHNAHlGnY/swheS6Bw5MJFRE4CyjuFybap5P3Ddw/O6YUn18642kRwUngI0won3eC",
-	"EgZJy8SiZWVm35dj5HMpjpVT8SpKIGYz4Jhg4gBEdugLiHp8xQLEF3ErKQ5EuYB7/dh8+qXn5JVnYxx4",
-	"kNPHxds0rVCcGc2WgaTsv+ZjcEMbdBkfzpJmVindZZTW4piFFjZswSWe9jcD8jPt2hXSAAQpfHJl/csj",
-	"Otk0XOemSk5UPKxm30vpVyX0Zmqjuyd9W2uPfRIrLnj9C/N4HxZXW5WC2TSHK9w2mNzG0WVIsqx5aT+k",
-	"KUpQqF+Ji69j871DlbfrP0OqvdrZb3OhY0s7LV/X8kWdDO1XPHO7GHTE+HrXcxW6/XWw+7rsj+Z1wwKt",
-	"PxRXTrqTr1XJlxKEJavT2g1OeRFDyz4aJIlybisC6KhMey225kfeQD/AZ6/tM29XmdXrggnBBqJMvHmd",
-	"kBsm4x40L9hKXdEbQONCtuVAJHmqCq6hF6y6rffG137/0XcKRgh6fp9QhJh6CwIRJhybCkOU2nQXhHip",
-	"e6rQ4l3T6mM1D4R29DSdUuV6mM9/w+fdbq20IUvxv0D2TgZsMhAok75KOSDildy2mzn4dxqAwpDKjg4J",
-	"0PdxiEF/3F2c5e1hRxBRX/AgXBGi8ba5OKK/oZLA7UyV8x4S8WrFao2VfMaNtifzl6JZefyNOsLnxuNq",
-	"P7idauCjX8Cjhu1dXL1isRq82BVd940qVs+I1AStvL4LKhqHWtWX39qPttSjmX1OuI7WIp1LnHNpxtiJ",
-	"pfW4q5Qbf7n0sFT6H3vyb4+SFlpsqnxE2b+4ZStDlFW5aodtr0DHa7etndKrC3q2V3ptpS0FfVypEFU6",
-	"CrsGmO3dEblr6icJr7yGZQslYfV21/3iqq/dzTWVN51Z4im5Er5XI7mSIP0lt83yzeVDkD33aLqXXcSN",
-	"t5B3e7QqPpbao2ls75xB2x6t5MXV+IK0KwWqVhRKbTWaO+aXaU/j4bhSqe/P/w0s74owt6g+2iUIXuXR",
-	"nZlXHvcE7KIiAgFV+WpNuFodz1Yn9Y5u7C482GKBdkqep0S3WlRLFVVr3aNZ6vgsJddVwfhqt5B/9ZJK",
-	"31roqsersbKro9xUHWWFF58AFTrCVVhZkMfQC/xfnNDLVtW064kDAol6itNxwi9eVQcmaC2XL4jmO52x",
-	"jTkHJE8VqTrCTMUtEPLSa9tyF1uh2HYZB60ZBzKVdeMKpVxT670LslmtfrvFERnLYXeq5fu5I/WnypZx",
-	"PBTdd/7HVvsfmkpr0RpPmDzIJ//cG5QkCWSzjjLIL6LRLhoo6wqWCoLvKrMcLyYpBqxddALJstt0jWhl",
-	"Mc0/u7brlfKUToFQ5SWvefdeWbDzCm0Dg69Yaosn2pcS291u3i65BW763VNU4anl5fkgIx63MZvXoNHa",
-	"JYdWd9hgFz6IcT0e3Yn6ugCs3ERJmTwWcdUCQu/CM4N4Y9Fx/aeFJr8sWcetExUqrLvTP7WTuyp21q6B",
-	"qJczLVr6eQ87h7o0zTuXevWGuZ9MeArBAbfD3pIQiLi2ry+9u/pgW68+MMvk+JxTyArS7jsmFu0v4nBT",
-	"no0/ZLrLSoHb0AbmBYpS4GWnLN27mBcozJxCQg+inBC1FHceKieJahjwbg2NeEMh+QjZBzXYGvmKz9ST",
-	"mQTEu6SX1/MsIWfyGrtpHhfkt7Cxeu4tAklyD6IHJzt/wPNM1g9xzrji8wfWpwP4ROq1QTH0FcflBz18",
-	"jcHfHB53vG0RqXnj5rwzCGKVCZ5gSQzr8Uqhthe9kKlXXJ3UE5+UAeLWDWP+dTlMiq790Sjg+Q5IFOD2",
-	"xCDG0wSuhyPF0FvMkatgQIm+FTNgibitY8CX8ltX0X95O021xrp4zK/TwPMRzDKftb7/1rvK3rgR5ocq",
-	"sfdxH3u9B9xZgu/kvQMQRTBj7qSxU/G9X8Wi7LOme5vl4I0iu0Xvt6vkynel5K2bF4ntzlJyN38RKDJM",
-	"WpIS+fd+/CX7hOvKruODr4C/5Mp3/NWR2saRtAR/JXiKWnJdL/GUBigNgLCN+y0OxqUYaE1lwdwE8/E3",
-	"dL2v1047wdMpjAO0qyrZ4rf6BNf47qQTPMU56xAGnDM/aeBDbQmPclB2TPp6okCSe3zZVlUjz1DWYwtk",
-	"dPLbBpl15aKbOv9ZK4PbJ+2/HzJRtNsTLbMnMjHYzZIETjkNSJu/KlvQVmW61pdr+AQajG1yLDTydjH8",
-	"V+FiaBbqVtcqe1bmxUHik+FqUcQy49Yzk1WO0ZpDJqZ4vendSxyvbpk8bU1ed4+07oFmnQaDy7yTIvXT",
-	"40I7M8PTK/nE/047I92gPYlyoyLwtiPiYd7uVgC4qw76zq+8KmY1OGaZFEZxE4lPWYOXJPSwAtsnBqvP",
-	"uFky1WZnDexZNsuzeIdNOEhQ+rAnD9pbwi0ofQhAIJsFBGaYIoblqy/ABNIuGyoQg9IHefj+qgRl9bud",
-	"EhGjApO+Ve+JgxIbLYL3FnIOrZLwJsQ7M/qdzaiQahsnrUnVMIKm07ZIxLVsoC5JX6qs0P9msG1QMO2J",
-	"uY+QUITT/eBiIrbANOf8AeOBrHUBDFKmGwWIBhPIohmMXdm7qmW49fpRsYFB1T53gtRK1DavFX2qJc07",
-	"33e1ktukFLUO6qjS7LptoIdaVHJJfaustcR7qcTfZONXtDv5K+jENWsYRdRlyxn0one65jvrmkodRcmK",
-	"a3K/tJ45iOEEpUgnh/ZROWXPvtrnrJxzp4f+YnrIoO3LNJLBXzvltI3KySTQ8nqqfvB9DwGBpDj4HliP",
-	"wiF51PoiJ0l4EoaL28X/BQAA//+cwM5yqhMBAA==",
+	"H4sIAAAAAAAC/+x9a3PbuJLoX2Hx3g+7VbKd5+ycVO0HxVYyPid+XMme1NZclwsWWxLGFKEBQDs+Kf/3",
+	"LbxIkAT4kCVHnvBTHBGPRqO70d3obnwPp2S5IgkknIUfvodsuoAlkn8Oz49HlBIq/l5RsgLKMcgvUxKB",
+	"+DcCNqV4xTFJwg8hCqYp42QZ/Ib4dAE8ANE7kI0HIXxDy1UM4YfX7169GoQzQpeIhx/CFCf8l3fhIOQP",
+	"Kwg/hDjhMAcaPg6Kw1dns/4fzAgN+AIzNac9XTjMG96BhmkJjKE55LMyTnEyl5OSKbuOcXLrmlL8HnAS",
+	"8AUEEZmmS0g4cgAwCPAswDyAb5hxVgBnjvkivdmfkuXBQuFpL4I787cLohmGOKpCI2CQnwK+QNyaPMAs",
+	"QIyRKUYcouAe84WEB61WMZ6im7iwHWGClg5EPA5CCn+lmEIUfvijMPVV1pjc/AlTLmA0tMKqxALZ75jD",
+	"Uv7xfynMwg/h/znIae9AE96BGSl8zKZBlKKHCkh6XA80J8BRFRaU8kULAETnoWj6+OgffajHKs4gR1F/",
+	"VreLpasVoWJTxKAsILNAQAQJx1NJRvbG/BHeIIan4SCcEzKPQaw0w2CFSCqo8oF9LPiLIsNUpb1KBHk4",
+	"iO1+AXwBmsRxPoSgNd0pIInkC5wwjpKpRVM3hMSAEgGEJDYnbsQXgRA1RA5jlXcaiVVTtFmMh0LGwEhK",
+	"p+CmlCkFwT1D7oaW4yVYfEf1WME9YoHuWoD8zas3b/Zev9l7/TZ4/f7Dq18+vPt1/9dff337/te9V+8/",
+	"vHoVWhIxQhz2xAQuYYA9kgBHCnkWMIMAJ8Hl5fFRoIe2Abq5efP63a+v/mvvzbtfYO/dW/R+D715H+29",
+	"e/1fv7yOXk9ns3+ADVSaYrGiJfr2BZK5oPy3vwzCJU7s/1agTVfRuliMEeOB7r8NVJZoRq4u33QbdA/9",
+	"XJBbcLHQtxWmwFxL/roAxSLD8+OAi+6Bbr3fev+XwFGEOGohxQoE7uW9ixLvZbDtF7f7zfv3TTjMYBtk",
+	"LJghw4nE6RRW/Di5wxzG8FcKjFfxieVnhdmOxNuFWAfhtz2CVnhPqCtzSPbgG6doj6O5hOIOxVjsS/gh",
+	"W/FAssRjhZAUvK71HkryMqTjXbF7n4Zql5Se8aRtkuO3gY+tSMKgCiA3lF+lpAJY9WCoUfxwnKdxrHH0",
+	"iZLlhMNqnDoY7oaiZLo41Uirn9Nqe5VNNDmdWIeid1s4WeHpkPoWvkT/JklgeC4QcwT/MRyf/qdhrMnp",
+	"JJBj7IcbIL4lTv779WCJvv33m/e/VKkwA9aP3wtIUNLEfbBEOHavWH4yi0sZUKEYK+rfyArV1HJhJIYm",
+	"eadWcwLLG6Bj0b6METWcHqwJKx15syxDuRxkE1iQy2BxOndPKr5sftKBNkYknzx6tCsJlBuPJDmncIfh",
+	"3oE/+MY/YQoX2KkkS0TCNy41AyaXNaXCujKHZKYAtzstaw2IIiwNS/FSxJSkCfesJBXUKPZHwG/WRAIK",
+	"PKXJIIhghtKYy9/eazmOl+ky/PBamMhLnOj/uYxjgRf3tBJj8G1FgTFMkkFAaECSjDjZglC+QEnEAoqS",
+	"iCyvX7+/XuJkYP67ICmNHwKUROaXCOH4Yd+F4D8x50DdcNzjJCL3AdwBfVAYwCyIIEYP2ioVc7J0uggQ",
+	"C16/XzonEEj7N0k8THc8PB0qLVK0CXh19WJOuENxKm1hXML65cWhc9Z7Qm9nMbk/jjxL09/zGW8gJslc",
+	"DLof/FMiBSILhbIRU1iQ6i4EjCPKxaYAmi4MstIkBsaE6wCzgAEfCNyI1hJvCoEU30EUzChZBtyCZf+p",
+	"6nqJPSSBubhidAeJgxFu4cGNrFt4yNQWEH33N6zYKsnX7oTI2/v29vioKFHLvhTtafEuxOzHOE0m6XKJ",
+	"6EMTZBKhX6vdavRrgWxrIVdmW46Qy5g1eK0uVnwpbk7wH/+cnJ0GNw8c2H82a3Fy6Gz6fz2NBswYX7BL",
+	"0q7QHCeZ46IOoedZy0yJFWCT+/ZuqGw51VPEALorUNaAeEYjoB8fjjCFqQEJEnGm/BEiNg2VjzW88u2F",
+	"7v/JeCBN39xQ9nadAKLThdNX5aP3Ci5nCDu9UaXDVbYKaJoU7Wi/Y3kFSSRgaRhYN+syMk2TpMXIulmX",
+	"kVk6nQJEzejIGrYfXdDLZ+DaxDrCs5nf+IvwbNaeQK0hG/UxNbKQJZ+ln2+4Wh0njKM49ngr0VQqX9fo",
+	"DnFEr1MaO8nNNEvcJuIgxNYs1ww4x8mceYdb+6DyS3M/ACXoB641u85ohcGP0tz1mcw1CGHXWkuyPmde",
+	"XKdNbeCzuvrhGsOKVKGisCJ+mORXcp8AdXwugWS1HVjDugD6J7lx0HjdxZM8NvNfjLLwJ7nZ35LDrjIm",
+	"47DqxoNV5iuqQU69m6Qew0Z/bFr6HVChgh9HzTtmMUMGlj1A5lFUS/fspNM/NEXJFOLYeKHbGY5Zp+wG",
+	"1N9kDIiRxNlmhhPMFt2m/pPcNO2oIFrV0rN7TyA6CqzI9zmGpcXSbTGMI56yFusRaoBqq+l7nCadj5k1",
+	"qHx6C7SeBbos19L9m0C29J9Sz/X5pTiIIZBsF/xcM8m2yWh456PTo+PTz+EgHF+enqq/JpeHh6PR0ego",
+	"HISfhsdf5B+Hw9PD0Rfxt0sV/IKT21zmM8wJffC6UuaYi1b5qVWVPDQbJVDnjlPw6IFOvc46axghV+oG",
+	"OTNHTu0o8rBxDmOf7cdR40AGnKdY7qUpi/goLWxQwrqLRoSh4749bnujX+7q4FM9ibx6YH7181nNKwOP",
+	"28ISEDs11V0B3wlcoxpugajn89GErWRCYdEdwFPdfRRhyY41xxd9faNbV0x1e2a1aj25NXQzxu0JrjRs",
+	"xVsp9oNJqQjNpmiIzL/gBDoFXygHN8ixhfcqc2vHZC7Cs6DLVboKAnPOIYbTDRrVel9v1WI/rCy94mnN",
+	"ww7yyLRshqscVV/gDmL7mD4afbwUR/Px6aezcBB+HY5Pw0E4Go/Pxu7z2Bon8+q0ooACBC5+0t9/vFPM",
+	"kJVbaKuPT3CMFUfo6BrTnWucYw4E2LEP38NpSikk/HolaffNQN5j6f+9HYRJupT/YeIW6XFQ2ohiZ1dM",
+	"jm4RrBQVZhO/aeWlsmBxDS4+V0Z+227kfF2ukTnhKLZ9d6KpdDnHmHF1D5qHoL5qMaUrhu4cpQwyzd2n",
+	"x/6VQgrSycmcQUAykk56wVlwv8DTRcApns+BFu5zxKcY9FXQSkwcBYhKZVFfoS0g0Z+F0baEaN8Rc+dc",
+	"hnU41R13HxGDXBuvkKrV8jdAUbuWx0dWC9snmzc5lbvY2EwYLdDhHFbti2NcYB77/U1KJz9Fy6YmZ+39",
+	"UnaHyixlTDlgdWHKtxUDz2Y60HhVJIsMt0askRUk4SCcxoQVQipzbIxBkNfPE801lnwo2dy7XMnkx1Hx",
+	"7HruIMz6KGoD4ZVcEk0T7Uup2cJV6vIPVTAnmqlRhXBqlJo0x2eN2JThE6JhfpVYEqIQecToPTJytK2c",
+	"LGm9DlTMgfFL6omQuhx/EfAySCIZ/aV1OxkksJUrcJ9/IU3wXykEOIKE4xkGmt3Fqn4mBlcFqdnh3VZY",
+	"g4MQq6S2vRi5dh6w2ri3yXQBURpDZGixhrhRFGEBOorPrQacpjBwrEx2M4khGeXRNHEo/0/bZ0XjwxrH",
+	"fBEGzHK+aG8aNcW/mGjzYvSJd5jTVlFzrYbK/KNtgBI7YImFGyU0mCYDk6SzQCowJ6qd+Hf7MqPV5Pr2",
+	"Qkun8ka0pm5rL0oYdQFnk0grHtgBg63Kl60SWuS9luNS2EBdvbFZ4DiiUPRcNhyXW7plWSFqzrn2kFBA",
+	"kUhp8buR1XeLGBmHlZOsN3b555nBT8/WKgqi21xW6A1UtuSxO33HGwz+tMu+IR+tSMGEsQMuN3MluB4R",
+	"gnfOda4Y8z416y3reYUbyhYXXPo+Nmu/eSYiKfeBuBZ/rSiZU2CeuGTz1SZ8ecDgJFgBnUKiojVl4hIF",
+	"neinzxwhqAsXRLZJqwc+qfNHat3RTC4nySBSszn5XDAhpDCc6TDddgSy8Utgyhuord1Fseb74k1x2/gH",
+	"0dYn8FpIwy4rzrrUrFiRxPqXvRlXZSurvei1I7F8sbtV5iSRsBfceCEUi6M/bl6AilbN2lvjXuWQ1d1B",
+	"67+uh5PJ8efTk9HpRTgI1X9GR0+9o77IwoeLSNl6qp0vY+PJKR8ZdU0gVv7tCaeIw/yhTVSCq1tzqp+E",
+	"2D+viyTtDKTtpR49ZmmItTaTSUJVwzxrYuZ6+U1NRr/6KnRBZwC7+ezHmmrht930CIW0RC9F+umnkJiV",
+	"75Ud3d5AOztgwhRIuaxaCG/knOwpoRCOxbjS0Wjv6UbFz9MIqn0ihWC9ptaXDKjqcZ7exHhaRwpyvJoU",
+	"PRvmndl0vX/rbPpY75M58M6+no7G4mQ7OjkW97gno5OPI/dF7oWy8q0oss05bC9l6nvFKm+eoJvTLIH7",
+	"jo6zBu+XGFBJc+K8URtoV8wyZTy4AaG4i8+zlKe0ddyAyxOhENYqoXYjuaxeBrEB8YKwBdXAA0hGOSSO",
+	"SeqHaIoSRB/OlRHlNYFW2XedQmEc/ynTqX9yGON32w/GGmXq1FNfM09ZId/yH/9oTLcs9nZDWPH4URLL",
+	"yiiKxpFjCW4oc1TsP/3+Z4WTBKKWoEu0CoTihHFAUW5wcmDcwq1pV/0o3KppwoBvIiOxSlnMdVg2Koso",
+	"imzjXZw0BfCMFlLBnvzwO9DMDvLcS+lBpTf5TjcXv2JahGDfWRdnK7ZGhJm8KbNtDrPwzupZEQ9Xnp35",
+	"QuY4Wb+awHq79KTiAivE2D2hHrYwX+vRtwYA2bSPvkIFWQsfrscwx4wDfVHobmcZe6h0B3dLW7+tN80+",
+	"29kCr9hL1WMrev0zyuRtiDw1mWvblOLju2nyeBX0R2VrK41LnO3BCqhYX6GWRaNjWgx2BxMrK6Y2AVQ7",
+	"po16hCgIFwCeJ+JEIgGhWf4pSWwA94NhEFGE5ScNM2bqJ1nfbQqqOWbBK7cvW/ilfwNE+Q0g3nBLnSNG",
+	"9AoYJDxAwcL03t9OAbWte/Uqrv58bgpTkRldu4+qjbWJWWXJfOCn5kTpG3CczCet3O5a9S/3anIK+plp",
+	"B4Se5mpnkLBnvbXKX84vGa8JezTbR8FcOoozb5oQ7mkepAnHcTG8cyALiqAKjzJOVkyxJ+bCg7k3i/F8",
+	"YRPRAt1BYK79JGdpl8Pw8OL495FwsQ8vJ9JZfjQeHktnusvt8NVvO5oRv4yGk4vrL2dD5YQfn12eHl2P",
+	"zz5Kj8Z4eHp0diJcG2eTi+vx6HB0enH922g4vvg4Gl54p3QH62zHbAyf1fx7unkXwSomD0todtwZTB5l",
+	"PQ5JMsPzxlq7npTn2oAdzGSwdNTMNSZOyR+bJy+7PPJSfHEB1EoI6ETasnwU51H3FM5nOVm8+F6vshYr",
+	"2feV4KUZpjDIKRqWK/6gQyr9+/ekKl2DUA3UPsatGNjZbs5tOEWezsvGBnGsGs3XJ3NDqBfIiW8Nf7ez",
+	"0wo7M1RcG2jcVjkQ4x6SRKWhTB+cBVZ1bS9HkcxC6S9yV5Ey0t2cU3NWGEyHaUAyx4n0H89BBcROc1CC",
+	"OSXpKrvqsrjfnT8M3FrHZ9HXCbCyFDRQc+DsifPGeIm5fTA77pD1V7FQQdb3pjCtPascR2kfaFrSGtSF",
+	"+vXx6fX5+OzzeDSZSMXh7Pz6dPR1NBG38//vcnQ5yv/7eXx2eX5tKwOus36Jvvk1Y+2ttSydDFxeuDuo",
+	"FL15+8ad31OgTz11GYHujayj3srx+nMkus99RXvWSlF2jtYcfKvGC4arVWBnwbcKXt9CYZ8Oiff+JV9Z",
+	"tHV8VMXAMCf+4yPn1tTH+j4pjPWZLbb20cFfi6U4ykWspJvIm9m12XDLVvejlQWo+K726MnjLcvn+xM2",
+	"eGslX+zyb1k0X30Ynome//jQYfALq1c1mr+jouPNB3hKsZZ8oAx3xcVe1VO3pxynTuxqEpiyma7GWrmE",
+	"l6VjX4n7vLyp9lqsBNSMl1Ruf/qu7Hwhf269d6OsjxQ1DzFBnvUo0HST/eAToULHAbRUX9gg81uKhdwg",
+	"Br+824NEXABEwXSRJrcef53iBoXGxgPENF9nmWO7r44gZRwtV2sVH2oFcIlKi90HGQGV1lXAir2vbch0",
+	"XMKQ0ScnF6Pz6/Gl8BB9PRv/69OXs6/yv3X+oAKBFMYaji9U2OfZyfmX0YUvFnQQXhyfjI6uzy6Fgmop",
+	"spOL8Wh40jT5jvgwLQdEp0NxayWfKnMYRHVdknUulA40j3x31BEhifCJNLoSREPpRjC5YfKipOz7QDTG",
+	"0qJE2loydUjkOMI+W2ImHLyzbqGjYvZzmbDgOfpEAxOO5WwAdy3cf1mxWOto2HxOU8cDMetUJz6E36K6",
+	"uSQmdDO+yie739yXwgrC2oUp6hV15scwcxOwp2TpWirlU6u13zwEKAnQkqSJ5UZhXGadGQfFFtyUG3oA",
+	"wJDbNfZnemyy2Px+u/DBEjXok3Lm0emufckadWtrMS1zk1/306hE1I5dgLtKclaHgTP8bNbUUaq5jzQM",
+	"UV9rZ2l3NFsmR1mQFbydbTBhO0it25Gn3Hk8AXOERqXkMp/PLTNsuu45s/zU9b76LsnkXTwOxZT41ueb",
+	"gdlgqTDQVTO5HC5QMndkx8woWXoQgeIUghuYEaqFlBxiIM02BlxnvQttB0WRJ+tdF6F1Xrdpwa+GjaSN",
+	"qH7JsiLtxzBO9azidFBdmIn3NrQgRrA9z2228BYn3iAwystDFEG2Pdn/PPsoNf7ReTiQl+Fnp/IyfKxy",
+	"zISFoOyD8ehifDwS5sHod3FpfTE+/vxZRusfjs9O7f+enR5ejsej08P/cerHJmXR5ZaHVS1ylYQvFeWo",
+	"TMBJHWGgGde3In66oLAkdx7K4CVza3ik7/lHJ2e/K/Pgt+HpZ6dxUGITruxJuZUtWOEIhPPNXYmFovvi",
+	"5+ryKboP/md48iWIsobdNbviPG2AduZbakZwA6o/ir2PZN2KylMvBW4RMSEZJ2nOkpfi4oMgKSbUNuP9",
+	"X+d+T8sgx6EhIGt5hyqmQWY1G7gqFTuhcVt2h6eJir4WDwqYLS7tfHaXqsFjlTtu5fR6kNFz8FeKYg+v",
+	"dV0+J09efIkmi5tQhGmQkVqOsRZU634T9ZlUhJ/gmBfHAExTivnDJH8w+AYQBWreFZbQiU7q53yBC85l",
+	"PvuUkFsMpjkWGFI/mSv2D2HlVWm0wvJRm0dpQ848J4V5wHt4fiy6qhp5YfHXbJfC1/uv9l/JTV5BglY4",
+	"/BC+3X+9/0pa93whl3aAVvggxnfy6JiDwx/z2dx8i1YJMGlWKme4oMHs/i/8or9/luui2ncmZ3nz6lV1",
+	"4N8AxXwhxdV713ehoJg5CzsTfvjjahAy8ziNgDBvaGI1/tDjTxcwvQ2vRH+5VgooemherGiG61Y7Ng02",
+	"uVwJnAxJky+wBpyi2QxPG1efQdu4/LvX4p89+cYnO/ie/f0opQphDpyM4Y7cgvAv5M/jCqUR6aTiCmqG",
+	"Kyyrc6tcM9VdeZTQEri0Mf6ofaM0HCiuEVSa80wGa2hzu/KoKInh8q13E+BXlZ18V0XIRDzkw9gsjeOH",
+	"gMrlqdJ23NQkf6c2eEoSrv1/+o13McLBn7oETQ50m3fXdXh/+UxdolgsGSKhkd6gKKB5cet3r94+Dxif",
+	"CL3BUQSqXF9Om5p0xMZe6J0z5Jn/diUyGczdhfyW0VW+5QUKVm6Kg+/y38cDc/T5OFruTfagGkryh86K",
+	"dJs91KZYupFe9b1b5CZX+fVZSXVzNJdhwrXZJfLnFMOdZgCFEbkfPRcUJLSFmZwHJJrr6B9UA5v2VTDK",
+	"HlqtDuxAGuZlAHHL4wu/qR5rWdyP6HZcaro1emvxYkM3Qiwucpdo8fXzgHGZoJQvCMX/hkhN/P55Jj4B",
+	"viCRSqCIY3IPUVl7+V5QkP+4eiyoM03kanhHNWnHGwff54s9+5fHAxk515pnsjg7DA0sI1/EaHN42OB4",
+	"z5AS2C/0NPG9F9KNpQt70HP0y+XoEjOVGbpyGpaZ4EksL38Xf+3JgNnH/P+C5R4PbvSjOa1FQ9ahVix8",
+	"zFu9NMkwaBN47AUyR3UtiF0nNY9a+ufULdpP+TwSsPIoUzchmFFbLwBfrgC0RMYmhN/BPdwsCLn1e3Cs",
+	"uecxuUFxYLq4hZZy3HyWTb9mLZtdXAXCXVEi/iOCVPQQPc3uEs0WnYiKQpCLQpo1bkOBB9/1H4+taFHX",
+	"KWxDi6qgRU6LjYeoHtR7ft5bZP2sGnXPMX87jqnQcR3HLKHeWcmy9+myuD1zvyMPgmQKFU450T38VxGb",
+	"Qp/Oq+qispjl7AwxN9yl2Ekheh9P8hf/Sjt5gEtPQfptBhTHQaG1bxeV563QcKuKqesZ2E47HIvlkVlx",
+	"dbu020VNrLQJ9ZvMhCnJEvaodjUG7ghCPZK/l9/oqWzwJGGqZZsDrDSY9yBjCXvWQ6zpPkzhKKogoz/K",
+	"fvxRlvGBl2ANM0xOJ3X3EoLoqmyiPj+aezm/DijmNddjFRZRCl8bFskKabs5I4P2WT0jcl2BKqy/1q2g",
+	"BcOb9+8LQLzutcxey2ylZTIOqz2aysNL//l4oF7A3VtRP2ceyiYBCsQ7lGZndLRHFrVVYVpV0Ewxrhrh",
+	"nLZh4PylFt/hpmHf9gmn3uEk0cPGiECjIX+48xMly6zyW5UuCo/VTV27UMHB4xb1wq7gFySMAl/phoUV",
+	"/NwxAWLWd88zq4glm5E0KZ/7mr1LZGUESRZuWXfyG45sFjeRjgKuD8sRocBKvmTS4Ab4PejCN0siH22S",
+	"pRfFNxlUvQCRd8a4qUfvFEefgcuo2pckh7bEzZ+BW68MrXn1ILez5+AfzMGCbyJF1lti25jM6z0ZLIjJ",
+	"PIhxAqzEuVVe1M/qi24vhREHNUV8OQnYLV4Z2P5KgT7kwJHZjAEPnaD4H7Ovn04V+7p58EwpPz91xmHm",
+	"wYnhDmKmUuxjDrRmYtkyHLSkdUMHotcnDHHkWzkDRKeLQM5mwTEj1AOI6tAVkInq5QDiq3xwiqgcH//6",
+	"5eePD2otHSc/s/t68KCmjzAF8+pmDRRHVrN1IMn7b/ka3JIGTYdPLIvYWI+J9xGlJT9mJoWts+ALmXc/",
+	"BtRn1mQVsgCpl3zcUf/qik41DbdpVBVf0fHYUub9dGNMPav1ZB7i6mAnaaT+vWm8C4lrUyUjNkPhGrcV",
+	"IndRdOaSlKQtbtGqtK28FsyfzaImfDleyS05NFwvWNXzXp6jKMuZGgTuHBsqyHo2dLKh2vb2bGjou5Yd",
+	"rRS0+jvTLCOMtcs4a2tn7ASPbvdKV+Jj3TBD4+rtla6y0pWlrbFuuWyiVmy9y71zemWmav2sR5JCgKF1",
+	"61Davmc8n7Tnr03xl2aENZNF6w+cvLBVjVtLROiohgUG9CSKvpSz5mf2Z93CQytvlmhXmLVVNRZJBrJq",
+	"Q7XEpx8mqyZ4K9hyWdEZQKs4+XogClesqn8ArWA1bVv7odw1SX+Qb1Du54/xDMqpd8AvaMPxXF7BXJr2",
+	"PsGnqqcaLa1TzNucmgdSOrY8OpXIbXF8/gseemuNHRRw0ZX+JbJ7HnDxQKCP9E3yAQXxNkVdoRzxXbgS",
+	"zUGqOno4wJTHkYP+vFacQoCuHVvrVzT1VqQqQg3ens+f2P6gUsD1R5W3LJBAz4YPK5zcYQ6sPrcmZ03D",
+	"TbqX2+t/LL/25xQ7qOCjm8OjhO3ev144sSq02N7LPuhwZasnqKX13qlo3TErlLS77VK47XTh/Hor3LnG",
+	"tbMhjJ4tnbfPOd9s5vZL87n5YU/9v0WGWX5L3YaV2+ea7aSLsshX9bDtZeh46WdrI/ea/Lrd5V5Xplm2",
+	"P77IpOI+ynOtXbxGG0544SllO8gJ240vWe/c/WERJi05txpnstOcqzakO+fWnXxLEPdAXW0008vN4ify",
+	"a2+jsYMKPtay0Qy2e2XQZaPltLgZXZA1hUCVcrSZK2W6J34V9jQ5nRQKZ7Sn/wqW+5zoHSpX4GOEVtUK",
+	"GiOvWpTt6L0iEgFF/qoNuNoczRYnbe3d6OuP7DBDezmvJUfXnqiOpMbaNGQ78/hBca4vofjFmpB/9wzn",
+	"tqUJihqvwUqf1vxcac0FWhQv2iU1ec6moS0XxE9io9dNcquXEwcURMeaG37RwZIY9bVQZPNeZuxizAFN",
+	"E71VDW6mrCiLqkHvWu7jTgi2PuKgNuJAhbI+u0DJ11RbBkU1K5VTqFFEJmrYXrT8OHWk/HLgOoqH3vde",
+	"/9hp/cPs0lakhoi1B1orIERwrWrWkAb5VTbqvYHswMJEn5m1kQfMNAGW6g4BXddMN4jWJ6b93yZzvZCe",
+	"0sgQOr3kJVvvhQX7QLMx+IK5Vm/XmmzbW/Nuzs1w061sWIGm1ufngxVtURzdrkrISjVHneqwRS5iEKta",
+	"JetZfVsA2rskcwOhJhcQWieeWZs3kR23f1to08uaedwmUKFAur38Kd3cFbGzdQnEWinTsmU77aFXqNlB",
+	"ARe9Sr3Rg7kbT7RkgoMpJQk7WFG4w3Dvd2n/jmIcqWrTokcA31YUGMMkCe4xXwQoCYhsi+KA4yUE/yYJ",
+	"yDK8f2LOgQ7k33Ptx0rgG5fNWIB5MMPUkVpjlnhISXKuwfuZb9YzLDR4ouX2cBKsMpw9Zz3wfK+auF/D",
+	"p7lfQN0zfs74GosKL9vge6F/tz4BhZrJWtvQfcmTXS15YqfHijmFPDZbu++ZWLY/jsLnsmjaQ2a6bBS4",
+	"Z3JcPEFBknjpZaXfe8G2JjCbCkRNOAW01DbfnblhFaZfvTQNEAsY0Duge0xwpy4IEIzQdGEYVrRQLVHw",
+	"z8nZ6R4kUxJBFFhUJTOj9wMNhp4dUQhIEj+o/ngmwZtcjEfDEz224AExgd5UiPb/f60bRY0/MnnZL0/Q",
+	"v6gyS/ketYJQNr8QrdcH0ZCSGKYbsIbD2sNremwI5LE1nAP0ZvHO4RtXfL7HJJ2vJd8lLI0CnqU34tsN",
+	"RCZ0pODY6wM0yqJeSzYXlrYh8wWsURpD5JX4dmSGbGl5jYTY17JvoCpyQSRiR0V7aSPzBTxI8cwpns/F",
+	"Z6/UnZjxe+V6l5VrhxbrooznVmodReqAL4AaGKV64CPj+wWeLoIFurPotHTnIsg7H4QkUOiVEO5brU34",
+	"lZiZG0JiQNuuK5dx1hN8lw609VK7FCXjQNFWRfbB9+zPPfO5zfO8yAHqflC9dOM5NwyEVo2Sh4FQom9h",
+	"5ShmV5HjL7zsQhVHXgCr27CTLxG7+biPufvRT5lptnRsTce3zapkKHL+6vJ9qjTeygWa8fiLTgV6MQy+",
+	"RVXgyWpALz524SXEbcmO2tozysxTKoIy9wjVaSLSMHQwmNGZVbyxT9luljsvvJDNTouebZW0qciehmte",
+	"B5J+THGb7kLTrnDTi8zdE5lafm1HalpWXMqAsoNpSqlep7/YjwwRUA0D0a0iAy8Z0M/AD/VgW6R3MVNH",
+	"vUBC3FcW+PGVBWCaUswf5GE4JeQWwzAV0v6Pq8crmwOE0lAiN0P4cvsdZDzHfJHeHExRHN+g6a2XnA/J",
+	"cqWKNArKOBPzB5q3qhStOPGzHPpM4PLQDF8i8Lev3jSYuVM9b1SddwEo0uW2YqI2w5nDlh2Ej52QaVZc",
+	"nLQlPhlHlNfctyLK18Ok7NodjRKeH4BECW5HDBIyj2E7FCmH3mGK3AQBKvRtmABzxO0cAT6V3poqq+dP",
+	"gBQLWUunSqsDXoxg11Jk4S6VMree3fip6pi3uQpoK+ba1Tn30t4Bmk5hxf1hzEP5vVtZWNVnS29Vq8Er",
+	"lUw9BlsN9amV9/W6ay+iFLYb63X76YuCTOOvqfwivnejL9Un3FYJEzH4BuhLrbynr4b6IQJJa9BXTOa4",
+	"pqDQFzJnAU4CJM/G/RoF44scaEuOKnEEi/Gf6Q3VVpZ2TOZziALcl+7bLQO7eKwLqmlrScdkTlLewAwk",
+	"5e24QQy1IzQqQOmJ9OV4gRT1tCVbXfJ5gVcdTCCrUzszyC7eLbvpeMCtErh70u72kI2i3iZaxyayMdhM",
+	"khTmYg9onb6qWrBaYXpoP1W0Da3CgLFLioVBXu/DfxEqhiGhZnGtSxSp4iNA25QRcghiVdaoZYSPGqO2",
+	"UIec4uXW0Fojlw1ofwi4imd1qJ01MKRTS+AHEUV11uWR+JxR+iBgJMAy4UzyHmN4nkAkHwg0pe6YzOZn",
+	"nKxYQJIpBJgL+3RvFuP5glvNZPz3DCeYLTzJDUZVl0D0vNSKl+R+9py0W2Gqkom2xcErlDLwc/C5+NyR",
+	"g9OE41g3o8DSZQODyjl6Bm3FoHK7ev7cJf5ULLIt/lQMVGdnie8BMpRBqBLiOJlXmdbNsLXMqcbvubOl",
+	"KiqlXc+eO8SemkE2yJ8qHex7t9Svboke7XO4GgOZdz41qg/P3bGEqDWDctsmP3XjhA5+kN1jg80X+Fmz",
+	"sk/vD3EX9VmfxBvOhIMYJ7d7KtS05sIRJ7cBClSzgMKKMMwJfQg4sRjFyxv6KhIntyr89EUxyub9/Tki",
+	"xhkm2z6uE3t24lkTXlozuYBWc3gV4v4Y/cHHqORqFyVtSdR0cOGIDsYejAgoN44M/JUmYSf3TS6BOjlw",
+	"/qbCR+KgZYadqmcra1jJvSse1TspcUp+p16n2C3P03Z1ma4+qJaKSzfX0t9UbigkdBccak9egOQo+8R6",
+	"0bFjXrEtyw4SxyboMnVpJ9kFsck0R8EdUIaJqv0l+uuYzClKEH0wX1XTFdApJBzNISAzeVns9WhbgkfD",
+	"9HNLHoWLTPIonDQIoBVOxPWBuKMv7oasSbj7wsguEJBtrSHRXijtRnmAysZsWzgdrChZEl5nQ6kGKoq2",
+	"KIZkyZWCbSWicJUlxSDAvJ0w0jP0vs4uRonCWeTYlp6Zf7hxolkmo1a1QdtnZkp03rjfXhE6hWjSgp8J",
+	"FYde8cDQFZVNjxuYEQqmQKnF+vJEpKDCZUrztJMKY5LlwPdiobXFQWJRWybb4N7q2BmrI2O87RseusiZ",
+	"XwpcqAYBkq7Ptd50zdIXXgRz1teV1nJpPzieKa9wKmgDooF6dAJxYNw0EhJtBlxUYfLVX87F3I6bQZoM",
+	"rF1te2dUeff1R5g8bZ6qnUoy7R+q3T2BaGRQwxO5TU+9dxCLxl5v+8S14fhWIvF31fgFxWz8HWTiliXM",
+	"78aiWu9Nqd4g26F6tZVd2Zr6pSdgBxGILB1TNKqLyMl7dpU+R/mcvRz6m8kha2+fJpEs+uqF0y4KJ3uD",
+	"nkFO4dms8TGm6QIlc2DBDfB7gCTg96RwA9IiaM+SUmLGlyyfrJs4QQeIQjCjZOmROvrTj4aQEw98nGwR",
+	"uucUkIKsnioa5Ri9UNxFoaikxrrisFwf5AYQBZrVBxk4K4bIB0SVeEppHH4Iw8erx/8dAHqaYIIhegEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
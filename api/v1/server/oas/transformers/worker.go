@@ -7,9 +7,12 @@ import (
 )
 
 func ToWorker(worker *db.WorkerModel) *gen.Worker {
+	schedulingStatus := gen.WorkerSchedulingStatus(worker.SchedulingStatus)
+
 	res := &gen.Worker{
-		Metadata: *toAPIMetadata(worker.ID, worker.CreatedAt, worker.UpdatedAt),
-		Name:     worker.Name,
+		Metadata:         *toAPIMetadata(worker.ID, worker.CreatedAt, worker.UpdatedAt),
+		Name:             worker.Name,
+		SchedulingStatus: &schedulingStatus,
 	}
 
 	if lastHeartbeatAt, ok := worker.LastHeartbeatAt(); ok {
@@ -32,9 +35,12 @@ func ToWorker(worker *db.WorkerModel) *gen.Worker {
 }
 
 func ToWorkerSqlc(worker *dbsqlc.Worker) *gen.Worker {
+	schedulingStatus := gen.WorkerSchedulingStatus(worker.SchedulingStatus)
+
 	res := &gen.Worker{
-		Metadata: *toAPIMetadata(pgUUIDToStr(worker.ID), worker.CreatedAt.Time, worker.UpdatedAt.Time),
-		Name:     worker.Name,
+		Metadata:         *toAPIMetadata(pgUUIDToStr(worker.ID), worker.CreatedAt.Time, worker.UpdatedAt.Time),
+		Name:             worker.Name,
+		SchedulingStatus: &schedulingStatus,
 	}
 
 	if !worker.LastHeartbeatAt.Time.IsZero() {
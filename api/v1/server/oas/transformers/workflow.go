@@ -2,14 +2,20 @@ package transformers
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/workflowdiff"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
@@ -17,6 +23,25 @@ func ToWorkflow(workflow *db.WorkflowModel, lastRun *db.WorkflowRunModel) (*gen.
 	res := &gen.Workflow{
 		Metadata: *toAPIMetadata(workflow.ID, workflow.CreatedAt, workflow.UpdatedAt),
 		Name:     workflow.Name,
+		IsPaused: &workflow.IsPaused,
+	}
+
+	if pausedAt, ok := workflow.PausedAt(); ok {
+		res.PausedAt = &pausedAt
+	}
+
+	if pinnedVersionId, ok := workflow.PinnedVersionID(); ok {
+		pinnedVersionUUID := uuid.MustParse(pinnedVersionId)
+		res.PinnedVersionId = &pinnedVersionUUID
+	}
+
+	if canaryVersionId, ok := workflow.CanaryVersionID(); ok {
+		canaryVersionUUID := uuid.MustParse(canaryVersionId)
+		res.CanaryVersionId = &canaryVersionUUID
+	}
+
+	if canaryPercentage, ok := workflow.CanaryPercentage(); ok {
+		res.CanaryPercentage = &canaryPercentage
 	}
 
 	if lastRun != nil {
@@ -69,12 +94,61 @@ func ToWorkflow(workflow *db.WorkflowModel, lastRun *db.WorkflowRunModel) (*gen.
 			}
 
 			res.Versions = &apiVersions
+
+			// the crons of the latest version are the ones which are scheduled
+			if len(versions) > 0 && versions[0].RelationsWorkflowVersion.Triggers != nil {
+				if triggers, ok := versions[0].Triggers(); ok && triggers != nil {
+					crons := triggers.Crons()
+					cronPtrs := make([]*db.WorkflowTriggerCronRefModel, len(crons))
+
+					for i := range crons {
+						cronPtrs[i] = &crons[i]
+					}
+
+					nextFireTimes := ToWorkflowNextFireTimes(workflow, cronPtrs)
+					res.NextFireTimes = &nextFireTimes
+				}
+			}
 		}
 	}
 
 	return res, nil
 }
 
+// ToWorkflowNextFireTimes returns the next fire times of the crons of a workflow in order, or no fire times while the
+// workflow is paused.
+func ToWorkflowNextFireTimes(workflow *db.WorkflowModel, crons []*db.WorkflowTriggerCronRefModel) []time.Time {
+	res := []time.Time{}
+
+	if workflow.IsPaused {
+		return res
+	}
+
+	now := time.Now()
+
+	for _, cron := range crons {
+		jitter, _ := cron.Jitter()
+
+		schedule, err := cronutils.ParseForWorkflow(workflow.ID, cron.Cron, cron.Timezone, jitter)
+
+		if err != nil {
+			continue
+		}
+
+		res = append(res, schedule.NextN(now, defaults.DefaultCronNextFireTimes)...)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Before(res[j])
+	})
+
+	if len(res) > defaults.DefaultCronNextFireTimes {
+		res = res[:defaults.DefaultCronNextFireTimes]
+	}
+
+	return res
+}
+
 func ToWorkflowVersionMeta(version *db.WorkflowVersionModel) *gen.WorkflowVersionMeta {
 	res := &gen.WorkflowVersionMeta{
 		Metadata:   *toAPIMetadata(version.ID, version.CreatedAt, version.UpdatedAt),
@@ -162,6 +236,28 @@ func ToWorkflowVersion(workflow *db.WorkflowModel, version *db.WorkflowVersionMo
 					genCrons[i] = gen.WorkflowTriggerCronRef{
 						Cron:     &cronCp.Cron,
 						ParentId: &cronCp.ParentID,
+						Timezone: &cronCp.Timezone,
+					}
+
+					jitter, ok := cronCp.Jitter()
+
+					if ok {
+						genCrons[i].Jitter = &jitter
+					}
+
+					if schedule, err := cronutils.ParseForWorkflow(version.WorkflowID, cronCp.Cron, cronCp.Timezone, jitter); err == nil {
+						nextFireTimes := schedule.NextN(time.Now(), defaults.DefaultCronNextFireTimes)
+						genCrons[i].NextFireTimes = &nextFireTimes
+					}
+
+					input, err := toCronInput(&cronCp)
+
+					if err != nil {
+						return nil, err
+					}
+
+					if input != nil {
+						genCrons[i].Input = &input
 					}
 				}
 
@@ -202,95 +298,143 @@ func ToWorkflowVersion(workflow *db.WorkflowModel, version *db.WorkflowVersionMo
 	return res, nil
 }
 
-func ToWorkflowVersionConcurrency(concurrency *db.WorkflowConcurrencyModel) (*gen.WorkflowConcurrency, error) {
-	res := &gen.WorkflowConcurrency{
-		MaxRuns:       int32(concurrency.MaxRuns),
-		LimitStrategy: gen.WorkflowConcurrencyLimitStrategy(concurrency.LimitStrategy),
+func toCronInput(cron *db.WorkflowTriggerCronRefModel) (map[string]interface{}, error) {
+	data, ok := cron.Input()
+
+	if !ok {
+		return nil, nil
 	}
 
-	if getGroup, ok := concurrency.GetConcurrencyGroup(); ok {
-		res.GetConcurrencyGroup = getGroup.ActionID
+	input := map[string]interface{}{}
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("could not unmarshal cron input: %w", err)
 	}
 
-	return res, nil
+	return input, nil
 }
 
-func ToWorkflowYAMLBytes(workflow *db.WorkflowModel, version *db.WorkflowVersionModel) ([]byte, error) {
-	res := &types.Workflow{
-		Name: workflow.Name,
+func ToScheduledWorkflow(scheduled *db.WorkflowTriggerScheduledRefModel) (*gen.ScheduledWorkflow, error) {
+	workflowVersion := scheduled.Parent()
+
+	res := &gen.ScheduledWorkflow{
+		Metadata:          *toAPIMetadata(scheduled.ID, scheduled.CreatedAt, scheduled.UpdatedAt),
+		WorkflowId:        workflowVersion.WorkflowID,
+		WorkflowName:      workflowVersion.Workflow().Name,
+		WorkflowVersionId: workflowVersion.ID,
+		TriggerAt:         scheduled.TriggerAt,
 	}
 
-	if setVersion, ok := version.Version(); ok {
-		res.Version = setVersion
+	if data, ok := scheduled.Input(); ok {
+		input, err := toScheduledInput(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Input = &input
 	}
 
-	if description, ok := workflow.Description(); ok {
-		res.Description = description
+	if triggered, ok := scheduled.Triggered(); ok && triggered != nil {
+		res.WorkflowRunId = &triggered.ParentID
 	}
 
-	if triggers, ok := version.Triggers(); ok && triggers != nil {
-		triggersResp := types.WorkflowTriggers{}
+	return res, nil
+}
 
-		if crons := triggers.Crons(); len(crons) > 0 {
-			triggersResp.Cron = make([]string, len(crons))
+func ToScheduledWorkflowFromSQLC(row *dbsqlc.ListScheduledWorkflowsRow) (*gen.ScheduledWorkflow, error) {
+	scheduled := row.WorkflowTriggerScheduledRef
 
-			for i, cron := range crons {
-				triggersResp.Cron[i] = cron.Cron
-			}
-		}
+	res := &gen.ScheduledWorkflow{
+		Metadata:          *toAPIMetadata(pgUUIDToStr(scheduled.ID), scheduled.CreatedAt.Time, scheduled.UpdatedAt.Time),
+		WorkflowId:        pgUUIDToStr(row.WorkflowId),
+		WorkflowName:      row.WorkflowName,
+		WorkflowVersionId: pgUUIDToStr(scheduled.ParentId),
+		TriggerAt:         scheduled.TriggerAt.Time,
+	}
 
-		if events := triggers.Events(); len(events) > 0 {
-			triggersResp.Events = make([]string, len(events))
+	if scheduled.Input != nil {
+		input, err := toScheduledInput(scheduled.Input)
 
-			for i, event := range events {
-				triggersResp.Events[i] = event.EventKey
-			}
+		if err != nil {
+			return nil, err
 		}
 
-		res.Triggers = triggersResp
+		res.Input = &input
 	}
 
-	if jobs := version.Jobs(); jobs != nil {
-		res.Jobs = make(map[string]types.WorkflowJob, len(jobs))
+	if row.WorkflowRunId.Valid {
+		workflowRunId := pgUUIDToStr(row.WorkflowRunId)
+		res.WorkflowRunId = &workflowRunId
+	}
 
-		for _, job := range jobs {
-			jobCp := job
+	return res, nil
+}
 
-			jobRes := types.WorkflowJob{}
+func toScheduledInput(data []byte) (map[string]interface{}, error) {
+	input := map[string]interface{}{}
 
-			if description, ok := jobCp.Description(); ok {
-				jobRes.Description = description
-			}
-
-			if timeout, ok := jobCp.Timeout(); ok {
-				jobRes.Timeout = timeout
-			}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("could not unmarshal scheduled workflow input: %w", err)
+	}
 
-			if steps := jobCp.Steps(); steps != nil {
-				jobRes.Steps = make([]types.WorkflowStep, 0)
+	return input, nil
+}
 
-				for _, step := range steps {
-					stepRes := types.WorkflowStep{
-						ID:       step.ID,
-						ActionID: step.ActionID,
-					}
+func ToWorkflowVersionConcurrency(concurrency *db.WorkflowConcurrencyModel) (*gen.WorkflowConcurrency, error) {
+	res := &gen.WorkflowConcurrency{
+		MaxRuns:       int32(concurrency.MaxRuns),
+		LimitStrategy: gen.WorkflowConcurrencyLimitStrategy(concurrency.LimitStrategy),
+	}
 
-					if readableId, ok := step.ReadableID(); ok {
-						stepRes.ID = readableId
-					}
+	if getGroup, ok := concurrency.GetConcurrencyGroup(); ok {
+		res.GetConcurrencyGroup = getGroup.ActionID
+	}
 
-					if timeout, ok := step.Timeout(); ok {
-						stepRes.Timeout = timeout
-					}
+	if expr, ok := concurrency.ConcurrencyGroupExpression(); ok {
+		res.Expression = &expr
+	}
 
-					jobRes.Steps = append(jobRes.Steps, stepRes)
-				}
+	return res, nil
+}
 
-				res.Jobs[jobCp.Name] = jobRes
-			}
+func ToWorkflowVersionDiff(diff *workflowdiff.Diff) *gen.WorkflowVersionDiff {
+	res := &gen.WorkflowVersionDiff{
+		FromVersionId: uuid.MustParse(diff.FromVersionId),
+		ToVersionId:   uuid.MustParse(diff.ToVersionId),
+		Changes:       make([]gen.WorkflowVersionChange, len(diff.Changes)),
+		RawDiff:       diff.RawDiff,
+	}
+
+	for i, change := range diff.Changes {
+		res.Changes[i] = gen.WorkflowVersionChange{
+			Type: gen.WorkflowVersionChangeType(change.Type),
+			Kind: gen.WorkflowVersionChangeKind(change.Kind),
+			Job:  stringOrNil(change.Job),
+			Step: stringOrNil(change.Step),
+			From: stringOrNil(change.From),
+			To:   stringOrNil(change.To),
 		}
 	}
 
+	return res
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func ToWorkflowYAMLBytes(workflow *db.WorkflowModel, version *db.WorkflowVersionModel) ([]byte, error) {
+	res, err := workflowdiff.ToWorkflowFile(workflow, version)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return types.ToYAML(context.Background(), res)
 }
 
@@ -5,11 +5,14 @@ import (
 	"sort"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 )
 
 func ToWorkflowRun(run *db.WorkflowRunModel) (*gen.WorkflowRun, error) {
@@ -194,6 +197,14 @@ func ToStepRun(stepRun *db.StepRunModel) (*gen.StepRun, error) {
 		res.TimeoutAtEpoch = getEpochFromTime(timeoutAt)
 	}
 
+	if progress, ok := stepRun.Progress(); ok {
+		res.Progress = &progress
+	}
+
+	if progressMessage, ok := stepRun.ProgressMessage(); ok {
+		res.ProgressMessage = &progressMessage
+	}
+
 	if workerId, ok := stepRun.WorkerID(); ok {
 		res.WorkerId = &workerId
 	}
@@ -245,6 +256,10 @@ func ToWorkflowRunTriggeredBy(triggeredBy *db.WorkflowRunTriggeredByModel) *gen.
 		res.CronSchedule = &cron.Cron
 	}
 
+	if cronFireAt, ok := triggeredBy.CronFireAt(); ok {
+		res.CronFireAt = &cronFireAt
+	}
+
 	return res
 }
 
@@ -284,6 +299,10 @@ func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
 		triggeredBy.Event = event
 	}
 
+	if runTriggeredBy.CronFireAt.Valid {
+		triggeredBy.CronFireAt = &runTriggeredBy.CronFireAt.Time
+	}
+
 	workflowRunId := sqlchelpers.UUIDToStr(run.ID)
 
 	res := &gen.WorkflowRun{
@@ -313,3 +332,23 @@ func ToPullRequest(pr *db.GithubPullRequestModel) *gen.PullRequest {
 		RepositoryOwner:       pr.RepositoryOwner,
 	}
 }
+
+func ToWorkflowRunEvent(e *runevents.Event) *gen.WorkflowRunEvent {
+	res := &gen.WorkflowRunEvent{
+		WorkflowRunId: uuid.MustParse(e.WorkflowRunId),
+		EventId:       e.EventId,
+		ResourceType:  gen.WorkflowRunEventResourceType(e.ResourceType),
+		ResourceId:    uuid.MustParse(e.ResourceId),
+		EventType:     gen.WorkflowRunEventType(e.EventType),
+	}
+
+	if e.Payload != "" {
+		res.Payload = &e.Payload
+	}
+
+	if !e.Timestamp.IsZero() {
+		res.Timestamp = &e.Timestamp
+	}
+
+	return res
+}
@@ -148,6 +148,16 @@ func (t *APIServer) Run() (func() error, error) {
 		return workflowRun, workflowRun.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("scheduled-workflow", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		scheduled, err := config.Repository.Workflow().GetScheduledById(parentId, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return scheduled, scheduled.Parent().Workflow().TenantID, nil
+	})
+
 	populatorMW.RegisterGetter("step-run", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		stepRun, err := config.Repository.StepRun().GetStepRunById(parentId, id)
 
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/config/loader"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+)
+
+var (
+	workflowTenantId     string
+	workflowName         string
+	workflowQueueEvents  bool
+	workflowReplayEvents bool
+)
+
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "command for managing workflows.",
+}
+
+var workflowPauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "pause a workflow, so it does not start new runs until it is resumed.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runPauseWorkflow()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [workflow pause] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var workflowResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "resume a paused workflow.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runResumeWorkflow()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [workflow resume] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workflowCmd)
+	workflowCmd.AddCommand(workflowPauseCmd)
+	workflowCmd.AddCommand(workflowResumeCmd)
+
+	workflowCmd.PersistentFlags().StringVar(
+		&workflowTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID of the workflow",
+	)
+
+	workflowCmd.PersistentFlags().StringVar(
+		&workflowName,
+		"name",
+		"",
+		"the name of the workflow",
+	)
+
+	// require the tenant ID and workflow name
+	workflowCmd.MarkPersistentFlagRequired("tenant-id") // nolint: errcheck
+	workflowCmd.MarkPersistentFlagRequired("name")      // nolint: errcheck
+
+	workflowPauseCmd.Flags().BoolVar(
+		&workflowQueueEvents,
+		"queue-events",
+		false,
+		"hold back the events which trigger the workflow while it is paused, so they are replayed when it is resumed",
+	)
+
+	workflowResumeCmd.Flags().BoolVar(
+		&workflowReplayEvents,
+		"replay-events",
+		false,
+		"replay the events which triggered the workflow while it was paused",
+	)
+}
+
+func runPauseWorkflow() error {
+	// read in the local config
+	configLoader := loader.NewConfigLoader(configDirectory)
+
+	cleanup, serverConf, err := configLoader.LoadServerConfig()
+	defer func() {
+		if err := cleanup(); err != nil {
+			panic(fmt.Errorf("could not cleanup server config: %v", err))
+		}
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	defer serverConf.Disconnect() // nolint: errcheck
+
+	workflow, err := serverConf.Repository.Workflow().GetWorkflowByName(workflowTenantId, workflowName)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	_, err = serverConf.Repository.Workflow().PauseWorkflow(workflowTenantId, workflow.ID, &repository.PauseWorkflowOpts{
+		QueueEvents: workflowQueueEvents,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not pause workflow: %w", err)
+	}
+
+	fmt.Printf("paused workflow %s\n", workflowName)
+
+	return nil
+}
+
+func runResumeWorkflow() error {
+	// read in the local config
+	configLoader := loader.NewConfigLoader(configDirectory)
+
+	cleanup, serverConf, err := configLoader.LoadServerConfig()
+	defer func() {
+		if err := cleanup(); err != nil {
+			panic(fmt.Errorf("could not cleanup server config: %v", err))
+		}
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	defer serverConf.Disconnect() // nolint: errcheck
+
+	workflow, err := serverConf.Repository.Workflow().GetWorkflowByName(workflowTenantId, workflowName)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	workflow, err = serverConf.Repository.Workflow().ResumeWorkflow(workflowTenantId, workflow.ID, &repository.ResumeWorkflowOpts{
+		ReplayEvents: workflowReplayEvents,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not resume workflow: %w", err)
+	}
+
+	// replay the events which were held back during the pause
+	err = serverConf.TaskQueue.AddTask(
+		context.Background(),
+		taskqueue.EVENT_PROCESSING_QUEUE,
+		tasktypes.WorkflowResumedToTask(workflow),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not add workflow resumed task: %w", err)
+	}
+
+	fmt.Printf("resumed workflow %s\n", workflowName)
+
+	return nil
+}
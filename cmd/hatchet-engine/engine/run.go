@@ -17,6 +17,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/health"
 	"github.com/hatchet-dev/hatchet/internal/services/heartbeat"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/leader"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
@@ -43,10 +44,20 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 
 	var teardown []Teardown
 
+	// elect a leader for the loops which should only run on one instance
+	le, err := leader.New(
+		leader.WithRepository(sc.Repository.Leader()),
+		leader.WithLogger(sc.Logger),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create leader elector: %w", err)
+	}
+
 	var h *health.Health
 	healthProbes := sc.HasService("health")
 	if healthProbes {
-		h = health.New(sc.Repository, sc.TaskQueue)
+		h = health.New(sc.Repository, sc.TaskQueue, le)
 		cleanup, err := h.Start()
 		if err != nil {
 			return fmt.Errorf("could not start health: %w", err)
@@ -62,6 +73,7 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			ticker.WithTaskQueue(sc.TaskQueue),
 			ticker.WithRepository(sc.Repository),
 			ticker.WithLogger(sc.Logger),
+			ticker.WithLeaderElector(le),
 		)
 
 		if err != nil {
@@ -104,6 +116,7 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			jobs.WithTaskQueue(sc.TaskQueue),
 			jobs.WithRepository(sc.Repository),
 			jobs.WithLogger(sc.Logger),
+			jobs.WithLeaderElector(le),
 		)
 
 		if err != nil {
@@ -145,6 +158,7 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 			heartbeat.WithTaskQueue(sc.TaskQueue),
 			heartbeat.WithRepository(sc.Repository),
 			heartbeat.WithLogger(sc.Logger),
+			heartbeat.WithLeaderElector(le),
 		)
 
 		if err != nil {
@@ -259,6 +273,11 @@ func Run(ctx context.Context, cf *loader.ConfigLoader) error {
 		})
 	}
 
+	// hand over the loops which this instance leads once the services which run them have stopped
+	teardown = append(teardown, Teardown{
+		name: "leader elector",
+		fn:   le.Resign,
+	})
 	teardown = append(teardown, Teardown{
 		name: "telemetry",
 		fn: func() error {
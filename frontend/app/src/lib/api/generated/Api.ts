@@ -20,6 +20,8 @@ import {
   CreateSNSIntegrationRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CronPreview,
+  CronPreviewRequest,
   EventData,
   EventKey,
   EventKeyList,
@@ -41,18 +43,25 @@ import {
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  PauseWorkflowRequest,
   PullRequestState,
   RejectInviteRequest,
   ReplayEventRequest,
   RerunStepRunRequest,
+  ResumeWorkflowRequest,
   SNSIntegration,
+  ScheduledWorkflow,
+  ScheduledWorkflowList,
   StepRun,
   Tenant,
   TenantInvite,
   TenantInviteList,
   TenantMemberList,
   TriggerWorkflowRunRequest,
+  UpdateScheduledWorkflowRequest,
   UpdateTenantInviteRequest,
+  UpdateTenantRequest,
+  UpdateWorkflowRolloutRequest,
   User,
   UserLoginRequest,
   UserRegisterRequest,
@@ -63,10 +72,14 @@ import {
   WorkflowID,
   WorkflowList,
   WorkflowRun,
+  WorkflowRunEvent,
+  WorkflowRunEventResourceType,
+  WorkflowRunEventType,
   WorkflowRunList,
   WorkflowRunStatusList,
   WorkflowVersion,
   WorkflowVersionDefinition,
+  WorkflowVersionDiff,
 } from "./data-contracts";
 import { ContentType, HttpClient, RequestParams } from "./http-client";
 
@@ -442,6 +455,25 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Updates a tenant
+   *
+   * @tags Tenant
+   * @name TenantUpdate
+   * @summary Update tenant
+   * @request PATCH:/api/v1/tenants/{tenant}
+   * @secure
+   */
+  tenantUpdate = (tenant: string, data: UpdateTenantRequest, params: RequestParams = {}) =>
+    this.request<Tenant, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}`,
+      method: "PATCH",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Creates a new tenant invite
    *
@@ -797,6 +829,97 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Pause a workflow, so it does not start new runs until it is resumed
+   *
+   * @tags Workflow
+   * @name WorkflowUpdatePause
+   * @summary Pause workflow
+   * @request POST:/api/v1/workflows/{workflow}/pause
+   * @secure
+   */
+  workflowUpdatePause = (workflow: string, data: PauseWorkflowRequest, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/pause`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Resume a paused workflow
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateResume
+   * @summary Resume workflow
+   * @request POST:/api/v1/workflows/{workflow}/resume
+   * @secure
+   */
+  workflowUpdateResume = (workflow: string, data: ResumeWorkflowRequest, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/resume`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Pin a workflow to a version, or roll out a canary version to a percentage of its runs
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateRollout
+   * @summary Update workflow rollout
+   * @request PUT:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowUpdateRollout = (workflow: string, data: UpdateWorkflowRolloutRequest, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: "PUT",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Promote the canary version of a workflow, so all runs use it
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateRolloutPromote
+   * @summary Promote workflow canary
+   * @request POST:/api/v1/workflows/{workflow}/rollout/promote
+   * @secure
+   */
+  workflowUpdateRolloutPromote = (workflow: string, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout/promote`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Roll back the canary version of a workflow, or pin the workflow to the version before the one runs use if there is no canary version
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateRolloutRollback
+   * @summary Roll back workflow
+   * @request POST:/api/v1/workflows/{workflow}/rollout/rollback
+   * @secure
+   */
+  workflowUpdateRolloutRollback = (workflow: string, params: RequestParams = {}) =>
+    this.request<Workflow, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout/rollback`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Get a workflow version definition for a tenant
    *
@@ -827,6 +950,43 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Get the changes between two versions of a workflow
+   *
+   * @tags Workflow
+   * @name WorkflowVersionGetDiff
+   * @summary Get workflow version diff
+   * @request GET:/api/v1/workflows/{workflow}/versions/diff
+   * @secure
+   */
+  workflowVersionGetDiff = (
+    workflow: string,
+    query: {
+      /**
+       * The version to compare from
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      from: string;
+      /**
+       * The version to compare to
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      to: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowVersionDiff, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/versions/diff`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Link a github repository to a workflow
    *
@@ -970,6 +1130,152 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Stream the live events of all workflow runs for a tenant as server-sent events. Each event is sent as a JSON-encoded WorkflowRunEvent. Stream events are only sent if the STREAM event type is requested.
+   *
+   * @tags Workflow
+   * @name WorkflowRunStreamEvents
+   * @summary Stream workflow run events
+   * @request GET:/api/v1/tenants/{tenant}/workflows/runs/events
+   * @secure
+   */
+  workflowRunStreamEvents = (
+    tenant: string,
+    query?: {
+      /** A list of workflow IDs to filter by */
+      workflows?: WorkflowID[];
+      /** A list of event types to filter by */
+      eventTypes?: WorkflowRunEventType[];
+      /** A list of resource types to filter by */
+      resourceTypes?: WorkflowRunEventResourceType[];
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRunEvent, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/runs/events`,
+      method: "GET",
+      query: query,
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Validate a cron expression with an optional time zone and jitter, and get the next times it fires
+   *
+   * @tags Workflow
+   * @name WorkflowCronPreview
+   * @summary Preview cron
+   * @request POST:/api/v1/tenants/{tenant}/workflows/crons/preview
+   * @secure
+   */
+  workflowCronPreview = (tenant: string, data: CronPreviewRequest, params: RequestParams = {}) =>
+    this.request<CronPreview, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/crons/preview`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the scheduled workflows of a tenant, ordered by the time they are triggered
+   *
+   * @tags Workflow
+   * @name WorkflowScheduledList
+   * @summary Get scheduled workflows
+   * @request GET:/api/v1/tenants/{tenant}/workflows/scheduled
+   * @secure
+   */
+  workflowScheduledList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /**
+       * The workflow id to get scheduled workflows for.
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      workflowId?: string;
+      /** Whether to get only the scheduled workflows which have triggered a workflow run, or only the ones which have not. */
+      triggered?: boolean;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<ScheduledWorkflowList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/scheduled`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get a scheduled workflow for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowScheduledGet
+   * @summary Get scheduled workflow
+   * @request GET:/api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow}
+   * @secure
+   */
+  workflowScheduledGet = (tenant: string, scheduledWorkflow: string, params: RequestParams = {}) =>
+    this.request<ScheduledWorkflow, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/scheduled/${scheduledWorkflow}`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Update the trigger time or input of a scheduled workflow which has not triggered a workflow run
+   *
+   * @tags Workflow
+   * @name WorkflowScheduledUpdate
+   * @summary Update scheduled workflow
+   * @request PATCH:/api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow}
+   * @secure
+   */
+  workflowScheduledUpdate = (
+    tenant: string,
+    scheduledWorkflow: string,
+    data: UpdateScheduledWorkflowRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<ScheduledWorkflow, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/scheduled/${scheduledWorkflow}`,
+      method: "PATCH",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete a scheduled workflow. The workflow run it triggered, if any, is kept.
+   *
+   * @tags Workflow
+   * @name WorkflowScheduledDelete
+   * @summary Delete scheduled workflow
+   * @request DELETE:/api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow}
+   * @secure
+   */
+  workflowScheduledDelete = (tenant: string, scheduledWorkflow: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/scheduled/${scheduledWorkflow}`,
+      method: "DELETE",
+      secure: true,
+      ...params,
+    });
   /**
    * @description Get a workflow run for a tenant
    *
@@ -1100,6 +1406,57 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: "json",
       ...params,
     });
+  /**
+   * @description Pause a worker, so it is not assigned new step runs until it is resumed
+   *
+   * @tags Worker
+   * @name WorkerUpdatePause
+   * @summary Pause worker
+   * @request POST:/api/v1/workers/{worker}/pause
+   * @secure
+   */
+  workerUpdatePause = (worker: string, params: RequestParams = {}) =>
+    this.request<Worker, APIErrors>({
+      path: `/api/v1/workers/${worker}/pause`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Drain a worker, so it is not assigned new step runs and stops once its in-flight step runs have finished
+   *
+   * @tags Worker
+   * @name WorkerUpdateDrain
+   * @summary Drain worker
+   * @request POST:/api/v1/workers/{worker}/drain
+   * @secure
+   */
+  workerUpdateDrain = (worker: string, params: RequestParams = {}) =>
+    this.request<Worker, APIErrors>({
+      path: `/api/v1/workers/${worker}/drain`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Resume a paused or draining worker, so it is assigned new step runs
+   *
+   * @tags Worker
+   * @name WorkerUpdateResume
+   * @summary Resume worker
+   * @request POST:/api/v1/workers/{worker}/resume
+   * @secure
+   */
+  workerUpdateResume = (worker: string, params: RequestParams = {}) =>
+    this.request<Worker, APIErrors>({
+      path: `/api/v1/workers/${worker}/resume`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description List Github App installations
    *
@@ -162,6 +162,20 @@ export interface Tenant {
   name: string;
   /** The slug of the tenant. */
   slug: string;
+  /** The default strategy for selecting a worker to assign a step run to. */
+  workerSelectionStrategy: WorkerSelectionStrategy;
+}
+
+export interface UpdateTenantRequest {
+  /** The default strategy for selecting a worker to assign a step run to. */
+  workerSelectionStrategy?: WorkerSelectionStrategy;
+}
+
+export enum WorkerSelectionStrategy {
+  LEAST_LOADED = "LEAST_LOADED",
+  ROUND_ROBIN = "ROUND_ROBIN",
+  RANDOM = "RANDOM",
+  MOST_RECENT_HEARTBEAT = "MOST_RECENT_HEARTBEAT",
 }
 
 export interface TenantMember {
@@ -333,6 +347,31 @@ export interface Workflow {
   /** The jobs of the workflow. */
   jobs?: Job[];
   deployment?: WorkflowDeploymentConfig;
+  /** Whether the workflow is paused. */
+  isPaused?: boolean;
+  /**
+   * The time the workflow was paused.
+   * @format date-time
+   */
+  pausedAt?: string;
+  /** The next times the crons of the latest workflow version fire, which is empty while the workflow is paused. */
+  nextFireTimes?: string[];
+  /**
+   * The version runs use instead of the latest version.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  pinnedVersionId?: string;
+  /**
+   * The version which is rolled out to a percentage of runs.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  canaryVersionId?: string;
+  /** The percentage of runs which use the canary version. */
+  canaryPercentage?: number;
 }
 
 export interface WorkflowConcurrency {
@@ -345,6 +384,8 @@ export interface WorkflowConcurrency {
   limitStrategy: "CANCEL_IN_PROGRESS" | "DROP_NEWEST" | "QUEUE_NEWEST" | "GROUP_ROUND_ROBIN";
   /** An action which gets the concurrency group for the WorkflowRun. */
   getConcurrencyGroup: string;
+  /** An expression over the workflow input which is evaluated by the engine to get the concurrency group for the WorkflowRun. */
+  expression?: string;
 }
 
 export interface WorkflowDeploymentConfig {
@@ -392,6 +433,50 @@ export interface WorkflowVersionDefinition {
   rawDefinition: string;
 }
 
+export interface WorkflowVersionDiff {
+  /**
+   * The version compared from.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  fromVersionId: string;
+  /**
+   * The version compared to.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  toVersionId: string;
+  /** The changes, ordered from the workflow concurrency and triggers to the jobs and steps by name. */
+  changes: WorkflowVersionChange[];
+  /** A unified diff of the YAML definitions of the versions, which is empty if they are equal. */
+  rawDiff: string;
+}
+
+export interface WorkflowVersionChange {
+  type: "ADDED" | "REMOVED" | "CHANGED";
+  /** The part of the workflow which changed. */
+  kind:
+    | "JOB"
+    | "STEP"
+    | "ACTION"
+    | "PARENT"
+    | "TIMEOUT"
+    | "RETRIES"
+    | "EVENT_TRIGGER"
+    | "CRON_TRIGGER"
+    | "CONCURRENCY";
+  /** The job which changed, or which the step belongs to. Not set for changes to the triggers or concurrency of the workflow. */
+  job?: string;
+  /** The step which changed, or which the parent belongs to. */
+  step?: string;
+  /** The value before the change, not set if it was added. */
+  from?: string;
+  /** The value after the change, not set if it was removed. */
+  to?: string;
+}
+
 export interface WorkflowTag {
   /** The name of the workflow. */
   name: string;
@@ -421,6 +506,13 @@ export interface WorkflowTriggerEventRef {
 export interface WorkflowTriggerCronRef {
   parent_id?: string;
   cron?: string;
+  /** The IANA time zone the cron expression is evaluated in. */
+  timezone?: string;
+  input?: Record<string, any>;
+  /** The window every fire is delayed within, by an amount which is stable for the workflow. */
+  jitter?: string;
+  /** The next times the cron fires. */
+  nextFireTimes?: string[];
 }
 
 export interface Job {
@@ -488,6 +580,39 @@ export enum JobRunStatus {
   CANCELLED = "CANCELLED",
 }
 
+export enum WorkflowRunEventType {
+  STARTED = "STARTED",
+  COMPLETED = "COMPLETED",
+  FAILED = "FAILED",
+  CANCELLED = "CANCELLED",
+  TIMED_OUT = "TIMED_OUT",
+  PROGRESS = "PROGRESS",
+  STREAM = "STREAM",
+}
+
+export enum WorkflowRunEventResourceType {
+  STEP_RUN = "STEP_RUN",
+  WORKFLOW_RUN = "WORKFLOW_RUN",
+}
+
+export interface WorkflowRunEvent {
+  /** @format uuid */
+  workflowRunId: string;
+  /**
+   * The id of the event within the workflow run, or 0 if the event is not persisted.
+   * @format int64
+   */
+  eventId: number;
+  resourceType: WorkflowRunEventResourceType;
+  /** @format uuid */
+  resourceId: string;
+  eventType: WorkflowRunEventType;
+  /** The event payload. For stream events, this is the base64-encoded chunk. */
+  payload?: string;
+  /** @format date-time */
+  timestamp?: string;
+}
+
 export enum StepRunStatus {
   PENDING = "PENDING",
   PENDING_ASSIGNMENT = "PENDING_ASSIGNMENT",
@@ -528,6 +653,11 @@ export interface WorkflowRunTriggeredBy {
   event?: Event;
   cronParentId?: string;
   cronSchedule?: string;
+  /**
+   * The time the cron was scheduled to fire, which is earlier than the creation time for missed fires.
+   * @format date-time
+   */
+  cronFireAt?: string;
 }
 
 export interface StepRun {
@@ -556,6 +686,10 @@ export interface StepRun {
   /** @format date-time */
   timeoutAt?: string;
   timeoutAtEpoch?: number;
+  /** The progress of the step run in percent, as last reported by the worker. */
+  progress?: number;
+  /** The message of the last progress report. */
+  progressMessage?: string;
   /** @format date-time */
   cancelledAt?: string;
   cancelledAtEpoch?: number;
@@ -582,6 +716,17 @@ export interface Worker {
   actions?: string[];
   /** The recent step runs for this worker. */
   recentStepRuns?: StepRun[];
+  /** Whether the worker is assigned new step runs. A paused worker is not assigned new step runs until it is resumed, and a draining worker stops once its in-flight step runs have finished. */
+  schedulingStatus?: WorkerSchedulingStatus;
+  /** The number of step runs which are assigned to or running on this worker. A draining worker is drained once this is 0. */
+  activeStepRuns?: number;
+}
+
+/** Whether the worker is assigned new step runs. A paused worker is not assigned new step runs until it is resumed, and a draining worker stops once its in-flight step runs have finished. */
+export enum WorkerSchedulingStatus {
+  ACTIVE = "ACTIVE",
+  PAUSED = "PAUSED",
+  DRAINING = "DRAINING",
 }
 
 export interface APIToken {
@@ -639,6 +784,100 @@ export interface LinkGithubRepositoryRequest {
   gitRepoBranch: string;
 }
 
+export interface ScheduledWorkflow {
+  metadata: APIResourceMeta;
+  /** The id of the workflow. */
+  workflowId: string;
+  /** The name of the workflow. */
+  workflowName: string;
+  /** The id of the workflow version which is triggered. */
+  workflowVersionId: string;
+  /**
+   * The time the workflow is triggered.
+   * @format date-time
+   */
+  triggerAt: string;
+  /** The input to the workflow run. */
+  input?: Record<string, any>;
+  /** The id of the workflow run triggered by the schedule, if it has fired. */
+  workflowRunId?: string;
+}
+
+export interface ScheduledWorkflowList {
+  rows?: ScheduledWorkflow[];
+  pagination?: PaginationResponse;
+}
+
+export interface UpdateScheduledWorkflowRequest {
+  /**
+   * The new time to trigger the workflow, which must be in the future.
+   * @format date-time
+   */
+  triggerAt?: string;
+  /** The new input to the workflow run. */
+  input?: Record<string, any>;
+}
+
+export interface CronPreviewRequest {
+  /** The cron expression, or one of the shorthands random_15_min, random_hourly and random_daily. */
+  cron: string;
+  /** The IANA time zone the cron expression is evaluated in, defaults to UTC. */
+  timezone?: string;
+  /** The window every fire is delayed within, such as 15m. */
+  jitter?: string;
+  /**
+   * The workflow the cron belongs to. Jittered and random crons fire at the start of each window unless it is set, as the delay is derived from the workflow.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId?: string;
+  /**
+   * The number of fire times to return, defaults to 5.
+   * @min 1
+   * @max 100
+   */
+  count?: number;
+}
+
+export interface CronPreview {
+  /** The next times the cron fires. */
+  nextFireTimes: string[];
+}
+
+export interface PauseWorkflowRequest {
+  /** Whether events which trigger the workflow while it is paused are replayed when it is resumed. */
+  queueEvents?: boolean;
+}
+
+export interface ResumeWorkflowRequest {
+  /** Whether to replay the events which triggered the workflow while it was paused. */
+  replayEvents?: boolean;
+}
+
+export interface UpdateWorkflowRolloutRequest {
+  /**
+   * The version runs use instead of the latest version. Runs use the latest version if unset.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  pinnedVersionId?: string;
+  /**
+   * The version which is rolled out to a percentage of runs. Required with canaryPercentage.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  canaryVersionId?: string;
+  /**
+   * The percentage of runs which use the canary version. Required with canaryVersionId.
+   * @min 1
+   * @max 99
+   */
+  canaryPercentage?: number;
+}
+
 export interface GithubBranch {
   branch_name: string;
   is_default: boolean;
@@ -0,0 +1,462 @@
+package cronutils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// maxSearchDays bounds how far ahead Next looks for a fire time, so expressions which never match, such as
+// 30 February, do not search forever.
+const maxSearchDays = 5 * 366
+
+// starBit is set by the cron parser on fields which are a wildcard.
+const starBit = 1 << 63
+
+// maxJitter is the largest jitter window of a cron.
+const maxJitter = 24 * time.Hour
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+type randomSchedule struct {
+	expression string
+	window     time.Duration
+}
+
+// randomSchedules are the shorthands for schedules which fire once in each interval, at a random but stable point
+// of the interval.
+var randomSchedules = map[string]randomSchedule{
+	"random_15_min": {expression: "*/15 * * * *", window: 15 * time.Minute},
+	"random_hourly": {expression: "0 * * * *", window: time.Hour},
+	"random_daily":  {expression: "0 0 * * *", window: 24 * time.Hour},
+}
+
+// Schedule is a cron expression which is evaluated on the wall clock of a time zone.
+type Schedule struct {
+	schedule cron.Schedule
+	loc      *time.Location
+
+	// the jitter window which is implied by a random shorthand
+	window time.Duration
+
+	// the amount of time every fire time is delayed by
+	offset time.Duration
+}
+
+// IsRandom returns true if the expression is one of the random shorthands random_15_min, random_hourly and
+// random_daily.
+func IsRandom(expression string) bool {
+	_, ok := randomSchedules[expression]
+	return ok
+}
+
+// Parse parses a standard five-field cron expression, a descriptor such as @daily, or one of the random shorthands
+// random_15_min, random_hourly and random_daily. The expression is evaluated in the given IANA time zone, or in UTC
+// if the time zone is empty.
+//
+// The random shorthands fire once in each interval, but only once a jitter key is set with WithJitter, as the
+// point of the interval they fire at is derived from the key.
+func Parse(expression, timezone string) (*Schedule, error) {
+	loc, err := LoadLocation(timezone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(expression, "TZ=") || strings.HasPrefix(expression, "CRON_TZ=") {
+		return nil, fmt.Errorf("the time zone of a cron expression must be set separately")
+	}
+
+	var window time.Duration
+
+	if random, ok := randomSchedules[expression]; ok {
+		expression = random.expression
+		window = random.window
+	}
+
+	schedule, err := parser.Parse(expression)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+
+	return &Schedule{
+		schedule: schedule,
+		loc:      loc,
+		window:   window,
+	}, nil
+}
+
+// ValidationError is an error in one of the fields of a cron: the cron expression, the time zone or the jitter.
+type ValidationError struct {
+	Field string
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validate returns the errors in the expression, time zone and jitter of a cron, where the time zone and jitter are
+// optional.
+func Validate(expression, timezone, jitter string) []*ValidationError {
+	res := []*ValidationError{}
+
+	if _, err := LoadLocation(timezone); err != nil {
+		res = append(res, &ValidationError{Field: "timezone", Err: err})
+	}
+
+	// parse the expression in UTC, so an invalid time zone is not reported twice
+	if _, err := Parse(expression, ""); err != nil {
+		res = append(res, &ValidationError{Field: "cron", Err: err})
+	}
+
+	if jitter != "" {
+		if _, err := ParseJitter(jitter); err != nil {
+			res = append(res, &ValidationError{Field: "jitter", Err: err})
+		}
+	}
+
+	return res
+}
+
+// ParseForWorkflow parses the cron expression of a workflow trigger, which is delayed within the jitter window if it
+// is set. The delay is keyed by the workflow and the expression, so it does not change between workflow versions.
+func ParseForWorkflow(workflowId, expression, timezone, jitter string) (*Schedule, error) {
+	schedule, err := Parse(expression, timezone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var window time.Duration
+
+	if jitter != "" {
+		window, err = ParseJitter(jitter)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule.WithJitter(window, fmt.Sprintf("%s-%s", workflowId, expression)), nil
+}
+
+// Preview returns the next n fire times after t of the cron expression of a workflow trigger. Without a workflow,
+// jittered and random crons are not delayed, so they fire at the start of each jitter window.
+func Preview(workflowId, expression, timezone, jitter string, t time.Time, n int) ([]time.Time, error) {
+	if workflowId != "" {
+		schedule, err := ParseForWorkflow(workflowId, expression, timezone, jitter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return schedule.NextN(t, n), nil
+	}
+
+	if errs := Validate(expression, timezone, jitter); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	schedule, err := Parse(expression, timezone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule.NextN(t, n), nil
+}
+
+// ParseJitter parses the jitter window of a cron, which is a duration of at least a second and at most a day.
+func ParseJitter(jitter string) (time.Duration, error) {
+	window, err := time.ParseDuration(jitter)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid jitter %q: %w", jitter, err)
+	}
+
+	if window < time.Second || window > maxJitter {
+		return 0, fmt.Errorf("invalid jitter %q: must be between 1s and %s", jitter, maxJitter)
+	}
+
+	return window, nil
+}
+
+// WithJitter returns a copy of the schedule where every fire time is delayed by the same amount of time within the
+// jitter window. The delay is derived from the key, so it is stable for as long as the key is, and crons with
+// different keys are spread over the window. A zero window uses the window of a random shorthand, or leaves the
+// schedule unchanged for other expressions.
+func (s *Schedule) WithJitter(window time.Duration, key string) *Schedule {
+	if window == 0 {
+		window = s.window
+	}
+
+	res := *s
+	res.offset = 0
+
+	if seconds := uint64(window / time.Second); seconds > 0 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+
+		res.offset = time.Duration(h.Sum64()%seconds) * time.Second
+	}
+
+	return &res
+}
+
+// LoadLocation loads an IANA time zone, where an empty time zone is UTC. The local time zone of the server is not
+// allowed, as it differs between instances.
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	if timezone == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", timezone)
+	}
+
+	loc, err := time.LoadLocation(timezone)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timezone, err)
+	}
+
+	return loc, nil
+}
+
+// Location returns the time zone the schedule is evaluated in.
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+// Next returns the first fire time after t, or the zero time if the schedule does not fire within five years.
+//
+// Fire times follow the wall clock of the schedule's time zone across daylight saving time changes. A time which
+// is skipped when the clocks are turned forward fires when the clocks change. A time which is repeated when the
+// clocks are turned back fires once, unless the hour is a wildcard, so hourly and more frequent schedules keep
+// firing through the repeated hour.
+func (s *Schedule) Next(t time.Time) time.Time {
+	return shift(s.next(t.Add(-s.offset)), s.offset)
+}
+
+// NextN returns the next n fire times after t.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	res := make([]time.Time, 0, n)
+
+	for next := s.Next(t); !next.IsZero() && len(res) < n; next = s.Next(next) {
+		res = append(res, next)
+	}
+
+	return res
+}
+
+func (s *Schedule) next(t time.Time) time.Time {
+	spec, ok := s.schedule.(*cron.SpecSchedule)
+
+	// @every schedules are intervals of elapsed time, which are not affected by the time zone
+	if !ok {
+		return s.schedule.Next(t)
+	}
+
+	local := t.In(s.loc)
+
+	// start a day early, as a fire time of the previous day can be after t when the clocks are turned back
+	day := time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < maxSearchDays; i++ {
+		var next time.Time
+
+		for _, fireAt := range s.fireTimesOn(spec, day.AddDate(0, 0, i)) {
+			if fireAt.After(t) && (next.IsZero() || fireAt.Before(next)) {
+				next = fireAt
+			}
+		}
+
+		if !next.IsZero() {
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+// Prev returns the last fire time before t, or the zero time if the schedule did not fire within five years. As
+// @every schedules have no fixed fire times, their previous fire time is one interval before t.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	return shift(s.prev(t.Add(-s.offset)), s.offset)
+}
+
+func (s *Schedule) prev(t time.Time) time.Time {
+	spec, ok := s.schedule.(*cron.SpecSchedule)
+
+	if !ok {
+		if interval, ok := s.schedule.(cron.ConstantDelaySchedule); ok {
+			return t.Add(-interval.Delay).Truncate(time.Second)
+		}
+
+		return time.Time{}
+	}
+
+	local := t.In(s.loc)
+
+	// start a day late, as a fire time of the next day can be before t when the clocks are turned forward
+	day := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < maxSearchDays; i++ {
+		var prev time.Time
+
+		for _, fireAt := range s.fireTimesOn(spec, day.AddDate(0, 0, -i)) {
+			if fireAt.Before(t) && fireAt.After(prev) {
+				prev = fireAt
+			}
+		}
+
+		if !prev.IsZero() {
+			return prev
+		}
+	}
+
+	return time.Time{}
+}
+
+// shift delays a fire time by the offset of a schedule, keeping the zero time which means there is no fire time.
+func shift(t time.Time, offset time.Duration) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	return t.Add(offset)
+}
+
+// fireTimesOn returns the fire times of the schedule on a date of the time zone, which is given in UTC.
+func (s *Schedule) fireTimesOn(spec *cron.SpecSchedule, date time.Time) []time.Time {
+	if !dayMatches(spec, date) {
+		return nil
+	}
+
+	res := []time.Time{}
+
+	for hour := 0; hour < 24; hour++ {
+		if spec.Hour&(1<<uint(hour)) == 0 {
+			continue
+		}
+
+		for minute := 0; minute < 60; minute++ {
+			if spec.Minute&(1<<uint(minute)) == 0 {
+				continue
+			}
+
+			wall := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, time.UTC)
+
+			res = append(res, s.fireTimes(spec, wall)...)
+		}
+	}
+
+	return res
+}
+
+// dayMatches returns true if the schedule fires on the date. Like in standard cron, a date matches either the day
+// of the month or the day of the week if both are restricted.
+func dayMatches(spec *cron.SpecSchedule, date time.Time) bool {
+	if spec.Month&(1<<uint(date.Month())) == 0 {
+		return false
+	}
+
+	domMatch := spec.Dom&(1<<uint(date.Day())) > 0
+	dowMatch := spec.Dow&(1<<uint(date.Weekday())) > 0
+
+	if spec.Dom&starBit > 0 || spec.Dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// fireTimes returns the instants at which the schedule fires for a wall clock time, which is given in UTC.
+func (s *Schedule) fireTimes(spec *cron.SpecSchedule, wall time.Time) []time.Time {
+	offsets := s.offsetsAround(wall)
+	occurrences := []time.Time{}
+
+	for _, offset := range offsets {
+		occurrence := wall.Add(-time.Duration(offset) * time.Second).In(s.loc)
+
+		if isWallClock(occurrence, wall) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].Before(occurrences[j])
+	})
+
+	switch {
+	case len(occurrences) == 0:
+		// the wall clock time was skipped by turning the clocks forward
+		return []time.Time{s.transition(wall, offsets)}
+	case len(occurrences) > 1 && spec.Hour&starBit == 0:
+		// the wall clock time was repeated by turning the clocks back
+		return occurrences[:1]
+	default:
+		return occurrences
+	}
+}
+
+// offsetsAround returns the distinct UTC offsets of the time zone in the day around a wall clock time.
+func (s *Schedule) offsetsAround(wall time.Time) []int {
+	offsets := []int{}
+
+	for _, d := range []time.Duration{-24 * time.Hour, 0, 24 * time.Hour} {
+		_, offset := wall.Add(d).In(s.loc).Zone()
+
+		found := false
+
+		for _, o := range offsets {
+			found = found || o == offset
+		}
+
+		if !found {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	return offsets
+}
+
+// transition returns the instant at which the clocks are turned forward over a skipped wall clock time.
+func (s *Schedule) transition(wall time.Time, offsets []int) time.Time {
+	lo, hi := wall.Unix()-int64(offsets[0]), wall.Unix()-int64(offsets[0])
+
+	for _, offset := range offsets[1:] {
+		lo = min(lo, wall.Unix()-int64(offset))
+		hi = max(hi, wall.Unix()-int64(offset))
+	}
+
+	_, loOffset := time.Unix(lo, 0).In(s.loc).Zone()
+
+	// the clocks change between lo and hi, which are a few hours apart at most
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+
+		if _, offset := time.Unix(mid, 0).In(s.loc).Zone(); offset == loOffset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return time.Unix(hi, 0).In(s.loc)
+}
+
+func isWallClock(t, wall time.Time) bool {
+	return t.Year() == wall.Year() && t.Month() == wall.Month() && t.Day() == wall.Day() &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
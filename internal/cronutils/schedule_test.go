@@ -0,0 +1,440 @@
+package cronutils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	res, err := time.Parse(time.RFC3339, value)
+
+	if err != nil {
+		t.Fatalf("could not parse time %q: %v", value, err)
+	}
+
+	return res
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timezone   string
+		after      string
+		expected   []string
+	}{
+		{
+			name:       "utc by default",
+			expression: "0 9 * * *",
+			after:      "2024-01-01T10:00:00Z",
+			expected:   []string{"2024-01-02T09:00:00Z", "2024-01-03T09:00:00Z"},
+		},
+		{
+			name:       "wall clock of the time zone",
+			expression: "0 9 * * 1-5",
+			timezone:   "Europe/Berlin",
+			after:      "2024-01-05T12:00:00Z",
+			expected:   []string{"2024-01-08T08:00:00Z", "2024-01-09T08:00:00Z"},
+		},
+		{
+			name:       "follows the offset change",
+			expression: "0 9 * * *",
+			timezone:   "America/New_York",
+			after:      "2024-03-09T00:00:00Z",
+			expected:   []string{"2024-03-09T14:00:00Z", "2024-03-10T13:00:00Z"},
+		},
+		{
+			name:       "skipped time fires when the clocks go forward",
+			expression: "30 2 * * *",
+			timezone:   "America/New_York",
+			after:      "2024-03-09T12:00:00-05:00",
+			expected:   []string{"2024-03-10T03:00:00-04:00", "2024-03-11T02:30:00-04:00"},
+		},
+		{
+			name:       "repeated time fires once when the clocks go back",
+			expression: "30 1 * * *",
+			timezone:   "America/New_York",
+			after:      "2024-11-02T12:00:00-04:00",
+			expected:   []string{"2024-11-03T01:30:00-04:00", "2024-11-04T01:30:00-05:00"},
+		},
+		{
+			name:       "hourly skips the missing hour",
+			expression: "0 * * * *",
+			timezone:   "America/New_York",
+			after:      "2024-03-10T00:30:00-05:00",
+			expected:   []string{"2024-03-10T01:00:00-05:00", "2024-03-10T03:00:00-04:00", "2024-03-10T04:00:00-04:00"},
+		},
+		{
+			name:       "hourly fires in both repeated hours",
+			expression: "0 * * * *",
+			timezone:   "America/New_York",
+			after:      "2024-11-03T00:30:00-04:00",
+			expected:   []string{"2024-11-03T01:00:00-04:00", "2024-11-03T01:00:00-05:00", "2024-11-03T02:00:00-05:00"},
+		},
+		{
+			name:       "every minute through the repeated hour",
+			expression: "* * * * *",
+			timezone:   "America/New_York",
+			after:      "2024-11-03T01:59:00-04:00",
+			expected:   []string{"2024-11-03T01:00:00-05:00", "2024-11-03T01:01:00-05:00"},
+		},
+		{
+			name:       "day of month or day of week",
+			expression: "0 0 1 * 1",
+			after:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-08T00:00:00Z", "2024-01-15T00:00:00Z", "2024-01-22T00:00:00Z", "2024-01-29T00:00:00Z", "2024-02-01T00:00:00Z"},
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			timezone:   "Asia/Tokyo",
+			after:      "2024-03-01T00:00:00Z",
+			expected:   []string{"2028-02-28T15:00:00Z"},
+		},
+		{
+			name:       "descriptor",
+			expression: "@daily",
+			timezone:   "Asia/Kolkata",
+			after:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-01T18:30:00Z"},
+		},
+		{
+			name:       "interval",
+			expression: "@every 90m",
+			timezone:   "America/New_York",
+			after:      "2024-03-10T01:00:00-05:00",
+			expected:   []string{"2024-03-10T07:30:00Z", "2024-03-10T09:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression, tt.timezone)
+
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			current := mustParseTime(t, tt.after)
+
+			for i, expected := range tt.expected {
+				current = schedule.Next(current)
+
+				if want := mustParseTime(t, expected); !current.Equal(want) {
+					t.Fatalf("Next() #%d = %s, want %s", i, current.Format(time.RFC3339), want.Format(time.RFC3339))
+				}
+			}
+		})
+	}
+}
+
+func TestSchedulePrev(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timezone   string
+		before     string
+		expected   []string
+	}{
+		{
+			name:       "utc by default",
+			expression: "0 9 * * *",
+			before:     "2024-01-03T09:00:00Z",
+			expected:   []string{"2024-01-02T09:00:00Z", "2024-01-01T09:00:00Z"},
+		},
+		{
+			name:       "skipped time fires when the clocks go forward",
+			expression: "30 2 * * *",
+			timezone:   "America/New_York",
+			before:     "2024-03-11T00:00:00-04:00",
+			expected:   []string{"2024-03-10T03:00:00-04:00", "2024-03-09T02:30:00-05:00"},
+		},
+		{
+			name:       "repeated time fires once when the clocks go back",
+			expression: "30 1 * * *",
+			timezone:   "America/New_York",
+			before:     "2024-11-04T00:00:00-05:00",
+			expected:   []string{"2024-11-03T01:30:00-04:00", "2024-11-02T01:30:00-04:00"},
+		},
+		{
+			name:       "hourly fires in both repeated hours",
+			expression: "0 * * * *",
+			timezone:   "America/New_York",
+			before:     "2024-11-03T02:00:00-05:00",
+			expected:   []string{"2024-11-03T01:00:00-05:00", "2024-11-03T01:00:00-04:00", "2024-11-03T00:00:00-04:00"},
+		},
+		{
+			name:       "interval",
+			expression: "@every 1h",
+			before:     "2024-01-01T12:30:00Z",
+			expected:   []string{"2024-01-01T11:30:00Z", "2024-01-01T10:30:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression, tt.timezone)
+
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			current := mustParseTime(t, tt.before)
+
+			for i, expected := range tt.expected {
+				current = schedule.Prev(current)
+
+				if want := mustParseTime(t, expected); !current.Equal(want) {
+					t.Fatalf("Prev() #%d = %s, want %s", i, current.Format(time.RFC3339), want.Format(time.RFC3339))
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleNextNeverFires(t *testing.T) {
+	schedule, err := Parse("0 0 30 2 *", "UTC")
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Next() = %s, want zero time", next)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timezone   string
+	}{
+		{name: "invalid expression", expression: "* * *"},
+		{name: "out of range", expression: "61 * * * *"},
+		{name: "seconds field", expression: "0 0 * * * *"},
+		{name: "inline time zone", expression: "CRON_TZ=Europe/Berlin 0 9 * * *"},
+		{name: "unknown time zone", expression: "0 9 * * *", timezone: "Mars/Olympus_Mons"},
+		{name: "server local time zone", expression: "0 9 * * *", timezone: "Local"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.expression, tt.timezone); err == nil {
+				t.Errorf("Parse(%q, %q) expected an error", tt.expression, tt.timezone)
+			}
+		})
+	}
+}
+
+func TestScheduleWithJitter(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		window     time.Duration
+		interval   time.Duration
+	}{
+		{name: "jitter window", expression: "0 * * * *", window: 10 * time.Minute, interval: time.Hour},
+		{name: "random 15 min", expression: "random_15_min", interval: 15 * time.Minute},
+		{name: "random hourly", expression: "random_hourly", interval: time.Hour},
+		{name: "random daily", expression: "random_daily", interval: 24 * time.Hour},
+	}
+
+	after := mustParseTime(t, "2024-01-01T00:00:00Z")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression, "UTC")
+
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			jittered := schedule.WithJitter(tt.window, "workflow-"+tt.name)
+			fireTimes := jittered.NextN(after, 3)
+
+			if len(fireTimes) != 3 {
+				t.Fatalf("NextN() returned %d fire times, want 3", len(fireTimes))
+			}
+
+			window := tt.window
+
+			if window == 0 {
+				window = tt.interval
+			}
+
+			for i, fireAt := range fireTimes {
+				base := after.Add(time.Duration(i) * tt.interval)
+
+				if fireAt.Before(base) || !fireAt.Before(base.Add(window)) {
+					t.Errorf("fire time #%d = %s, want within %s of %s", i, fireAt.Format(time.RFC3339), window, base.Format(time.RFC3339))
+				}
+
+				if i > 0 && fireAt.Sub(fireTimes[i-1]) != tt.interval {
+					t.Errorf("fire time #%d = %s, want %s after the previous one", i, fireAt.Format(time.RFC3339), tt.interval)
+				}
+
+				if prev := jittered.Prev(fireAt.Add(time.Second)); !prev.Equal(fireAt) {
+					t.Errorf("Prev() = %s, want %s", prev.Format(time.RFC3339), fireAt.Format(time.RFC3339))
+				}
+			}
+
+			if again := schedule.WithJitter(tt.window, "workflow-"+tt.name).Next(after); !again.Equal(fireTimes[0]) {
+				t.Errorf("Next() = %s with the same key, want %s", again.Format(time.RFC3339), fireTimes[0].Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestScheduleWithJitterSpreadsKeys(t *testing.T) {
+	schedule, err := Parse("random_hourly", "UTC")
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	after := mustParseTime(t, "2024-01-01T00:00:00Z")
+	fireTimes := map[time.Time]bool{}
+
+	for i := 0; i < 10; i++ {
+		fireTimes[schedule.WithJitter(0, fmt.Sprintf("workflow-%d", i)).Next(after)] = true
+	}
+
+	if len(fireTimes) < 5 {
+		t.Errorf("10 keys fire at %d distinct times, want them spread over the hour", len(fireTimes))
+	}
+}
+
+func TestScheduleWithoutJitter(t *testing.T) {
+	schedule, err := Parse("0 * * * *", "UTC")
+
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	after := mustParseTime(t, "2024-01-01T00:30:00Z")
+	want := mustParseTime(t, "2024-01-01T01:00:00Z")
+
+	if next := schedule.WithJitter(0, "workflow").Next(after); !next.Equal(want) {
+		t.Errorf("Next() = %s, want %s", next.Format(time.RFC3339), want.Format(time.RFC3339))
+	}
+}
+
+func TestParseJitter(t *testing.T) {
+	tests := []struct {
+		jitter  string
+		wantErr bool
+	}{
+		{jitter: "30s"},
+		{jitter: "1h"},
+		{jitter: "24h"},
+		{jitter: "500ms", wantErr: true},
+		{jitter: "25h", wantErr: true},
+		{jitter: "-1m", wantErr: true},
+		{jitter: "often", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.jitter, func(t *testing.T) {
+			if _, err := ParseJitter(tt.jitter); (err != nil) != tt.wantErr {
+				t.Errorf("ParseJitter(%q) error = %v, wantErr %v", tt.jitter, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseForWorkflow(t *testing.T) {
+	after := mustParseTime(t, "2024-01-01T00:00:00Z")
+
+	first, err := ParseForWorkflow("workflow-1", "0 * * * *", "UTC", "30m")
+
+	if err != nil {
+		t.Fatalf("ParseForWorkflow() error = %v", err)
+	}
+
+	second, err := ParseForWorkflow("workflow-1", "0 * * * *", "UTC", "30m")
+
+	if err != nil {
+		t.Fatalf("ParseForWorkflow() error = %v", err)
+	}
+
+	if !first.Next(after).Equal(second.Next(after)) {
+		t.Errorf("Next() = %s and %s, want the same fire time for the same workflow", first.Next(after), second.Next(after))
+	}
+
+	if _, err := ParseForWorkflow("workflow-1", "0 * * * *", "UTC", "2d"); err == nil {
+		t.Errorf("ParseForWorkflow() expected an error for an invalid jitter")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timezone   string
+		jitter     string
+		fields     []string
+	}{
+		{name: "valid", expression: "0 9 * * *", timezone: "Europe/Berlin", jitter: "5m"},
+		{name: "random shorthand", expression: "random_hourly"},
+		{name: "invalid expression", expression: "61 * * * *", fields: []string{"cron"}},
+		{name: "invalid time zone", expression: "0 9 * * *", timezone: "Mars/Olympus_Mons", fields: []string{"timezone"}},
+		{name: "invalid jitter", expression: "0 9 * * *", jitter: "2d", fields: []string{"jitter"}},
+		{name: "every field", expression: "* * *", timezone: "Local", jitter: "0s", fields: []string{"timezone", "cron", "jitter"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(tt.expression, tt.timezone, tt.jitter)
+
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("Validate() returned %d errors, want %d: %v", len(errs), len(tt.fields), errs)
+			}
+
+			for i, err := range errs {
+				if err.Field != tt.fields[i] {
+					t.Errorf("Validate() error #%d is for field %q, want %q", i, err.Field, tt.fields[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	after := mustParseTime(t, "2024-01-01T00:30:00Z")
+
+	fireTimes, err := Preview("", "random_hourly", "", "", after, 2)
+
+	if err != nil {
+		t.Fatalf("Preview() error = %v", err)
+	}
+
+	want := []time.Time{mustParseTime(t, "2024-01-01T01:00:00Z"), mustParseTime(t, "2024-01-01T02:00:00Z")}
+
+	if len(fireTimes) != len(want) || !fireTimes[0].Equal(want[0]) || !fireTimes[1].Equal(want[1]) {
+		t.Errorf("Preview() = %v, want %v without a workflow", fireTimes, want)
+	}
+
+	jittered, err := Preview("workflow-1", "random_hourly", "", "", after, 2)
+
+	if err != nil {
+		t.Fatalf("Preview() error = %v", err)
+	}
+
+	schedule, err := ParseForWorkflow("workflow-1", "random_hourly", "", "")
+
+	if err != nil {
+		t.Fatalf("ParseForWorkflow() error = %v", err)
+	}
+
+	if !jittered[0].Equal(schedule.Next(after)) {
+		t.Errorf("Preview() = %s, want %s for the workflow", jittered[0], schedule.Next(after))
+	}
+
+	if _, err := Preview("", "0 9 * * *", "", "2d", after, 2); err == nil {
+		t.Errorf("Preview() expected an error for an invalid jitter")
+	}
+}
@@ -0,0 +1,52 @@
+package datautils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EvalKeyExpression resolves a dot-separated key expression, such as input.customer_id, against the data map and
+// returns the resulting value as a string. Non-string values are JSON-encoded. It returns an error if any segment
+// of the path does not exist or if the value is null.
+func EvalKeyExpression(expr string, data map[string]interface{}) (string, error) {
+	if expr == "" {
+		return "", fmt.Errorf("key expression is empty")
+	}
+
+	var curr interface{} = data
+
+	for _, segment := range strings.Split(expr, ".") {
+		m, ok := curr.(map[string]interface{})
+
+		if !ok {
+			return "", fmt.Errorf("could not evaluate key expression %s: %s is not an object", expr, segment)
+		}
+
+		curr, ok = m[segment]
+
+		if !ok {
+			return "", fmt.Errorf("could not evaluate key expression %s: %s does not exist", expr, segment)
+		}
+	}
+
+	switch v := curr.(type) {
+	case nil:
+		return "", fmt.Errorf("could not evaluate key expression %s: value is null", expr)
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return "", fmt.Errorf("could not evaluate key expression %s: %w", expr, err)
+		}
+
+		return string(b), nil
+	}
+}
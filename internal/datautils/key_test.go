@@ -0,0 +1,87 @@
+package datautils
+
+import (
+	"testing"
+)
+
+func TestEvalKeyExpression(t *testing.T) {
+	data := map[string]interface{}{
+		"input": map[string]interface{}{
+			"customer_id": "cust-123",
+			"count":       float64(42),
+			"enabled":     true,
+			"empty":       nil,
+			"nested": map[string]interface{}{
+				"region": "us-east-1",
+			},
+			"tags": []interface{}{"a", "b"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "string value",
+			expr:     "input.customer_id",
+			expected: "cust-123",
+		},
+		{
+			name:     "number value",
+			expr:     "input.count",
+			expected: "42",
+		},
+		{
+			name:     "bool value",
+			expr:     "input.enabled",
+			expected: "true",
+		},
+		{
+			name:     "nested value",
+			expr:     "input.nested.region",
+			expected: "us-east-1",
+		},
+		{
+			name:     "array value",
+			expr:     "input.tags",
+			expected: `["a","b"]`,
+		},
+		{
+			name:    "missing key",
+			expr:    "input.missing",
+			wantErr: true,
+		},
+		{
+			name:    "null value",
+			expr:    "input.empty",
+			wantErr: true,
+		},
+		{
+			name:    "path through non-object",
+			expr:    "input.customer_id.value",
+			wantErr: true,
+		},
+		{
+			name:    "empty expression",
+			expr:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvalKeyExpression(tt.expr, data)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EvalKeyExpression() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.expected {
+				t.Errorf("EvalKeyExpression() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
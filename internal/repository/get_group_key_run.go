@@ -16,6 +16,9 @@ type UpdateGetGroupKeyRunOpts struct {
 
 	ScheduleTimeoutAt *time.Time
 
+	// when the get group key run times out, which is polled by the tickers
+	TimeoutAt *time.Time
+
 	Status *db.StepRunStatus
 
 	StartedAt *time.Time
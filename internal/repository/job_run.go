@@ -1,11 +1,16 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 )
 
 type UpdateJobRunOpts struct {
 	Status *db.JobRunStatus
+
+	// when the job run times out, which is polled by the tickers
+	TimeoutAt *time.Time
 }
 
 type UpdateJobRunLookupDataOpts struct {
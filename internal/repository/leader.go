@@ -0,0 +1,19 @@
+package repository
+
+import "context"
+
+// LeaderLease is a held leader lock. The lock is held until it is released or the connection which holds it is
+// lost.
+type LeaderLease interface {
+	// Alive returns true if the lock is still held.
+	Alive(ctx context.Context) bool
+
+	// Release releases the lock so another instance can acquire it.
+	Release(ctx context.Context) error
+}
+
+type LeaderRepository interface {
+	// TryAcquire tries to acquire the leader lock with the given name without waiting. It returns a nil lease if
+	// the lock is held by another instance.
+	TryAcquire(ctx context.Context, name string) (LeaderLease, error)
+}
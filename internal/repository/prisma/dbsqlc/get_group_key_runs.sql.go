@@ -85,19 +85,20 @@ SET
     "startedAt" = COALESCE($2::timestamp, "startedAt"),
     "finishedAt" = COALESCE($3::timestamp, "finishedAt"),
     "scheduleTimeoutAt" = COALESCE($4::timestamp, "scheduleTimeoutAt"),
+    "timeoutAt" = COALESCE($5::timestamp, "timeoutAt"),
     "status" = CASE 
         -- Final states are final, cannot be updated
         WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE COALESCE($5, "status")
+        ELSE COALESCE($6, "status")
     END,
-    "input" = COALESCE($6::jsonb, "input"),
-    "output" = COALESCE($7::text, "output"),
-    "error" = COALESCE($8::text, "error"),
-    "cancelledAt" = COALESCE($9::timestamp, "cancelledAt"),
-    "cancelledReason" = COALESCE($10::text, "cancelledReason")
+    "input" = COALESCE($7::jsonb, "input"),
+    "output" = COALESCE($8::text, "output"),
+    "error" = COALESCE($9::text, "error"),
+    "cancelledAt" = COALESCE($10::timestamp, "cancelledAt"),
+    "cancelledReason" = COALESCE($11::text, "cancelledReason")
 WHERE 
-  "id" = $11::uuid AND
-  "tenantId" = $12::uuid
+  "id" = $12::uuid AND
+  "tenantId" = $13::uuid
 RETURNING "GetGroupKeyRun".id, "GetGroupKeyRun"."createdAt", "GetGroupKeyRun"."updatedAt", "GetGroupKeyRun"."deletedAt", "GetGroupKeyRun"."tenantId", "GetGroupKeyRun"."workerId", "GetGroupKeyRun"."tickerId", "GetGroupKeyRun".status, "GetGroupKeyRun".input, "GetGroupKeyRun".output, "GetGroupKeyRun"."requeueAfter", "GetGroupKeyRun".error, "GetGroupKeyRun"."startedAt", "GetGroupKeyRun"."finishedAt", "GetGroupKeyRun"."timeoutAt", "GetGroupKeyRun"."cancelledAt", "GetGroupKeyRun"."cancelledReason", "GetGroupKeyRun"."cancelledError", "GetGroupKeyRun"."workflowRunId", "GetGroupKeyRun"."scheduleTimeoutAt"
 `
 
@@ -106,6 +107,7 @@ type UpdateGetGroupKeyRunParams struct {
 	StartedAt         pgtype.Timestamp  `json:"startedAt"`
 	FinishedAt        pgtype.Timestamp  `json:"finishedAt"`
 	ScheduleTimeoutAt pgtype.Timestamp  `json:"scheduleTimeoutAt"`
+	TimeoutAt         pgtype.Timestamp  `json:"timeoutAt"`
 	Status            NullStepRunStatus `json:"status"`
 	Input             []byte            `json:"input"`
 	Output            pgtype.Text       `json:"output"`
@@ -122,6 +124,7 @@ func (q *Queries) UpdateGetGroupKeyRun(ctx context.Context, db DBTX, arg UpdateG
 		arg.StartedAt,
 		arg.FinishedAt,
 		arg.ScheduleTimeoutAt,
+		arg.TimeoutAt,
 		arg.Status,
 		arg.Input,
 		arg.Output,
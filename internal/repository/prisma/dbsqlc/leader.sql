@@ -0,0 +1,5 @@
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(@key::bigint)::boolean AS "locked";
+
+-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock(@key::bigint)::boolean AS "unlocked";
\ No newline at end of file
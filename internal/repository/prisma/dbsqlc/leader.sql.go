@@ -0,0 +1,32 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: leader.sql
+
+package dbsqlc
+
+import (
+	"context"
+)
+
+const advisoryUnlock = `-- name: AdvisoryUnlock :one
+SELECT pg_advisory_unlock($1::bigint)::boolean AS "unlocked"
+`
+
+func (q *Queries) AdvisoryUnlock(ctx context.Context, db DBTX, key int64) (bool, error) {
+	row := db.QueryRow(ctx, advisoryUnlock, key)
+	var unlocked bool
+	err := row.Scan(&unlocked)
+	return unlocked, err
+}
+
+const tryAdvisoryLock = `-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock($1::bigint)::boolean AS "locked"
+`
+
+func (q *Queries) TryAdvisoryLock(ctx context.Context, db DBTX, key int64) (bool, error) {
+	row := db.QueryRow(ctx, tryAdvisoryLock, key)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
@@ -55,6 +55,49 @@ func (ns NullConcurrencyLimitStrategy) Value() (driver.Value, error) {
 	return string(ns.ConcurrencyLimitStrategy), nil
 }
 
+type CronMisfirePolicy string
+
+const (
+	CronMisfirePolicySKIP    CronMisfirePolicy = "SKIP"
+	CronMisfirePolicyRUNONCE CronMisfirePolicy = "RUN_ONCE"
+	CronMisfirePolicyRUNALL  CronMisfirePolicy = "RUN_ALL"
+)
+
+func (e *CronMisfirePolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CronMisfirePolicy(s)
+	case string:
+		*e = CronMisfirePolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CronMisfirePolicy: %T", src)
+	}
+	return nil
+}
+
+type NullCronMisfirePolicy struct {
+	CronMisfirePolicy CronMisfirePolicy `json:"CronMisfirePolicy"`
+	Valid             bool              `json:"valid"` // Valid is true if CronMisfirePolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCronMisfirePolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.CronMisfirePolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CronMisfirePolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCronMisfirePolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CronMisfirePolicy), nil
+}
+
 type InviteLinkStatus string
 
 const (
@@ -318,6 +361,93 @@ func (ns NullVcsProvider) Value() (driver.Value, error) {
 	return string(ns.VcsProvider), nil
 }
 
+type WorkerSchedulingStatus string
+
+const (
+	WorkerSchedulingStatusACTIVE   WorkerSchedulingStatus = "ACTIVE"
+	WorkerSchedulingStatusPAUSED   WorkerSchedulingStatus = "PAUSED"
+	WorkerSchedulingStatusDRAINING WorkerSchedulingStatus = "DRAINING"
+)
+
+func (e *WorkerSchedulingStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkerSchedulingStatus(s)
+	case string:
+		*e = WorkerSchedulingStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkerSchedulingStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWorkerSchedulingStatus struct {
+	WorkerSchedulingStatus WorkerSchedulingStatus `json:"WorkerSchedulingStatus"`
+	Valid                  bool                   `json:"valid"` // Valid is true if WorkerSchedulingStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkerSchedulingStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkerSchedulingStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkerSchedulingStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkerSchedulingStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkerSchedulingStatus), nil
+}
+
+type WorkerSelectionStrategy string
+
+const (
+	WorkerSelectionStrategyLEASTLOADED         WorkerSelectionStrategy = "LEAST_LOADED"
+	WorkerSelectionStrategyROUNDROBIN          WorkerSelectionStrategy = "ROUND_ROBIN"
+	WorkerSelectionStrategyRANDOM              WorkerSelectionStrategy = "RANDOM"
+	WorkerSelectionStrategyMOSTRECENTHEARTBEAT WorkerSelectionStrategy = "MOST_RECENT_HEARTBEAT"
+)
+
+func (e *WorkerSelectionStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkerSelectionStrategy(s)
+	case string:
+		*e = WorkerSelectionStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkerSelectionStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullWorkerSelectionStrategy struct {
+	WorkerSelectionStrategy WorkerSelectionStrategy `json:"WorkerSelectionStrategy"`
+	Valid                   bool                    `json:"valid"` // Valid is true if WorkerSelectionStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkerSelectionStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkerSelectionStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkerSelectionStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkerSelectionStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkerSelectionStrategy), nil
+}
+
 type WorkerStatus string
 
 const (
@@ -360,6 +490,94 @@ func (ns NullWorkerStatus) Value() (driver.Value, error) {
 	return string(ns.WorkerStatus), nil
 }
 
+type WorkflowRunEventResourceType string
+
+const (
+	WorkflowRunEventResourceTypeSTEPRUN     WorkflowRunEventResourceType = "STEP_RUN"
+	WorkflowRunEventResourceTypeWORKFLOWRUN WorkflowRunEventResourceType = "WORKFLOW_RUN"
+)
+
+func (e *WorkflowRunEventResourceType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowRunEventResourceType(s)
+	case string:
+		*e = WorkflowRunEventResourceType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowRunEventResourceType: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowRunEventResourceType struct {
+	WorkflowRunEventResourceType WorkflowRunEventResourceType `json:"WorkflowRunEventResourceType"`
+	Valid                        bool                         `json:"valid"` // Valid is true if WorkflowRunEventResourceType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowRunEventResourceType) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowRunEventResourceType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowRunEventResourceType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowRunEventResourceType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowRunEventResourceType), nil
+}
+
+type WorkflowRunEventType string
+
+const (
+	WorkflowRunEventTypeSTARTED   WorkflowRunEventType = "STARTED"
+	WorkflowRunEventTypeCOMPLETED WorkflowRunEventType = "COMPLETED"
+	WorkflowRunEventTypeFAILED    WorkflowRunEventType = "FAILED"
+	WorkflowRunEventTypeCANCELLED WorkflowRunEventType = "CANCELLED"
+	WorkflowRunEventTypeTIMEDOUT  WorkflowRunEventType = "TIMED_OUT"
+	WorkflowRunEventTypePROGRESS  WorkflowRunEventType = "PROGRESS"
+)
+
+func (e *WorkflowRunEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowRunEventType(s)
+	case string:
+		*e = WorkflowRunEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowRunEventType: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowRunEventType struct {
+	WorkflowRunEventType WorkflowRunEventType `json:"WorkflowRunEventType"`
+	Valid                bool                 `json:"valid"` // Valid is true if WorkflowRunEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowRunEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowRunEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowRunEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowRunEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowRunEventType), nil
+}
+
 type WorkflowRunStatus string
 
 const (
@@ -625,18 +843,20 @@ type ServiceToWorker struct {
 }
 
 type Step struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	ReadableId      pgtype.Text      `json:"readableId"`
-	TenantId        pgtype.UUID      `json:"tenantId"`
-	JobId           pgtype.UUID      `json:"jobId"`
-	ActionId        string           `json:"actionId"`
-	Timeout         pgtype.Text      `json:"timeout"`
-	CustomUserData  []byte           `json:"customUserData"`
-	Retries         int32            `json:"retries"`
-	ScheduleTimeout string           `json:"scheduleTimeout"`
+	ID                 pgtype.UUID      `json:"id"`
+	CreatedAt          pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt          pgtype.Timestamp `json:"deletedAt"`
+	ReadableId         pgtype.Text      `json:"readableId"`
+	TenantId           pgtype.UUID      `json:"tenantId"`
+	JobId              pgtype.UUID      `json:"jobId"`
+	ActionId           string           `json:"actionId"`
+	Timeout            pgtype.Text      `json:"timeout"`
+	CustomUserData     []byte           `json:"customUserData"`
+	Retries            int32            `json:"retries"`
+	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	ConcurrencyKeyExpr pgtype.Text      `json:"concurrencyKeyExpr"`
+	ConcurrencyMaxRuns pgtype.Int4      `json:"concurrencyMaxRuns"`
 }
 
 type StepOrder struct {
@@ -645,32 +865,37 @@ type StepOrder struct {
 }
 
 type StepRun struct {
-	ID                pgtype.UUID      `json:"id"`
-	CreatedAt         pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt         pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt         pgtype.Timestamp `json:"deletedAt"`
-	TenantId          pgtype.UUID      `json:"tenantId"`
-	JobRunId          pgtype.UUID      `json:"jobRunId"`
-	StepId            pgtype.UUID      `json:"stepId"`
-	Order             int64            `json:"order"`
-	WorkerId          pgtype.UUID      `json:"workerId"`
-	TickerId          pgtype.UUID      `json:"tickerId"`
-	Status            StepRunStatus    `json:"status"`
-	Input             []byte           `json:"input"`
-	Output            []byte           `json:"output"`
-	RequeueAfter      pgtype.Timestamp `json:"requeueAfter"`
-	ScheduleTimeoutAt pgtype.Timestamp `json:"scheduleTimeoutAt"`
-	Error             pgtype.Text      `json:"error"`
-	StartedAt         pgtype.Timestamp `json:"startedAt"`
-	FinishedAt        pgtype.Timestamp `json:"finishedAt"`
-	TimeoutAt         pgtype.Timestamp `json:"timeoutAt"`
-	CancelledAt       pgtype.Timestamp `json:"cancelledAt"`
-	CancelledReason   pgtype.Text      `json:"cancelledReason"`
-	CancelledError    pgtype.Text      `json:"cancelledError"`
-	InputSchema       []byte           `json:"inputSchema"`
-	CallerFiles       []byte           `json:"callerFiles"`
-	GitRepoBranch     pgtype.Text      `json:"gitRepoBranch"`
-	RetryCount        int32            `json:"retryCount"`
+	ID                   pgtype.UUID      `json:"id"`
+	CreatedAt            pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt            pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt            pgtype.Timestamp `json:"deletedAt"`
+	TenantId             pgtype.UUID      `json:"tenantId"`
+	JobRunId             pgtype.UUID      `json:"jobRunId"`
+	StepId               pgtype.UUID      `json:"stepId"`
+	Order                int64            `json:"order"`
+	WorkerId             pgtype.UUID      `json:"workerId"`
+	TickerId             pgtype.UUID      `json:"tickerId"`
+	Status               StepRunStatus    `json:"status"`
+	Input                []byte           `json:"input"`
+	Output               []byte           `json:"output"`
+	RequeueAfter         pgtype.Timestamp `json:"requeueAfter"`
+	ScheduleTimeoutAt    pgtype.Timestamp `json:"scheduleTimeoutAt"`
+	Error                pgtype.Text      `json:"error"`
+	StartedAt            pgtype.Timestamp `json:"startedAt"`
+	FinishedAt           pgtype.Timestamp `json:"finishedAt"`
+	TimeoutAt            pgtype.Timestamp `json:"timeoutAt"`
+	CancelledAt          pgtype.Timestamp `json:"cancelledAt"`
+	CancelledReason      pgtype.Text      `json:"cancelledReason"`
+	CancelledError       pgtype.Text      `json:"cancelledError"`
+	InputSchema          []byte           `json:"inputSchema"`
+	CallerFiles          []byte           `json:"callerFiles"`
+	GitRepoBranch        pgtype.Text      `json:"gitRepoBranch"`
+	RetryCount           int32            `json:"retryCount"`
+	ConcurrencyKey       pgtype.Text      `json:"concurrencyKey"`
+	Progress             pgtype.Int4      `json:"progress"`
+	ProgressMessage      pgtype.Text      `json:"progressMessage"`
+	AckedAt              pgtype.Timestamp `json:"ackedAt"`
+	ShutdownRequeueCount int32            `json:"shutdownRequeueCount"`
 }
 
 type StepRunOrder struct {
@@ -697,12 +922,13 @@ type StepRunResultArchive struct {
 }
 
 type Tenant struct {
-	ID        pgtype.UUID      `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt pgtype.Timestamp `json:"deletedAt"`
-	Name      string           `json:"name"`
-	Slug      string           `json:"slug"`
+	ID                      pgtype.UUID             `json:"id"`
+	CreatedAt               pgtype.Timestamp        `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp        `json:"updatedAt"`
+	DeletedAt               pgtype.Timestamp        `json:"deletedAt"`
+	Name                    string                  `json:"name"`
+	Slug                    string                  `json:"slug"`
+	WorkerSelectionStrategy WorkerSelectionStrategy `json:"workerSelectionStrategy"`
 }
 
 type TenantInviteLink struct {
@@ -781,36 +1007,51 @@ type UserSession struct {
 }
 
 type Worker struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	TenantId        pgtype.UUID      `json:"tenantId"`
-	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
-	Name            string           `json:"name"`
-	Status          WorkerStatus     `json:"status"`
-	DispatcherId    pgtype.UUID      `json:"dispatcherId"`
-	MaxRuns         pgtype.Int4      `json:"maxRuns"`
+	ID               pgtype.UUID            `json:"id"`
+	CreatedAt        pgtype.Timestamp       `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp       `json:"updatedAt"`
+	DeletedAt        pgtype.Timestamp       `json:"deletedAt"`
+	TenantId         pgtype.UUID            `json:"tenantId"`
+	LastHeartbeatAt  pgtype.Timestamp       `json:"lastHeartbeatAt"`
+	Name             string                 `json:"name"`
+	Status           WorkerStatus           `json:"status"`
+	DispatcherId     pgtype.UUID            `json:"dispatcherId"`
+	MaxRuns          pgtype.Int4            `json:"maxRuns"`
+	SchedulingStatus WorkerSchedulingStatus `json:"schedulingStatus"`
+}
+
+type WorkerActionSlot struct {
+	ID       pgtype.UUID `json:"id"`
+	WorkerId pgtype.UUID `json:"workerId"`
+	ActionId string      `json:"actionId"`
+	MaxRuns  int32       `json:"maxRuns"`
 }
 
 type Workflow struct {
-	ID          pgtype.UUID      `json:"id"`
-	CreatedAt   pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt   pgtype.Timestamp `json:"deletedAt"`
-	TenantId    pgtype.UUID      `json:"tenantId"`
-	Name        string           `json:"name"`
-	Description pgtype.Text      `json:"description"`
+	ID                     pgtype.UUID      `json:"id"`
+	CreatedAt              pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt              pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt              pgtype.Timestamp `json:"deletedAt"`
+	TenantId               pgtype.UUID      `json:"tenantId"`
+	Name                   string           `json:"name"`
+	Description            pgtype.Text      `json:"description"`
+	IsPaused               bool             `json:"isPaused"`
+	PausedAt               pgtype.Timestamp `json:"pausedAt"`
+	QueueEventsWhilePaused bool             `json:"queueEventsWhilePaused"`
+	PinnedVersionId        pgtype.UUID      `json:"pinnedVersionId"`
+	CanaryVersionId        pgtype.UUID      `json:"canaryVersionId"`
+	CanaryPercentage       pgtype.Int4      `json:"canaryPercentage"`
 }
 
 type WorkflowConcurrency struct {
-	ID                    pgtype.UUID              `json:"id"`
-	CreatedAt             pgtype.Timestamp         `json:"createdAt"`
-	UpdatedAt             pgtype.Timestamp         `json:"updatedAt"`
-	WorkflowVersionId     pgtype.UUID              `json:"workflowVersionId"`
-	GetConcurrencyGroupId pgtype.UUID              `json:"getConcurrencyGroupId"`
-	MaxRuns               int32                    `json:"maxRuns"`
-	LimitStrategy         ConcurrencyLimitStrategy `json:"limitStrategy"`
+	ID                         pgtype.UUID              `json:"id"`
+	CreatedAt                  pgtype.Timestamp         `json:"createdAt"`
+	UpdatedAt                  pgtype.Timestamp         `json:"updatedAt"`
+	WorkflowVersionId          pgtype.UUID              `json:"workflowVersionId"`
+	GetConcurrencyGroupId      pgtype.UUID              `json:"getConcurrencyGroupId"`
+	MaxRuns                    int32                    `json:"maxRuns"`
+	LimitStrategy              ConcurrencyLimitStrategy `json:"limitStrategy"`
+	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
 }
 
 type WorkflowDeploymentConfig struct {
@@ -825,6 +1066,13 @@ type WorkflowDeploymentConfig struct {
 	GithubAppInstallationId pgtype.UUID      `json:"githubAppInstallationId"`
 }
 
+type WorkflowHeldEvent struct {
+	ID         pgtype.UUID      `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	WorkflowId pgtype.UUID      `json:"workflowId"`
+	EventId    pgtype.UUID      `json:"eventId"`
+}
+
 type WorkflowRun struct {
 	CreatedAt          pgtype.Timestamp  `json:"createdAt"`
 	UpdatedAt          pgtype.Timestamp  `json:"updatedAt"`
@@ -839,6 +1087,18 @@ type WorkflowRun struct {
 	DisplayName        pgtype.Text       `json:"displayName"`
 	ID                 pgtype.UUID       `json:"id"`
 	GitRepoBranch      pgtype.Text       `json:"gitRepoBranch"`
+	LastEventSequence  int64             `json:"lastEventSequence"`
+}
+
+type WorkflowRunEvent struct {
+	WorkflowRunId pgtype.UUID                  `json:"workflowRunId"`
+	Sequence      int64                        `json:"sequence"`
+	CreatedAt     pgtype.Timestamp             `json:"createdAt"`
+	TenantId      pgtype.UUID                  `json:"tenantId"`
+	ResourceType  WorkflowRunEventResourceType `json:"resourceType"`
+	ResourceId    pgtype.UUID                  `json:"resourceId"`
+	EventType     WorkflowRunEventType         `json:"eventType"`
+	Payload       pgtype.Text                  `json:"payload"`
 }
 
 type WorkflowRunTriggeredBy struct {
@@ -853,6 +1113,8 @@ type WorkflowRunTriggeredBy struct {
 	ScheduledId  pgtype.UUID      `json:"scheduledId"`
 	Input        []byte           `json:"input"`
 	ParentId     pgtype.UUID      `json:"parentId"`
+	CronFireAt   pgtype.Timestamp `json:"cronFireAt"`
+	CronTimezone pgtype.Text      `json:"cronTimezone"`
 }
 
 type WorkflowTag struct {
@@ -870,10 +1132,15 @@ type WorkflowToWorkflowTag struct {
 }
 
 type WorkflowTriggerCronRef struct {
-	ParentId pgtype.UUID `json:"parentId"`
-	Cron     string      `json:"cron"`
-	TickerId pgtype.UUID `json:"tickerId"`
-	Input    []byte      `json:"input"`
+	ParentId      pgtype.UUID       `json:"parentId"`
+	Cron          string            `json:"cron"`
+	TickerId      pgtype.UUID       `json:"tickerId"`
+	Input         []byte            `json:"input"`
+	Timezone      string            `json:"timezone"`
+	MisfirePolicy CronMisfirePolicy `json:"misfirePolicy"`
+	MaxMisfires   pgtype.Int4       `json:"maxMisfires"`
+	LastFiredAt   pgtype.Timestamp  `json:"lastFiredAt"`
+	Jitter        pgtype.Text       `json:"jitter"`
 }
 
 type WorkflowTriggerEventRef struct {
@@ -887,6 +1154,8 @@ type WorkflowTriggerScheduledRef struct {
 	TriggerAt pgtype.Timestamp `json:"triggerAt"`
 	TickerId  pgtype.UUID      `json:"tickerId"`
 	Input     []byte           `json:"input"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
 }
 
 type WorkflowTriggers struct {
@@ -899,13 +1168,14 @@ type WorkflowTriggers struct {
 }
 
 type WorkflowVersion struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp `json:"deletedAt"`
-	Version         pgtype.Text      `json:"version"`
-	Order           int64            `json:"order"`
-	WorkflowId      pgtype.UUID      `json:"workflowId"`
-	Checksum        string           `json:"checksum"`
-	ScheduleTimeout string           `json:"scheduleTimeout"`
+	ID                      pgtype.UUID                 `json:"id"`
+	CreatedAt               pgtype.Timestamp            `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp            `json:"updatedAt"`
+	DeletedAt               pgtype.Timestamp            `json:"deletedAt"`
+	Version                 pgtype.Text                 `json:"version"`
+	Order                   int64                       `json:"order"`
+	WorkflowId              pgtype.UUID                 `json:"workflowId"`
+	Checksum                string                      `json:"checksum"`
+	ScheduleTimeout         string                      `json:"scheduleTimeout"`
+	WorkerSelectionStrategy NullWorkerSelectionStrategy `json:"workerSelectionStrategy"`
 }
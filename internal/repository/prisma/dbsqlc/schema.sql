@@ -1,6 +1,9 @@
 -- CreateEnum
 CREATE TYPE "ConcurrencyLimitStrategy" AS ENUM ('CANCEL_IN_PROGRESS', 'DROP_NEWEST', 'QUEUE_NEWEST', 'GROUP_ROUND_ROBIN');
 
+-- CreateEnum
+CREATE TYPE "CronMisfirePolicy" AS ENUM ('SKIP', 'RUN_ONCE', 'RUN_ALL');
+
 -- CreateEnum
 CREATE TYPE "InviteLinkStatus" AS ENUM ('PENDING', 'ACCEPTED', 'REJECTED');
 
@@ -19,9 +22,21 @@ CREATE TYPE "TenantMemberRole" AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
 -- CreateEnum
 CREATE TYPE "VcsProvider" AS ENUM ('GITHUB');
 
+-- CreateEnum
+CREATE TYPE "WorkerSchedulingStatus" AS ENUM ('ACTIVE', 'PAUSED', 'DRAINING');
+
+-- CreateEnum
+CREATE TYPE "WorkerSelectionStrategy" AS ENUM ('LEAST_LOADED', 'ROUND_ROBIN', 'RANDOM', 'MOST_RECENT_HEARTBEAT');
+
 -- CreateEnum
 CREATE TYPE "WorkerStatus" AS ENUM ('ACTIVE', 'INACTIVE');
 
+-- CreateEnum
+CREATE TYPE "WorkflowRunEventResourceType" AS ENUM ('STEP_RUN', 'WORKFLOW_RUN');
+
+-- CreateEnum
+CREATE TYPE "WorkflowRunEventType" AS ENUM ('STARTED', 'COMPLETED', 'FAILED', 'CANCELLED', 'TIMED_OUT', 'PROGRESS');
+
 -- CreateEnum
 CREATE TYPE "WorkflowRunStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'QUEUED');
 
@@ -281,6 +296,8 @@ CREATE TABLE "Step" (
     "customUserData" JSONB,
     "retries" INTEGER NOT NULL DEFAULT 0,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    "concurrencyKeyExpr" TEXT,
+    "concurrencyMaxRuns" INTEGER,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
@@ -313,6 +330,11 @@ CREATE TABLE "StepRun" (
     "callerFiles" JSONB,
     "gitRepoBranch" TEXT,
     "retryCount" INTEGER NOT NULL DEFAULT 0,
+    "concurrencyKey" TEXT,
+    "progress" INTEGER,
+    "progressMessage" TEXT,
+    "ackedAt" TIMESTAMP(3),
+    "shutdownRequeueCount" INTEGER NOT NULL DEFAULT 0,
 
     CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id")
 );
@@ -346,6 +368,7 @@ CREATE TABLE "Tenant" (
     "deletedAt" TIMESTAMP(3),
     "name" TEXT NOT NULL,
     "slug" TEXT NOT NULL,
+    "workerSelectionStrategy" "WorkerSelectionStrategy" NOT NULL DEFAULT 'LEAST_LOADED',
 
     CONSTRAINT "Tenant_pkey" PRIMARY KEY ("id")
 );
@@ -459,10 +482,21 @@ CREATE TABLE "Worker" (
     "status" "WorkerStatus" NOT NULL DEFAULT 'ACTIVE',
     "dispatcherId" UUID,
     "maxRuns" INTEGER,
+    "schedulingStatus" "WorkerSchedulingStatus" NOT NULL DEFAULT 'ACTIVE',
 
     CONSTRAINT "Worker_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WorkerActionSlot" (
+    "id" UUID NOT NULL,
+    "workerId" UUID NOT NULL,
+    "actionId" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkerActionSlot_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "Workflow" (
     "id" UUID NOT NULL,
@@ -472,6 +506,12 @@ CREATE TABLE "Workflow" (
     "tenantId" UUID NOT NULL,
     "name" TEXT NOT NULL,
     "description" TEXT,
+    "isPaused" BOOLEAN NOT NULL DEFAULT false,
+    "pausedAt" TIMESTAMP(3),
+    "queueEventsWhilePaused" BOOLEAN NOT NULL DEFAULT false,
+    "pinnedVersionId" UUID,
+    "canaryVersionId" UUID,
+    "canaryPercentage" INTEGER,
 
     CONSTRAINT "Workflow_pkey" PRIMARY KEY ("id")
 );
@@ -485,6 +525,7 @@ CREATE TABLE "WorkflowConcurrency" (
     "getConcurrencyGroupId" UUID,
     "maxRuns" INTEGER NOT NULL DEFAULT 1,
     "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
+    "concurrencyGroupExpression" TEXT,
 
     CONSTRAINT "WorkflowConcurrency_pkey" PRIMARY KEY ("id")
 );
@@ -504,6 +545,16 @@ CREATE TABLE "WorkflowDeploymentConfig" (
     CONSTRAINT "WorkflowDeploymentConfig_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WorkflowHeldEvent" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "workflowId" UUID NOT NULL,
+    "eventId" UUID NOT NULL,
+
+    CONSTRAINT "WorkflowHeldEvent_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "WorkflowRun" (
     "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -519,10 +570,25 @@ CREATE TABLE "WorkflowRun" (
     "displayName" TEXT,
     "id" UUID NOT NULL,
     "gitRepoBranch" TEXT,
+    "lastEventSequence" BIGINT NOT NULL DEFAULT 0,
 
     CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WorkflowRunEvent" (
+    "workflowRunId" UUID NOT NULL,
+    "sequence" BIGINT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "resourceType" "WorkflowRunEventResourceType" NOT NULL,
+    "resourceId" UUID NOT NULL,
+    "eventType" "WorkflowRunEventType" NOT NULL,
+    "payload" TEXT,
+
+    CONSTRAINT "WorkflowRunEvent_pkey" PRIMARY KEY ("workflowRunId","sequence")
+);
+
 -- CreateTable
 CREATE TABLE "WorkflowRunTriggeredBy" (
     "id" UUID NOT NULL,
@@ -536,6 +602,8 @@ CREATE TABLE "WorkflowRunTriggeredBy" (
     "scheduledId" UUID,
     "input" JSONB,
     "parentId" UUID NOT NULL,
+    "cronFireAt" TIMESTAMP(3),
+    "cronTimezone" TEXT,
 
     CONSTRAINT "WorkflowRunTriggeredBy_pkey" PRIMARY KEY ("id")
 );
@@ -557,7 +625,12 @@ CREATE TABLE "WorkflowTriggerCronRef" (
     "parentId" UUID NOT NULL,
     "cron" TEXT NOT NULL,
     "tickerId" UUID,
-    "input" JSONB
+    "input" JSONB,
+    "timezone" TEXT NOT NULL DEFAULT 'UTC',
+    "misfirePolicy" "CronMisfirePolicy" NOT NULL DEFAULT 'SKIP',
+    "maxMisfires" INTEGER,
+    "lastFiredAt" TIMESTAMP(3),
+    "jitter" TEXT
 );
 
 -- CreateTable
@@ -573,6 +646,8 @@ CREATE TABLE "WorkflowTriggerScheduledRef" (
     "triggerAt" TIMESTAMP(3) NOT NULL,
     "tickerId" UUID,
     "input" JSONB,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
     CONSTRAINT "WorkflowTriggerScheduledRef_pkey" PRIMARY KEY ("id")
 );
@@ -600,6 +675,7 @@ CREATE TABLE "WorkflowVersion" (
     "workflowId" UUID NOT NULL,
     "checksum" TEXT NOT NULL,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    "workerSelectionStrategy" "WorkerSelectionStrategy",
 
     CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
 );
@@ -673,6 +749,9 @@ CREATE UNIQUE INDEX "GetGroupKeyRun_id_key" ON "GetGroupKeyRun"("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "GetGroupKeyRun_workflowRunId_key" ON "GetGroupKeyRun"("workflowRunId" ASC);
 
+-- CreateIndex
+CREATE INDEX "GetGroupKeyRun_timeoutAt_idx" ON "GetGroupKeyRun"("timeoutAt" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "GithubAppInstallation_id_key" ON "GithubAppInstallation"("id" ASC);
 
@@ -709,6 +788,9 @@ CREATE UNIQUE INDEX "Job_workflowVersionId_name_key" ON "Job"("workflowVersionId
 -- CreateIndex
 CREATE UNIQUE INDEX "JobRun_id_key" ON "JobRun"("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "JobRun_timeoutAt_idx" ON "JobRun"("timeoutAt" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "JobRunLookupData_id_key" ON "JobRunLookupData"("id" ASC);
 
@@ -739,6 +821,12 @@ CREATE UNIQUE INDEX "Step_jobId_readableId_key" ON "Step"("jobId" ASC, "readable
 -- CreateIndex
 CREATE UNIQUE INDEX "StepRun_id_key" ON "StepRun"("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "StepRun_tenantId_concurrencyKey_idx" ON "StepRun"("tenantId" ASC, "concurrencyKey" ASC);
+
+-- CreateIndex
+CREATE INDEX "StepRun_timeoutAt_idx" ON "StepRun"("timeoutAt" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "StepRunResultArchive_id_key" ON "StepRunResultArchive"("id" ASC);
 
@@ -790,6 +878,12 @@ CREATE UNIQUE INDEX "UserSession_id_key" ON "UserSession"("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "Worker_id_key" ON "Worker"("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkerActionSlot_id_key" ON "WorkerActionSlot"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkerActionSlot_workerId_actionId_key" ON "WorkerActionSlot"("workerId" ASC, "actionId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "Workflow_id_key" ON "Workflow"("id" ASC);
 
@@ -808,6 +902,12 @@ CREATE UNIQUE INDEX "WorkflowDeploymentConfig_id_key" ON "WorkflowDeploymentConf
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowDeploymentConfig_workflowId_key" ON "WorkflowDeploymentConfig"("workflowId" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowHeldEvent_id_key" ON "WorkflowHeldEvent"("id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowHeldEvent_workflowId_eventId_key" ON "WorkflowHeldEvent"("workflowId" ASC, "eventId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowRun_id_key" ON "WorkflowRun"("id" ASC);
 
@@ -827,7 +927,7 @@ CREATE UNIQUE INDEX "WorkflowTag_id_key" ON "WorkflowTag"("id" ASC);
 CREATE UNIQUE INDEX "WorkflowTag_tenantId_name_key" ON "WorkflowTag"("tenantId" ASC, "name" ASC);
 
 -- CreateIndex
-CREATE UNIQUE INDEX "WorkflowTriggerCronRef_parentId_cron_key" ON "WorkflowTriggerCronRef"("parentId" ASC, "cron" ASC);
+CREATE UNIQUE INDEX "WorkflowTriggerCronRef_parentId_cron_timezone_key" ON "WorkflowTriggerCronRef"("parentId" ASC, "cron" ASC, "timezone" ASC);
 
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowTriggerEventRef_parentId_eventKey_key" ON "WorkflowTriggerEventRef"("parentId" ASC, "eventKey" ASC);
@@ -1027,9 +1127,18 @@ ALTER TABLE "Worker" ADD CONSTRAINT "Worker_dispatcherId_fkey" FOREIGN KEY ("dis
 -- AddForeignKey
 ALTER TABLE "Worker" ADD CONSTRAINT "Worker_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkerActionSlot" ADD CONSTRAINT "WorkerActionSlot_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_pinnedVersionId_fkey" FOREIGN KEY ("pinnedVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_canaryVersionId_fkey" FOREIGN KEY ("canaryVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_getConcurrencyGroupId_fkey" FOREIGN KEY ("getConcurrencyGroupId") REFERENCES "Action"("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1042,6 +1151,12 @@ ALTER TABLE "WorkflowDeploymentConfig" ADD CONSTRAINT "WorkflowDeploymentConfig_
 -- AddForeignKey
 ALTER TABLE "WorkflowDeploymentConfig" ADD CONSTRAINT "WorkflowDeploymentConfig_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowHeldEvent" ADD CONSTRAINT "WorkflowHeldEvent_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowHeldEvent" ADD CONSTRAINT "WorkflowHeldEvent_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1049,7 +1164,7 @@ ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_tenantId_fkey" FOREIGN KEY
 ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
 -- AddForeignKey
-ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_cronParentId_cronSchedule_fkey" FOREIGN KEY ("cronParentId", "cronSchedule") REFERENCES "WorkflowTriggerCronRef"("parentId", "cron") ON DELETE SET NULL ON UPDATE CASCADE;
+ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_cron_fkey" FOREIGN KEY ("cronParentId", "cronSchedule", "cronTimezone") REFERENCES "WorkflowTriggerCronRef"("parentId", "cron", "timezone") ON DELETE SET NULL ON UPDATE CASCADE;
 
 -- AddForeignKey
 ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event"("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -1138,3 +1253,8 @@ ALTER TABLE "_WorkflowToWorkflowTag" ADD CONSTRAINT "_WorkflowToWorkflowTag_A_fk
 -- AddForeignKey
 ALTER TABLE "_WorkflowToWorkflowTag" ADD CONSTRAINT "_WorkflowToWorkflowTag_B_fkey" FOREIGN KEY ("B") REFERENCES "WorkflowTag"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowRunEvent" ADD CONSTRAINT "WorkflowRunEvent_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunEvent" ADD CONSTRAINT "WorkflowRunEvent_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
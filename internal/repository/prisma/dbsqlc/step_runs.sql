@@ -26,6 +26,11 @@ SET
         WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
         ELSE COALESCE(sqlc.narg('status'), "status")
     END,
+    "timeoutAt" = CASE
+        -- step runs in a final state can't time out, so their timeout is cleared
+        WHEN sqlc.narg('status') IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "timeoutAt"
+    END,
     "input" = COALESCE(sqlc.narg('input')::jsonb, "input"),
     "output" = CASE
         -- if this is a rerun, we clear the output
@@ -47,10 +52,80 @@ SET
         WHEN sqlc.narg('rerun')::boolean THEN NULL
         ELSE COALESCE(sqlc.narg('cancelledReason')::text, "cancelledReason")
     END,
-    "retryCount" = COALESCE(sqlc.narg('retryCount')::int, "retryCount")
+    "retryCount" = COALESCE(sqlc.narg('retryCount')::int, "retryCount"),
+    "shutdownRequeueCount" = CASE
+        -- if this is a rerun, we reset the shutdown requeues
+        WHEN sqlc.narg('rerun')::boolean THEN 0
+        ELSE COALESCE(sqlc.narg('shutdownRequeueCount')::int, "shutdownRequeueCount")
+    END,
+    "concurrencyKey" = COALESCE(sqlc.narg('concurrencyKey')::text, "concurrencyKey")
 WHERE 
   "id" = @id::uuid AND
-  "tenantId" = @tenantId::uuid
+  "tenantId" = @tenantId::uuid AND
+  -- if the update belongs to an attempt, the step run must still be running that attempt on the worker
+  (
+    sqlc.narg('attemptWorkerId')::uuid IS NULL OR (
+      "status" IN ('ASSIGNED', 'RUNNING') AND
+      "workerId" = sqlc.narg('attemptWorkerId')::uuid AND
+      (sqlc.narg('attemptRetryCount')::int IS NULL OR "retryCount" = sqlc.narg('attemptRetryCount')::int)
+    )
+  )
+RETURNING "StepRun".*;
+
+-- name: RefreshStepRunTimeout :one
+UPDATE
+    "StepRun"
+SET
+    "timeoutAt" = @timeoutAt::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING') AND
+    -- the timeout is cleared when a ticker claims the step run as timed out
+    "timeoutAt" IS NOT NULL
+RETURNING "StepRun".*;
+
+-- name: AckStepRunAssignment :one
+UPDATE
+    "StepRun"
+SET
+    "ackedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    "workerId" = @workerId::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING')
+RETURNING "StepRun".*;
+
+-- name: RequeueUnackedStepRun :one
+-- Moves a step run which was not acknowledged by the worker it was assigned to back to pending assignment. Step
+-- runs which were acknowledged, started, or reassigned in the meantime are not changed.
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "requeueAfter" = @requeueAfter::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    "workerId" = @workerId::uuid AND
+    "status" = 'ASSIGNED' AND
+    "ackedAt" IS NULL
+RETURNING "StepRun".*;
+
+-- name: UpdateStepRunProgress :one
+UPDATE
+    "StepRun"
+SET
+    "progress" = @progress::int,
+    "progressMessage" = sqlc.narg('progressMessage')::text,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid AND
+    "tenantId" = @tenantId::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING')
 RETURNING "StepRun".*;
 
 -- name: ResolveLaterStepRuns :many
@@ -178,13 +253,14 @@ JOIN
     "Step" s ON sr."stepId" = s."id"
 WHERE
     sr."tenantId" = @tenantId::uuid
+    -- step runs whose worker was deleted are lost as well
     AND ((
         sr."status" = 'RUNNING'
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '60 seconds'
+        AND (w."id" IS NULL OR w."lastHeartbeatAt" < NOW() - INTERVAL '60 seconds')
         AND s."retries" > sr."retryCount"
     ) OR (
         sr."status" = 'ASSIGNED'
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '5 seconds'
+        AND (w."id" IS NULL OR w."lastHeartbeatAt" < NOW() - INTERVAL '5 seconds')
     ))
     -- Step run cannot have a failed parent
     AND NOT EXISTS (
@@ -195,6 +271,49 @@ WHERE
             order_table."B" = sr."id"
             AND prev_sr."status" != 'SUCCEEDED'
     )
+ORDER BY
+    sr."createdAt" ASC
+-- bound the scheduling work for a single tenant per pass
+LIMIT 1000;
+
+-- name: RequeueWorkerStepRuns :many
+-- Moves the step runs of a worker which is being deleted back to pending assignment. Running step runs are requeued
+-- like step runs which failed because their worker shut down, so they are left to be retried once they reach the
+-- limit of shutdown requeues.
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "workerId" = NULL,
+    "ackedAt" = NULL,
+    "timeoutAt" = NULL,
+    "requeueAfter" = NOW() + INTERVAL '5 seconds',
+    "shutdownRequeueCount" = CASE
+        WHEN "status" = 'RUNNING' THEN "shutdownRequeueCount" + 1
+        ELSE "shutdownRequeueCount"
+    END,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "workerId" = @workerId::uuid
+    AND (
+        "status" = 'ASSIGNED'
+        OR ("status" = 'RUNNING' AND "shutdownRequeueCount" < @maxShutdownRequeues::int)
+    )
+RETURNING "StepRun".*;
+
+-- name: ListLostStepRuns :many
+SELECT
+    sr.*
+FROM
+    "StepRun" sr
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."workerId" = @workerId::uuid
+    AND sr."status" IN ('ASSIGNED', 'RUNNING')
+    AND NOT (sr."id" = ANY(@inFlightStepRunIds::uuid[]))
+    -- skip step runs which may still be in transit to or from the worker
+    AND sr."updatedAt" < @updatedBefore::timestamp
 ORDER BY
     sr."createdAt" ASC;
 
@@ -208,7 +327,11 @@ LEFT JOIN
 WHERE
     sr."tenantId" = @tenantId::uuid
     AND sr."requeueAfter" < NOW()
-    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT')
+    -- step runs pending assignment are picked up by AssignStepRuns, so they are only requeued once they time out
+    AND (
+        sr."status" = 'PENDING'
+        OR (sr."status" = 'PENDING_ASSIGNMENT' AND sr."scheduleTimeoutAt" < NOW())
+    )
     AND NOT EXISTS (
         SELECT 1
         FROM "_StepRunOrder" AS order_table
@@ -218,4 +341,151 @@ WHERE
             AND prev_sr."status" != 'SUCCEEDED'
     )
 ORDER BY
-    sr."createdAt" ASC;
\ No newline at end of file
+    sr."createdAt" ASC
+-- bound the scheduling work for a single tenant per pass
+LIMIT 1000;
+
+-- name: ListStepRunsToAssign :many
+SELECT
+    sr."id",
+    sr."concurrencyKey",
+    s."actionId",
+    s."concurrencyMaxRuns",
+    s."timeout",
+    -- the workflow version's strategy overrides the tenant's strategy
+    COALESCE(wv."workerSelectionStrategy", t."workerSelectionStrategy")::"WorkerSelectionStrategy" AS "workerSelectionStrategy"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "Tenant" t ON t."id" = sr."tenantId"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."status" = 'PENDING_ASSIGNMENT'
+    -- Step run cannot have a failed parent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "_StepRunOrder" AS order_table
+        JOIN "StepRun" AS prev_sr ON order_table."A" = prev_sr."id"
+        WHERE
+            order_table."B" = sr."id"
+            AND prev_sr."status" != 'SUCCEEDED'
+    )
+    -- Only claim step runs whose action has a worker with a free slot, so step runs which are waiting for a
+    -- worker don't hold back newer step runs
+    AND s."actionId" IN (
+        SELECT
+            "Action"."actionId"
+        FROM
+            "Worker" workers
+        JOIN
+            "_ActionToWorker" ON "_ActionToWorker"."B" = workers."id"
+        JOIN
+            "Action" ON "Action"."id" = "_ActionToWorker"."A"
+        WHERE
+            workers."tenantId" = @tenantId::uuid
+            AND workers."dispatcherId" IS NOT NULL
+            AND workers."lastHeartbeatAt" > @lastHeartbeatAfter::timestamp
+            AND workers."schedulingStatus" = 'ACTIVE'
+            AND (
+                workers."maxRuns" IS NULL OR
+                workers."maxRuns" > (
+                    SELECT COUNT(*)
+                    FROM "StepRun" runs
+                    WHERE runs."workerId" = workers."id" AND runs."status" IN ('ASSIGNED', 'RUNNING')
+                )
+            )
+            AND NOT EXISTS (
+                SELECT 1
+                FROM "WorkerActionSlot" slots
+                WHERE
+                    slots."workerId" = workers."id"
+                    AND slots."actionId" = "Action"."actionId"
+                    AND slots."maxRuns" <= (
+                        SELECT COUNT(*)
+                        FROM "StepRun" runs
+                        JOIN "Step" steps ON steps."id" = runs."stepId"
+                        WHERE
+                            runs."workerId" = workers."id"
+                            AND runs."status" IN ('ASSIGNED', 'RUNNING')
+                            AND steps."actionId" = slots."actionId"
+                    )
+            )
+    )
+    -- Step run's concurrency key cannot be at the step's concurrency limit, which defaults to 1 like in
+    -- planStepRunAssignments
+    AND (
+        sr."concurrencyKey" IS NULL OR
+        COALESCE(s."concurrencyMaxRuns", 1) > (
+            SELECT COUNT(*)
+            FROM "StepRun" active_sr
+            JOIN "Step" active_s ON active_s."id" = active_sr."stepId"
+            WHERE
+                active_sr."tenantId" = @tenantId::uuid
+                AND active_sr."concurrencyKey" = sr."concurrencyKey"
+                AND active_s."actionId" = s."actionId"
+                AND active_sr."status" IN ('ASSIGNED', 'RUNNING')
+        )
+    )
+ORDER BY
+    sr."createdAt" ASC
+LIMIT
+    @batchSize::int
+FOR UPDATE OF sr SKIP LOCKED;
+
+-- name: AcquireStepRunConcurrencyLocks :exec
+-- Takes the locks in a consistent order so concurrent batches cannot deadlock.
+SELECT
+    pg_advisory_xact_lock(hashtext(lock_keys."lockKey"))
+FROM (
+    SELECT unnest(@lockKeys::text[]) AS "lockKey"
+    ORDER BY "lockKey"
+) AS lock_keys;
+
+-- name: CountActiveStepRunsByConcurrencyKey :many
+SELECT
+    s."actionId",
+    sr."concurrencyKey",
+    COUNT(*) AS "total"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    sr."tenantId" = @tenantId::uuid AND
+    sr."concurrencyKey" = ANY(@concurrencyKeys::text[]) AND
+    sr."status" IN ('ASSIGNED', 'RUNNING')
+GROUP BY
+    s."actionId", sr."concurrencyKey";
+
+-- name: BulkAssignStepRunsToWorkers :many
+WITH input AS (
+    SELECT
+        unnest(@stepRunIds::uuid[]) AS "id",
+        unnest(@workerIds::uuid[]) AS "workerId",
+        unnest(@timeoutAts::timestamp[]) AS "timeoutAt"
+)
+UPDATE
+    "StepRun" sr
+SET
+    "workerId" = input."workerId",
+    "timeoutAt" = input."timeoutAt",
+    "status" = 'ASSIGNED',
+    "ackedAt" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    input
+WHERE
+    sr."id" = input."id" AND
+    sr."tenantId" = @tenantId::uuid
+RETURNING
+    sr."id",
+    sr."workerId",
+    sr."jobRunId";
\ No newline at end of file
@@ -11,6 +11,79 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const ackStepRunAssignment = `-- name: AckStepRunAssignment :one
+UPDATE
+    "StepRun"
+SET
+    "ackedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $1::uuid AND
+    "tenantId" = $2::uuid AND
+    "workerId" = $3::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING')
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
+`
+
+type AckStepRunAssignmentParams struct {
+	ID       pgtype.UUID `json:"id"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+func (q *Queries) AckStepRunAssignment(ctx context.Context, db DBTX, arg AckStepRunAssignmentParams) (*StepRun, error) {
+	row := db.QueryRow(ctx, ackStepRunAssignment, arg.ID, arg.Tenantid, arg.Workerid)
+	var i StepRun
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.JobRunId,
+		&i.StepId,
+		&i.Order,
+		&i.WorkerId,
+		&i.TickerId,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.RequeueAfter,
+		&i.ScheduleTimeoutAt,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.TimeoutAt,
+		&i.CancelledAt,
+		&i.CancelledReason,
+		&i.CancelledError,
+		&i.InputSchema,
+		&i.CallerFiles,
+		&i.GitRepoBranch,
+		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
+	)
+	return &i, err
+}
+
+const acquireStepRunConcurrencyLocks = `-- name: AcquireStepRunConcurrencyLocks :exec
+SELECT
+    pg_advisory_xact_lock(hashtext(lock_keys."lockKey"))
+FROM (
+    SELECT unnest($1::text[]) AS "lockKey"
+    ORDER BY "lockKey"
+) AS lock_keys
+`
+
+// Takes the locks in a consistent order so concurrent batches cannot deadlock.
+func (q *Queries) AcquireStepRunConcurrencyLocks(ctx context.Context, db DBTX, lockkeys []string) error {
+	_, err := db.Exec(ctx, acquireStepRunConcurrencyLocks, lockkeys)
+	return err
+}
+
 const archiveStepRunResultFromStepRun = `-- name: ArchiveStepRunResultFromStepRun :one
 WITH step_run_data AS (
     SELECT
@@ -95,9 +168,121 @@ func (q *Queries) ArchiveStepRunResultFromStepRun(ctx context.Context, db DBTX,
 	return &i, err
 }
 
+const bulkAssignStepRunsToWorkers = `-- name: BulkAssignStepRunsToWorkers :many
+WITH input AS (
+    SELECT
+        unnest($2::uuid[]) AS "id",
+        unnest($3::uuid[]) AS "workerId",
+        unnest($4::timestamp[]) AS "timeoutAt"
+)
+UPDATE
+    "StepRun" sr
+SET
+    "workerId" = input."workerId",
+    "timeoutAt" = input."timeoutAt",
+    "status" = 'ASSIGNED',
+    "ackedAt" = NULL,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    input
+WHERE
+    sr."id" = input."id" AND
+    sr."tenantId" = $1::uuid
+RETURNING
+    sr."id",
+    sr."workerId",
+    sr."jobRunId"
+`
+
+type BulkAssignStepRunsToWorkersParams struct {
+	Tenantid   pgtype.UUID        `json:"tenantid"`
+	Steprunids []pgtype.UUID      `json:"steprunids"`
+	Workerids  []pgtype.UUID      `json:"workerids"`
+	Timeoutats []pgtype.Timestamp `json:"timeoutats"`
+}
+
+type BulkAssignStepRunsToWorkersRow struct {
+	ID       pgtype.UUID `json:"id"`
+	WorkerId pgtype.UUID `json:"workerId"`
+	JobRunId pgtype.UUID `json:"jobRunId"`
+}
+
+func (q *Queries) BulkAssignStepRunsToWorkers(ctx context.Context, db DBTX, arg BulkAssignStepRunsToWorkersParams) ([]*BulkAssignStepRunsToWorkersRow, error) {
+	rows, err := db.Query(ctx, bulkAssignStepRunsToWorkers,
+		arg.Tenantid,
+		arg.Steprunids,
+		arg.Workerids,
+		arg.Timeoutats,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*BulkAssignStepRunsToWorkersRow
+	for rows.Next() {
+		var i BulkAssignStepRunsToWorkersRow
+		if err := rows.Scan(&i.ID, &i.WorkerId, &i.JobRunId); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countActiveStepRunsByConcurrencyKey = `-- name: CountActiveStepRunsByConcurrencyKey :many
+SELECT
+    s."actionId",
+    sr."concurrencyKey",
+    COUNT(*) AS "total"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    sr."tenantId" = $1::uuid AND
+    sr."concurrencyKey" = ANY($2::text[]) AND
+    sr."status" IN ('ASSIGNED', 'RUNNING')
+GROUP BY
+    s."actionId", sr."concurrencyKey"
+`
+
+type CountActiveStepRunsByConcurrencyKeyParams struct {
+	Tenantid        pgtype.UUID `json:"tenantid"`
+	Concurrencykeys []string    `json:"concurrencykeys"`
+}
+
+type CountActiveStepRunsByConcurrencyKeyRow struct {
+	ActionId       string      `json:"actionId"`
+	ConcurrencyKey pgtype.Text `json:"concurrencyKey"`
+	Total          int64       `json:"total"`
+}
+
+func (q *Queries) CountActiveStepRunsByConcurrencyKey(ctx context.Context, db DBTX, arg CountActiveStepRunsByConcurrencyKeyParams) ([]*CountActiveStepRunsByConcurrencyKeyRow, error) {
+	rows, err := db.Query(ctx, countActiveStepRunsByConcurrencyKey, arg.Tenantid, arg.Concurrencykeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CountActiveStepRunsByConcurrencyKeyRow
+	for rows.Next() {
+		var i CountActiveStepRunsByConcurrencyKeyRow
+		if err := rows.Scan(&i.ActionId, &i.ConcurrencyKey, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStepRun = `-- name: GetStepRun :one
 SELECT
-    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount"
+    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
 FROM
     "StepRun"
 WHERE
@@ -140,13 +325,236 @@ func (q *Queries) GetStepRun(ctx context.Context, db DBTX, arg GetStepRunParams)
 		&i.CallerFiles,
 		&i.GitRepoBranch,
 		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
 	)
 	return &i, err
 }
 
+const listLostStepRuns = `-- name: ListLostStepRuns :many
+SELECT
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."concurrencyKey", sr.progress, sr."progressMessage", sr."ackedAt", sr."shutdownRequeueCount"
+FROM
+    "StepRun" sr
+WHERE
+    sr."tenantId" = $1::uuid
+    AND sr."workerId" = $2::uuid
+    AND sr."status" IN ('ASSIGNED', 'RUNNING')
+    AND NOT (sr."id" = ANY($3::uuid[]))
+    -- skip step runs which may still be in transit to or from the worker
+    AND sr."updatedAt" < $4::timestamp
+ORDER BY
+    sr."createdAt" ASC
+`
+
+type ListLostStepRunsParams struct {
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Workerid           pgtype.UUID      `json:"workerid"`
+	Inflightsteprunids []pgtype.UUID    `json:"inflightsteprunids"`
+	Updatedbefore      pgtype.Timestamp `json:"updatedbefore"`
+}
+
+func (q *Queries) ListLostStepRuns(ctx context.Context, db DBTX, arg ListLostStepRunsParams) ([]*StepRun, error) {
+	rows, err := db.Query(ctx, listLostStepRuns,
+		arg.Tenantid,
+		arg.Workerid,
+		arg.Inflightsteprunids,
+		arg.Updatedbefore,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepRun
+	for rows.Next() {
+		var i StepRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantId,
+			&i.JobRunId,
+			&i.StepId,
+			&i.Order,
+			&i.WorkerId,
+			&i.TickerId,
+			&i.Status,
+			&i.Input,
+			&i.Output,
+			&i.RequeueAfter,
+			&i.ScheduleTimeoutAt,
+			&i.Error,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.TimeoutAt,
+			&i.CancelledAt,
+			&i.CancelledReason,
+			&i.CancelledError,
+			&i.InputSchema,
+			&i.CallerFiles,
+			&i.GitRepoBranch,
+			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunsToAssign = `-- name: ListStepRunsToAssign :many
+SELECT
+    sr."id",
+    sr."concurrencyKey",
+    s."actionId",
+    s."concurrencyMaxRuns",
+    s."timeout",
+    -- the workflow version's strategy overrides the tenant's strategy
+    COALESCE(wv."workerSelectionStrategy", t."workerSelectionStrategy")::"WorkerSelectionStrategy" AS "workerSelectionStrategy"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "WorkflowRun" wr ON wr."id" = jr."workflowRunId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "Tenant" t ON t."id" = sr."tenantId"
+WHERE
+    sr."tenantId" = $1::uuid
+    AND sr."status" = 'PENDING_ASSIGNMENT'
+    -- Step run cannot have a failed parent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "_StepRunOrder" AS order_table
+        JOIN "StepRun" AS prev_sr ON order_table."A" = prev_sr."id"
+        WHERE
+            order_table."B" = sr."id"
+            AND prev_sr."status" != 'SUCCEEDED'
+    )
+    -- Only claim step runs whose action has a worker with a free slot, so step runs which are waiting for a
+    -- worker don't hold back newer step runs
+    AND s."actionId" IN (
+        SELECT
+            "Action"."actionId"
+        FROM
+            "Worker" workers
+        JOIN
+            "_ActionToWorker" ON "_ActionToWorker"."B" = workers."id"
+        JOIN
+            "Action" ON "Action"."id" = "_ActionToWorker"."A"
+        WHERE
+            workers."tenantId" = $1::uuid
+            AND workers."dispatcherId" IS NOT NULL
+            AND workers."lastHeartbeatAt" > $2::timestamp
+            AND workers."schedulingStatus" = 'ACTIVE'
+            AND (
+                workers."maxRuns" IS NULL OR
+                workers."maxRuns" > (
+                    SELECT COUNT(*)
+                    FROM "StepRun" runs
+                    WHERE runs."workerId" = workers."id" AND runs."status" IN ('ASSIGNED', 'RUNNING')
+                )
+            )
+            AND NOT EXISTS (
+                SELECT 1
+                FROM "WorkerActionSlot" slots
+                WHERE
+                    slots."workerId" = workers."id"
+                    AND slots."actionId" = "Action"."actionId"
+                    AND slots."maxRuns" <= (
+                        SELECT COUNT(*)
+                        FROM "StepRun" runs
+                        JOIN "Step" steps ON steps."id" = runs."stepId"
+                        WHERE
+                            runs."workerId" = workers."id"
+                            AND runs."status" IN ('ASSIGNED', 'RUNNING')
+                            AND steps."actionId" = slots."actionId"
+                    )
+            )
+    )
+    -- Step run's concurrency key cannot be at the step's concurrency limit, which defaults to 1 like in
+    -- planStepRunAssignments
+    AND (
+        sr."concurrencyKey" IS NULL OR
+        COALESCE(s."concurrencyMaxRuns", 1) > (
+            SELECT COUNT(*)
+            FROM "StepRun" active_sr
+            JOIN "Step" active_s ON active_s."id" = active_sr."stepId"
+            WHERE
+                active_sr."tenantId" = $1::uuid
+                AND active_sr."concurrencyKey" = sr."concurrencyKey"
+                AND active_s."actionId" = s."actionId"
+                AND active_sr."status" IN ('ASSIGNED', 'RUNNING')
+        )
+    )
+ORDER BY
+    sr."createdAt" ASC
+LIMIT
+    $3::int
+FOR UPDATE OF sr SKIP LOCKED
+`
+
+type ListStepRunsToAssignParams struct {
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Lastheartbeatafter pgtype.Timestamp `json:"lastheartbeatafter"`
+	Batchsize          int32            `json:"batchsize"`
+}
+
+type ListStepRunsToAssignRow struct {
+	ID                      pgtype.UUID             `json:"id"`
+	ConcurrencyKey          pgtype.Text             `json:"concurrencyKey"`
+	ActionId                string                  `json:"actionId"`
+	ConcurrencyMaxRuns      pgtype.Int4             `json:"concurrencyMaxRuns"`
+	Timeout                 pgtype.Text             `json:"timeout"`
+	WorkerSelectionStrategy WorkerSelectionStrategy `json:"workerSelectionStrategy"`
+}
+
+func (q *Queries) ListStepRunsToAssign(ctx context.Context, db DBTX, arg ListStepRunsToAssignParams) ([]*ListStepRunsToAssignRow, error) {
+	rows, err := db.Query(ctx, listStepRunsToAssign, arg.Tenantid, arg.Lastheartbeatafter, arg.Batchsize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunsToAssignRow
+	for rows.Next() {
+		var i ListStepRunsToAssignRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConcurrencyKey,
+			&i.ActionId,
+			&i.ConcurrencyMaxRuns,
+			&i.Timeout,
+			&i.WorkerSelectionStrategy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepRunsToReassign = `-- name: ListStepRunsToReassign :many
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."concurrencyKey", sr.progress, sr."progressMessage", sr."ackedAt", sr."shutdownRequeueCount"
 FROM
     "StepRun" sr
 LEFT JOIN
@@ -155,13 +563,14 @@ JOIN
     "Step" s ON sr."stepId" = s."id"
 WHERE
     sr."tenantId" = $1::uuid
+    -- step runs whose worker was deleted are lost as well
     AND ((
         sr."status" = 'RUNNING'
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '60 seconds'
+        AND (w."id" IS NULL OR w."lastHeartbeatAt" < NOW() - INTERVAL '60 seconds')
         AND s."retries" > sr."retryCount"
     ) OR (
         sr."status" = 'ASSIGNED'
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '5 seconds'
+        AND (w."id" IS NULL OR w."lastHeartbeatAt" < NOW() - INTERVAL '5 seconds')
     ))
     -- Step run cannot have a failed parent
     AND NOT EXISTS (
@@ -174,8 +583,10 @@ WHERE
     )
 ORDER BY
     sr."createdAt" ASC
+LIMIT 1000
 `
 
+// bound the scheduling work for a single tenant per pass
 func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*StepRun, error) {
 	rows, err := db.Query(ctx, listStepRunsToReassign, tenantid)
 	if err != nil {
@@ -212,6 +623,11 @@ func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, tenantid
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
 		); err != nil {
 			return nil, err
 		}
@@ -225,7 +641,7 @@ func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, tenantid
 
 const listStepRunsToRequeue = `-- name: ListStepRunsToRequeue :many
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."concurrencyKey", sr.progress, sr."progressMessage", sr."ackedAt", sr."shutdownRequeueCount"
 FROM
     "StepRun" sr
 LEFT JOIN
@@ -233,7 +649,11 @@ LEFT JOIN
 WHERE
     sr."tenantId" = $1::uuid
     AND sr."requeueAfter" < NOW()
-    AND (sr."status" = 'PENDING' OR sr."status" = 'PENDING_ASSIGNMENT')
+    -- step runs pending assignment are picked up by AssignStepRuns, so they are only requeued once they time out
+    AND (
+        sr."status" = 'PENDING'
+        OR (sr."status" = 'PENDING_ASSIGNMENT' AND sr."scheduleTimeoutAt" < NOW())
+    )
     AND NOT EXISTS (
         SELECT 1
         FROM "_StepRunOrder" AS order_table
@@ -244,8 +664,10 @@ WHERE
     )
 ORDER BY
     sr."createdAt" ASC
+LIMIT 1000
 `
 
+// bound the scheduling work for a single tenant per pass
 func (q *Queries) ListStepRunsToRequeue(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*StepRun, error) {
 	rows, err := db.Query(ctx, listStepRunsToRequeue, tenantid)
 	if err != nil {
@@ -282,6 +704,225 @@ func (q *Queries) ListStepRunsToRequeue(ctx context.Context, db DBTX, tenantid p
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshStepRunTimeout = `-- name: RefreshStepRunTimeout :one
+UPDATE
+    "StepRun"
+SET
+    "timeoutAt" = $1::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid AND
+    "tenantId" = $3::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING') AND
+    -- the timeout is cleared when a ticker claims the step run as timed out
+    "timeoutAt" IS NOT NULL
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
+`
+
+type RefreshStepRunTimeoutParams struct {
+	Timeoutat pgtype.Timestamp `json:"timeoutat"`
+	ID        pgtype.UUID      `json:"id"`
+	Tenantid  pgtype.UUID      `json:"tenantid"`
+}
+
+func (q *Queries) RefreshStepRunTimeout(ctx context.Context, db DBTX, arg RefreshStepRunTimeoutParams) (*StepRun, error) {
+	row := db.QueryRow(ctx, refreshStepRunTimeout, arg.Timeoutat, arg.ID, arg.Tenantid)
+	var i StepRun
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.JobRunId,
+		&i.StepId,
+		&i.Order,
+		&i.WorkerId,
+		&i.TickerId,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.RequeueAfter,
+		&i.ScheduleTimeoutAt,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.TimeoutAt,
+		&i.CancelledAt,
+		&i.CancelledReason,
+		&i.CancelledError,
+		&i.InputSchema,
+		&i.CallerFiles,
+		&i.GitRepoBranch,
+		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
+	)
+	return &i, err
+}
+
+const requeueUnackedStepRun = `-- name: RequeueUnackedStepRun :one
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "requeueAfter" = $1::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid AND
+    "tenantId" = $3::uuid AND
+    "workerId" = $4::uuid AND
+    "status" = 'ASSIGNED' AND
+    "ackedAt" IS NULL
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
+`
+
+type RequeueUnackedStepRunParams struct {
+	Requeueafter pgtype.Timestamp `json:"requeueafter"`
+	ID           pgtype.UUID      `json:"id"`
+	Tenantid     pgtype.UUID      `json:"tenantid"`
+	Workerid     pgtype.UUID      `json:"workerid"`
+}
+
+// Moves a step run which was not acknowledged by the worker it was assigned to back to pending assignment. Step
+// runs which were acknowledged, started, or reassigned in the meantime are not changed.
+func (q *Queries) RequeueUnackedStepRun(ctx context.Context, db DBTX, arg RequeueUnackedStepRunParams) (*StepRun, error) {
+	row := db.QueryRow(ctx, requeueUnackedStepRun,
+		arg.Requeueafter,
+		arg.ID,
+		arg.Tenantid,
+		arg.Workerid,
+	)
+	var i StepRun
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.JobRunId,
+		&i.StepId,
+		&i.Order,
+		&i.WorkerId,
+		&i.TickerId,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.RequeueAfter,
+		&i.ScheduleTimeoutAt,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.TimeoutAt,
+		&i.CancelledAt,
+		&i.CancelledReason,
+		&i.CancelledError,
+		&i.InputSchema,
+		&i.CallerFiles,
+		&i.GitRepoBranch,
+		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
+	)
+	return &i, err
+}
+
+const requeueWorkerStepRuns = `-- name: RequeueWorkerStepRuns :many
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT',
+    "workerId" = NULL,
+    "ackedAt" = NULL,
+    "timeoutAt" = NULL,
+    "requeueAfter" = NOW() + INTERVAL '5 seconds',
+    "shutdownRequeueCount" = CASE
+        WHEN "status" = 'RUNNING' THEN "shutdownRequeueCount" + 1
+        ELSE "shutdownRequeueCount"
+    END,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $1::uuid
+    AND "workerId" = $2::uuid
+    AND (
+        "status" = 'ASSIGNED'
+        OR ("status" = 'RUNNING' AND "shutdownRequeueCount" < $3::int)
+    )
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
+`
+
+type RequeueWorkerStepRunsParams struct {
+	Tenantid            pgtype.UUID `json:"tenantid"`
+	Workerid            pgtype.UUID `json:"workerid"`
+	Maxshutdownrequeues int32       `json:"maxshutdownrequeues"`
+}
+
+// Moves the step runs of a worker which is being deleted back to pending assignment. Running step runs are requeued
+// like step runs which failed because their worker shut down, so they are left to be retried once they reach the
+// limit of shutdown requeues.
+func (q *Queries) RequeueWorkerStepRuns(ctx context.Context, db DBTX, arg RequeueWorkerStepRunsParams) ([]*StepRun, error) {
+	rows, err := db.Query(ctx, requeueWorkerStepRuns, arg.Tenantid, arg.Workerid, arg.Maxshutdownrequeues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepRun
+	for rows.Next() {
+		var i StepRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantId,
+			&i.JobRunId,
+			&i.StepId,
+			&i.Order,
+			&i.WorkerId,
+			&i.TickerId,
+			&i.Status,
+			&i.Input,
+			&i.Output,
+			&i.RequeueAfter,
+			&i.ScheduleTimeoutAt,
+			&i.Error,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.TimeoutAt,
+			&i.CancelledAt,
+			&i.CancelledReason,
+			&i.CancelledError,
+			&i.InputSchema,
+			&i.CallerFiles,
+			&i.GitRepoBranch,
+			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
 		); err != nil {
 			return nil, err
 		}
@@ -295,7 +936,7 @@ func (q *Queries) ListStepRunsToRequeue(ctx context.Context, db DBTX, tenantid p
 
 const resolveLaterStepRuns = `-- name: ResolveLaterStepRuns :many
 WITH currStepRun AS (
-  SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount"
+  SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "concurrencyKey", progress, "progressMessage", "ackedAt", "shutdownRequeueCount"
   FROM "StepRun"
   WHERE
     "id" = $1::uuid AND
@@ -328,7 +969,7 @@ WHERE
         WHERE "id" = $1::uuid
     ) AND
     sr."tenantId" = $2::uuid
-RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount"
+RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."concurrencyKey", sr.progress, sr."progressMessage", sr."ackedAt", sr."shutdownRequeueCount"
 `
 
 type ResolveLaterStepRunsParams struct {
@@ -372,6 +1013,11 @@ func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg Resolve
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
 		); err != nil {
 			return nil, err
 		}
@@ -402,6 +1048,11 @@ SET
         WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
         ELSE COALESCE($6, "status")
     END,
+    "timeoutAt" = CASE
+        -- step runs in a final state can't time out, so their timeout is cleared
+        WHEN $6 IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN NULL
+        ELSE "timeoutAt"
+    END,
     "input" = COALESCE($7::jsonb, "input"),
     "output" = CASE
         -- if this is a rerun, we clear the output
@@ -423,28 +1074,46 @@ SET
         WHEN $4::boolean THEN NULL
         ELSE COALESCE($11::text, "cancelledReason")
     END,
-    "retryCount" = COALESCE($12::int, "retryCount")
+    "retryCount" = COALESCE($12::int, "retryCount"),
+    "shutdownRequeueCount" = CASE
+        -- if this is a rerun, we reset the shutdown requeues
+        WHEN $4::boolean THEN 0
+        ELSE COALESCE($13::int, "shutdownRequeueCount")
+    END,
+    "concurrencyKey" = COALESCE($14::text, "concurrencyKey")
 WHERE 
-  "id" = $13::uuid AND
-  "tenantId" = $14::uuid
-RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount"
+  "id" = $15::uuid AND
+  "tenantId" = $16::uuid AND
+  -- if the update belongs to an attempt, the step run must still be running that attempt on the worker
+  (
+    $17::uuid IS NULL OR (
+      "status" IN ('ASSIGNED', 'RUNNING') AND
+      "workerId" = $17::uuid AND
+      ($18::int IS NULL OR "retryCount" = $18::int)
+    )
+  )
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
 `
 
 type UpdateStepRunParams struct {
-	RequeueAfter      pgtype.Timestamp  `json:"requeueAfter"`
-	ScheduleTimeoutAt pgtype.Timestamp  `json:"scheduleTimeoutAt"`
-	StartedAt         pgtype.Timestamp  `json:"startedAt"`
-	Rerun             pgtype.Bool       `json:"rerun"`
-	FinishedAt        pgtype.Timestamp  `json:"finishedAt"`
-	Status            NullStepRunStatus `json:"status"`
-	Input             []byte            `json:"input"`
-	Output            []byte            `json:"output"`
-	Error             pgtype.Text       `json:"error"`
-	CancelledAt       pgtype.Timestamp  `json:"cancelledAt"`
-	CancelledReason   pgtype.Text       `json:"cancelledReason"`
-	RetryCount        pgtype.Int4       `json:"retryCount"`
-	ID                pgtype.UUID       `json:"id"`
-	Tenantid          pgtype.UUID       `json:"tenantid"`
+	RequeueAfter         pgtype.Timestamp  `json:"requeueAfter"`
+	ScheduleTimeoutAt    pgtype.Timestamp  `json:"scheduleTimeoutAt"`
+	StartedAt            pgtype.Timestamp  `json:"startedAt"`
+	Rerun                pgtype.Bool       `json:"rerun"`
+	FinishedAt           pgtype.Timestamp  `json:"finishedAt"`
+	Status               NullStepRunStatus `json:"status"`
+	Input                []byte            `json:"input"`
+	Output               []byte            `json:"output"`
+	Error                pgtype.Text       `json:"error"`
+	CancelledAt          pgtype.Timestamp  `json:"cancelledAt"`
+	CancelledReason      pgtype.Text       `json:"cancelledReason"`
+	RetryCount           pgtype.Int4       `json:"retryCount"`
+	ShutdownRequeueCount pgtype.Int4       `json:"shutdownRequeueCount"`
+	ConcurrencyKey       pgtype.Text       `json:"concurrencyKey"`
+	ID                   pgtype.UUID       `json:"id"`
+	Tenantid             pgtype.UUID       `json:"tenantid"`
+	AttemptWorkerId      pgtype.UUID       `json:"attemptWorkerId"`
+	AttemptRetryCount    pgtype.Int4       `json:"attemptRetryCount"`
 }
 
 func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunParams) (*StepRun, error) {
@@ -461,8 +1130,12 @@ func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunP
 		arg.CancelledAt,
 		arg.CancelledReason,
 		arg.RetryCount,
+		arg.ShutdownRequeueCount,
+		arg.ConcurrencyKey,
 		arg.ID,
 		arg.Tenantid,
+		arg.AttemptWorkerId,
+		arg.AttemptRetryCount,
 	)
 	var i StepRun
 	err := row.Scan(
@@ -492,6 +1165,11 @@ func (q *Queries) UpdateStepRun(ctx context.Context, db DBTX, arg UpdateStepRunP
 		&i.CallerFiles,
 		&i.GitRepoBranch,
 		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
 	)
 	return &i, err
 }
@@ -556,3 +1234,68 @@ func (q *Queries) UpdateStepRunOverridesData(ctx context.Context, db DBTX, arg U
 	err := row.Scan(&input)
 	return input, err
 }
+
+const updateStepRunProgress = `-- name: UpdateStepRunProgress :one
+UPDATE
+    "StepRun"
+SET
+    "progress" = $1::int,
+    "progressMessage" = $2::text,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $3::uuid AND
+    "tenantId" = $4::uuid AND
+    "status" IN ('ASSIGNED', 'RUNNING')
+RETURNING "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."concurrencyKey", "StepRun".progress, "StepRun"."progressMessage", "StepRun"."ackedAt", "StepRun"."shutdownRequeueCount"
+`
+
+type UpdateStepRunProgressParams struct {
+	Progress        int32       `json:"progress"`
+	ProgressMessage pgtype.Text `json:"progressMessage"`
+	ID              pgtype.UUID `json:"id"`
+	Tenantid        pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) UpdateStepRunProgress(ctx context.Context, db DBTX, arg UpdateStepRunProgressParams) (*StepRun, error) {
+	row := db.QueryRow(ctx, updateStepRunProgress,
+		arg.Progress,
+		arg.ProgressMessage,
+		arg.ID,
+		arg.Tenantid,
+	)
+	var i StepRun
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.JobRunId,
+		&i.StepId,
+		&i.Order,
+		&i.WorkerId,
+		&i.TickerId,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.RequeueAfter,
+		&i.ScheduleTimeoutAt,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.TimeoutAt,
+		&i.CancelledAt,
+		&i.CancelledReason,
+		&i.CancelledError,
+		&i.InputSchema,
+		&i.CallerFiles,
+		&i.GitRepoBranch,
+		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
+	)
+	return &i, err
+}
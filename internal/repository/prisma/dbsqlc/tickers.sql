@@ -32,4 +32,136 @@ RETURNING
 SELECT
     sqlc.embed(tickers)
 FROM
-    "Ticker" as tickers;
\ No newline at end of file
+    "Ticker" as tickers;
+
+-- name: PollStepRunTimeouts :many
+-- Claims step runs whose timeout has passed, skipping step runs which are claimed by another ticker. The timeout
+-- is cleared when the transaction commits, so it is claimed again if the ticker dies before handling it. Step runs
+-- which finished or were requeued before their timeout did not time out.
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "StepRun"
+    WHERE
+        "timeoutAt" <= NOW()
+        AND "status" IN ('ASSIGNED', 'RUNNING')
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "StepRun" as stepRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    stepRuns."id" = timed_out."id"
+RETURNING
+    stepRuns."id",
+    stepRuns."tenantId",
+    stepRuns."jobRunId",
+    stepRuns."status";
+
+-- name: PollJobRunTimeouts :many
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "JobRun"
+    WHERE
+        "timeoutAt" <= NOW()
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "JobRun" as jobRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    jobRuns."id" = timed_out."id"
+RETURNING
+    jobRuns."id",
+    jobRuns."tenantId",
+    jobRuns."status";
+
+-- name: PollGetGroupKeyRunTimeouts :many
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "GetGroupKeyRun"
+    WHERE
+        "timeoutAt" <= NOW()
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "GetGroupKeyRun" as getGroupKeyRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    getGroupKeyRuns."id" = timed_out."id"
+RETURNING
+    getGroupKeyRuns."id",
+    getGroupKeyRuns."tenantId",
+    getGroupKeyRuns."workflowRunId",
+    getGroupKeyRuns."status";
+
+-- name: PollScheduledWorkflows :many
+-- Claims scheduled workflows whose trigger time has passed and which have not triggered a workflow run, skipping
+-- schedules which are claimed by another ticker. A schedule stops being returned once its workflow run is created.
+-- The lock does not block creating the workflow run, which references the schedule.
+SELECT
+    scheduled."id",
+    scheduled."parentId",
+    workflows."tenantId"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+WHERE
+    scheduled."triggerAt" <= NOW()
+    -- schedules which were already in the past when their version was created are not triggered
+    AND scheduled."triggerAt" >= versions."createdAt"
+    AND workflows."deletedAt" IS NULL
+    -- paused workflows trigger their schedules when they are resumed
+    AND NOT workflows."isPaused"
+    -- only the latest version of a workflow triggers its schedules
+    AND versions."id" = (
+        SELECT
+            latest."id"
+        FROM
+            "WorkflowVersion" as latest
+        WHERE
+            latest."workflowId" = workflows."id"
+            AND latest."deletedAt" IS NULL
+        ORDER BY
+            latest."order" DESC
+        LIMIT 1
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunTriggeredBy" as triggeredBy
+        WHERE triggeredBy."scheduledId" = scheduled."id"
+    )
+ORDER BY
+    scheduled."triggerAt" ASC
+LIMIT
+    @batchSize::int
+FOR NO KEY UPDATE OF scheduled SKIP LOCKED;
\ No newline at end of file
@@ -130,6 +130,258 @@ func (q *Queries) ListTickers(ctx context.Context, db DBTX) ([]*ListTickersRow,
 	return items, nil
 }
 
+const pollGetGroupKeyRunTimeouts = `-- name: PollGetGroupKeyRunTimeouts :many
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "GetGroupKeyRun"
+    WHERE
+        "timeoutAt" <= NOW()
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        $1::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "GetGroupKeyRun" as getGroupKeyRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    getGroupKeyRuns."id" = timed_out."id"
+RETURNING
+    getGroupKeyRuns."id",
+    getGroupKeyRuns."tenantId",
+    getGroupKeyRuns."workflowRunId",
+    getGroupKeyRuns."status"
+`
+
+type PollGetGroupKeyRunTimeoutsRow struct {
+	ID            pgtype.UUID   `json:"id"`
+	TenantId      pgtype.UUID   `json:"tenantId"`
+	WorkflowRunId pgtype.UUID   `json:"workflowRunId"`
+	Status        StepRunStatus `json:"status"`
+}
+
+func (q *Queries) PollGetGroupKeyRunTimeouts(ctx context.Context, db DBTX, batchsize int32) ([]*PollGetGroupKeyRunTimeoutsRow, error) {
+	rows, err := db.Query(ctx, pollGetGroupKeyRunTimeouts, batchsize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollGetGroupKeyRunTimeoutsRow
+	for rows.Next() {
+		var i PollGetGroupKeyRunTimeoutsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.WorkflowRunId,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pollJobRunTimeouts = `-- name: PollJobRunTimeouts :many
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "JobRun"
+    WHERE
+        "timeoutAt" <= NOW()
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        $1::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "JobRun" as jobRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    jobRuns."id" = timed_out."id"
+RETURNING
+    jobRuns."id",
+    jobRuns."tenantId",
+    jobRuns."status"
+`
+
+type PollJobRunTimeoutsRow struct {
+	ID       pgtype.UUID  `json:"id"`
+	TenantId pgtype.UUID  `json:"tenantId"`
+	Status   JobRunStatus `json:"status"`
+}
+
+func (q *Queries) PollJobRunTimeouts(ctx context.Context, db DBTX, batchsize int32) ([]*PollJobRunTimeoutsRow, error) {
+	rows, err := db.Query(ctx, pollJobRunTimeouts, batchsize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollJobRunTimeoutsRow
+	for rows.Next() {
+		var i PollJobRunTimeoutsRow
+		if err := rows.Scan(&i.ID, &i.TenantId, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pollScheduledWorkflows = `-- name: PollScheduledWorkflows :many
+SELECT
+    scheduled."id",
+    scheduled."parentId",
+    workflows."tenantId"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+WHERE
+    scheduled."triggerAt" <= NOW()
+    -- schedules which were already in the past when their version was created are not triggered
+    AND scheduled."triggerAt" >= versions."createdAt"
+    AND workflows."deletedAt" IS NULL
+    -- paused workflows trigger their schedules when they are resumed
+    AND NOT workflows."isPaused"
+    -- only the latest version of a workflow triggers its schedules
+    AND versions."id" = (
+        SELECT
+            latest."id"
+        FROM
+            "WorkflowVersion" as latest
+        WHERE
+            latest."workflowId" = workflows."id"
+            AND latest."deletedAt" IS NULL
+        ORDER BY
+            latest."order" DESC
+        LIMIT 1
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunTriggeredBy" as triggeredBy
+        WHERE triggeredBy."scheduledId" = scheduled."id"
+    )
+ORDER BY
+    scheduled."triggerAt" ASC
+LIMIT
+    $1::int
+FOR NO KEY UPDATE OF scheduled SKIP LOCKED
+`
+
+type PollScheduledWorkflowsRow struct {
+	ID       pgtype.UUID `json:"id"`
+	ParentId pgtype.UUID `json:"parentId"`
+	TenantId pgtype.UUID `json:"tenantId"`
+}
+
+// Claims scheduled workflows whose trigger time has passed and which have not triggered a workflow run, skipping
+// schedules which are claimed by another ticker. A schedule stops being returned once its workflow run is created.
+// The lock does not block creating the workflow run, which references the schedule.
+func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, batchsize int32) ([]*PollScheduledWorkflowsRow, error) {
+	rows, err := db.Query(ctx, pollScheduledWorkflows, batchsize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollScheduledWorkflowsRow
+	for rows.Next() {
+		var i PollScheduledWorkflowsRow
+		if err := rows.Scan(&i.ID, &i.ParentId, &i.TenantId); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pollStepRunTimeouts = `-- name: PollStepRunTimeouts :many
+WITH timed_out AS (
+    SELECT
+        "id"
+    FROM
+        "StepRun"
+    WHERE
+        "timeoutAt" <= NOW()
+        AND "status" IN ('ASSIGNED', 'RUNNING')
+    ORDER BY
+        "timeoutAt" ASC
+    LIMIT
+        $1::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "StepRun" as stepRuns
+SET
+    "timeoutAt" = NULL
+FROM
+    timed_out
+WHERE
+    stepRuns."id" = timed_out."id"
+RETURNING
+    stepRuns."id",
+    stepRuns."tenantId",
+    stepRuns."jobRunId",
+    stepRuns."status"
+`
+
+type PollStepRunTimeoutsRow struct {
+	ID       pgtype.UUID   `json:"id"`
+	TenantId pgtype.UUID   `json:"tenantId"`
+	JobRunId pgtype.UUID   `json:"jobRunId"`
+	Status   StepRunStatus `json:"status"`
+}
+
+// Claims step runs whose timeout has passed, skipping step runs which are claimed by another ticker. The timeout
+// is cleared when the transaction commits, so it is claimed again if the ticker dies before handling it. Step runs
+// which finished or were requeued before their timeout did not time out.
+func (q *Queries) PollStepRunTimeouts(ctx context.Context, db DBTX, batchsize int32) ([]*PollStepRunTimeoutsRow, error) {
+	rows, err := db.Query(ctx, pollStepRunTimeouts, batchsize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollStepRunTimeoutsRow
+	for rows.Next() {
+		var i PollStepRunTimeoutsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.JobRunId,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setTickersInactive = `-- name: SetTickersInactive :many
 UPDATE
     "Ticker" as tickers
@@ -30,5 +30,74 @@ WHERE
             WHERE runs."workerId" = workers."id" AND runs."status" = 'RUNNING'
         ))
     )
+    AND (
+        sqlc.narg('assignable')::boolean IS NULL OR
+        NOT sqlc.narg('assignable')::boolean OR
+        workers."schedulingStatus" = 'ACTIVE'
+    )
+GROUP BY
+    workers."id";
+
+-- name: ListWorkersToAssign :many
+SELECT
+    workers."id",
+    workers."dispatcherId",
+    workers."maxRuns",
+    workers."lastHeartbeatAt",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" runs
+        WHERE runs."workerId" = workers."id" AND runs."status" IN ('ASSIGNED', 'RUNNING')
+    ) AS "activeStepRuns",
+    array_agg("Action"."actionId")::text[] AS "actions"
+FROM
+    "Worker" workers
+JOIN
+    "_ActionToWorker" ON "_ActionToWorker"."B" = workers."id"
+JOIN
+    "Action" ON "Action"."id" = "_ActionToWorker"."A"
+WHERE
+    workers."tenantId" = @tenantId::uuid
+    AND workers."dispatcherId" IS NOT NULL
+    AND workers."lastHeartbeatAt" > @lastHeartbeatAfter::timestamp
+    AND workers."schedulingStatus" = 'ACTIVE'
+    AND "Action"."actionId" = ANY(@actionIds::text[])
 GROUP BY
-    workers."id";
\ No newline at end of file
+    workers."id";
+
+-- name: CountActiveWorkerStepRuns :one
+SELECT
+    COUNT(*) AS "activeStepRuns"
+FROM
+    "StepRun" runs
+WHERE
+    runs."tenantId" = @tenantId::uuid
+    AND runs."workerId" = @workerId::uuid
+    AND runs."status" IN ('ASSIGNED', 'RUNNING');
+
+-- name: ListWorkerActionSlots :many
+SELECT
+    slots."workerId",
+    slots."actionId",
+    slots."maxRuns",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" runs
+        JOIN "Step" steps ON steps."id" = runs."stepId"
+        WHERE
+            runs."workerId" = slots."workerId"
+            AND runs."status" IN ('ASSIGNED', 'RUNNING')
+            AND steps."actionId" = slots."actionId"
+    ) AS "activeStepRuns"
+FROM
+    "WorkerActionSlot" slots
+WHERE
+    slots."workerId" = ANY(@workerIds::uuid[]);
+
+-- name: DeleteWorker :one
+DELETE FROM
+    "Worker"
+WHERE
+    "id" = @workerId::uuid
+    AND "tenantId" = @tenantId::uuid
+RETURNING *;
@@ -11,9 +11,187 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countActiveWorkerStepRuns = `-- name: CountActiveWorkerStepRuns :one
+SELECT
+    COUNT(*) AS "activeStepRuns"
+FROM
+    "StepRun" runs
+WHERE
+    runs."tenantId" = $1::uuid
+    AND runs."workerId" = $2::uuid
+    AND runs."status" IN ('ASSIGNED', 'RUNNING')
+`
+
+type CountActiveWorkerStepRunsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+func (q *Queries) CountActiveWorkerStepRuns(ctx context.Context, db DBTX, arg CountActiveWorkerStepRunsParams) (int64, error) {
+	row := db.QueryRow(ctx, countActiveWorkerStepRuns, arg.Tenantid, arg.Workerid)
+	var activeStepRuns int64
+	err := row.Scan(&activeStepRuns)
+	return activeStepRuns, err
+}
+
+const deleteWorker = `-- name: DeleteWorker :one
+DELETE FROM
+    "Worker"
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, status, "dispatcherId", "maxRuns", "schedulingStatus"
+`
+
+type DeleteWorkerParams struct {
+	Workerid pgtype.UUID `json:"workerid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, arg DeleteWorkerParams) (*Worker, error) {
+	row := db.QueryRow(ctx, deleteWorker, arg.Workerid, arg.Tenantid)
+	var i Worker
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.LastHeartbeatAt,
+		&i.Name,
+		&i.Status,
+		&i.DispatcherId,
+		&i.MaxRuns,
+		&i.SchedulingStatus,
+	)
+	return &i, err
+}
+
+const listWorkerActionSlots = `-- name: ListWorkerActionSlots :many
+SELECT
+    slots."workerId",
+    slots."actionId",
+    slots."maxRuns",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" runs
+        JOIN "Step" steps ON steps."id" = runs."stepId"
+        WHERE
+            runs."workerId" = slots."workerId"
+            AND runs."status" IN ('ASSIGNED', 'RUNNING')
+            AND steps."actionId" = slots."actionId"
+    ) AS "activeStepRuns"
+FROM
+    "WorkerActionSlot" slots
+WHERE
+    slots."workerId" = ANY($1::uuid[])
+`
+
+type ListWorkerActionSlotsRow struct {
+	WorkerId       pgtype.UUID `json:"workerId"`
+	ActionId       string      `json:"actionId"`
+	MaxRuns        int32       `json:"maxRuns"`
+	ActiveStepRuns int64       `json:"activeStepRuns"`
+}
+
+func (q *Queries) ListWorkerActionSlots(ctx context.Context, db DBTX, workerids []pgtype.UUID) ([]*ListWorkerActionSlotsRow, error) {
+	rows, err := db.Query(ctx, listWorkerActionSlots, workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkerActionSlotsRow
+	for rows.Next() {
+		var i ListWorkerActionSlotsRow
+		if err := rows.Scan(
+			&i.WorkerId,
+			&i.ActionId,
+			&i.MaxRuns,
+			&i.ActiveStepRuns,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkersToAssign = `-- name: ListWorkersToAssign :many
+SELECT
+    workers."id",
+    workers."dispatcherId",
+    workers."maxRuns",
+    workers."lastHeartbeatAt",
+    (
+        SELECT COUNT(*)
+        FROM "StepRun" runs
+        WHERE runs."workerId" = workers."id" AND runs."status" IN ('ASSIGNED', 'RUNNING')
+    ) AS "activeStepRuns",
+    array_agg("Action"."actionId")::text[] AS "actions"
+FROM
+    "Worker" workers
+JOIN
+    "_ActionToWorker" ON "_ActionToWorker"."B" = workers."id"
+JOIN
+    "Action" ON "Action"."id" = "_ActionToWorker"."A"
+WHERE
+    workers."tenantId" = $1::uuid
+    AND workers."dispatcherId" IS NOT NULL
+    AND workers."lastHeartbeatAt" > $2::timestamp
+    AND workers."schedulingStatus" = 'ACTIVE'
+    AND "Action"."actionId" = ANY($3::text[])
+GROUP BY
+    workers."id"
+`
+
+type ListWorkersToAssignParams struct {
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Lastheartbeatafter pgtype.Timestamp `json:"lastheartbeatafter"`
+	Actionids          []string         `json:"actionids"`
+}
+
+type ListWorkersToAssignRow struct {
+	ID              pgtype.UUID      `json:"id"`
+	DispatcherId    pgtype.UUID      `json:"dispatcherId"`
+	MaxRuns         pgtype.Int4      `json:"maxRuns"`
+	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
+	ActiveStepRuns  int64            `json:"activeStepRuns"`
+	Actions         []string         `json:"actions"`
+}
+
+func (q *Queries) ListWorkersToAssign(ctx context.Context, db DBTX, arg ListWorkersToAssignParams) ([]*ListWorkersToAssignRow, error) {
+	rows, err := db.Query(ctx, listWorkersToAssign, arg.Tenantid, arg.Lastheartbeatafter, arg.Actionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkersToAssignRow
+	for rows.Next() {
+		var i ListWorkersToAssignRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DispatcherId,
+			&i.MaxRuns,
+			&i.LastHeartbeatAt,
+			&i.ActiveStepRuns,
+			&i.Actions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkersWithStepCount = `-- name: ListWorkersWithStepCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers.status, workers."dispatcherId", workers."maxRuns",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers.status, workers."dispatcherId", workers."maxRuns", workers."schedulingStatus",
     COUNT(runs."id") FILTER (WHERE runs."status" = 'RUNNING') AS "runningStepRuns"
 FROM
     "Worker" workers
@@ -43,6 +221,11 @@ WHERE
             WHERE runs."workerId" = workers."id" AND runs."status" = 'RUNNING'
         ))
     )
+    AND (
+        $4::boolean IS NULL OR
+        NOT $4::boolean OR
+        workers."schedulingStatus" = 'ACTIVE'
+    )
 GROUP BY
     workers."id"
 `
@@ -84,6 +267,7 @@ func (q *Queries) ListWorkersWithStepCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Status,
 			&i.Worker.DispatcherId,
 			&i.Worker.MaxRuns,
+			&i.Worker.SchedulingStatus,
 			&i.RunningStepRuns,
 		); err != nil {
 			return nil, err
@@ -159,6 +159,27 @@ workflowRun."id" = groupKeyRun."workflowRunId" AND
 workflowRun."tenantId" = @tenantId::uuid
 RETURNING workflowRun.*;
 
+-- name: UpdateWorkflowRunGroupKeyFromExpr :one
+UPDATE "WorkflowRun" workflowRun
+SET "status" = CASE
+    -- Final states are final, cannot be updated. We also can't move out of a queued state
+    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    -- When the group key expression could not be evaluated, then the workflow is failed
+    WHEN sqlc.narg('error')::text IS NOT NULL THEN 'FAILED'
+    ELSE 'QUEUED'
+END, "finishedAt" = CASE
+    -- Final states are final, cannot be updated
+    WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
+    WHEN sqlc.narg('error')::text IS NOT NULL THEN NOW()
+    ELSE "finishedAt"
+END,
+"error" = COALESCE(sqlc.narg('error')::text, "error"),
+"concurrencyGroupId" = COALESCE(sqlc.narg('groupKey')::text, "concurrencyGroupId")
+WHERE
+    workflowRun."id" = @workflowRunId::uuid AND
+    workflowRun."tenantId" = @tenantId::uuid
+RETURNING workflowRun.*;
+
 -- name: ResolveWorkflowRunStatus :one
 WITH jobRuns AS (
     SELECT sum(case when runs."status" = 'PENDING' then 1 else 0 end) AS pendingRuns,
@@ -274,6 +295,8 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     "eventId",
     "cronParentId",
     "cronSchedule",
+    "cronTimezone",
+    "cronFireAt",
     "scheduledId"
 ) VALUES (
     gen_random_uuid(), -- Generates a new UUID for id
@@ -285,6 +308,8 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     sqlc.narg('eventId')::uuid, -- NULL if not provided
     sqlc.narg('cronParentId')::uuid, -- NULL if not provided
     sqlc.narg('cron')::text, -- NULL if not provided
+    sqlc.narg('cronTimezone')::text, -- NULL if not provided
+    sqlc.narg('cronFireAt')::timestamp, -- NULL if not provided
     sqlc.narg('scheduledId')::uuid -- NULL if not provided
 ) RETURNING *;
 
@@ -474,3 +499,63 @@ WHERE
             parent_order."B" = child_run."id"
             AND parent_run."status" != 'SUCCEEDED'
     );
+
+-- name: CreateWorkflowRunEvent :one
+-- Appends an event to the event log of a workflow run. The workflow run is derived from the step run for step run
+-- events. Incrementing the run's last event sequence locks the run, so events are committed in sequence order.
+WITH run AS (
+    UPDATE
+        "WorkflowRun"
+    SET
+        "lastEventSequence" = "lastEventSequence" + 1
+    WHERE
+        "tenantId" = @tenantId::uuid AND
+        "id" = CASE
+            WHEN @resourceType::"WorkflowRunEventResourceType" = 'STEP_RUN' THEN (
+                SELECT
+                    jr."workflowRunId"
+                FROM
+                    "StepRun" sr
+                JOIN
+                    "JobRun" jr ON jr."id" = sr."jobRunId"
+                WHERE
+                    sr."id" = @resourceId::uuid
+            )
+            ELSE @resourceId::uuid
+        END
+    RETURNING "id", "lastEventSequence"
+)
+INSERT INTO "WorkflowRunEvent" (
+    "workflowRunId",
+    "sequence",
+    "createdAt",
+    "tenantId",
+    "resourceType",
+    "resourceId",
+    "eventType",
+    "payload"
+)
+SELECT
+    run."id",
+    run."lastEventSequence",
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @resourceType::"WorkflowRunEventResourceType",
+    @resourceId::uuid,
+    @eventType::"WorkflowRunEventType",
+    sqlc.narg('payload')::text
+FROM
+    run
+RETURNING *;
+
+-- name: ListWorkflowRunEvents :many
+SELECT
+    *
+FROM
+    "WorkflowRunEvent"
+WHERE
+    "tenantId" = @tenantId::uuid AND
+    "workflowRunId" = @workflowRunId::uuid AND
+    "sequence" > @afterSequence::bigint
+ORDER BY
+    "sequence" ASC;
@@ -335,7 +335,7 @@ INSERT INTO "StepRun" (
     NULL,
     NULL,
     '{}'
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "concurrencyKey", progress, "progressMessage", "ackedAt", "shutdownRequeueCount"
 `
 
 type CreateStepRunParams struct {
@@ -384,6 +384,11 @@ func (q *Queries) CreateStepRun(ctx context.Context, db DBTX, arg CreateStepRunP
 		&i.CallerFiles,
 		&i.GitRepoBranch,
 		&i.RetryCount,
+		&i.ConcurrencyKey,
+		&i.Progress,
+		&i.ProgressMessage,
+		&i.AckedAt,
+		&i.ShutdownRequeueCount,
 	)
 	return &i, err
 }
@@ -413,7 +418,7 @@ INSERT INTO "WorkflowRun" (
     NULL, -- assuming error is not set on creation
     NULL, -- assuming startedAt is not set on creation
     NULL  -- assuming finishedAt is not set on creation
-) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "gitRepoBranch"
+) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "gitRepoBranch", "lastEventSequence"
 `
 
 type CreateWorkflowRunParams struct {
@@ -445,6 +450,86 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.LastEventSequence,
+	)
+	return &i, err
+}
+
+const createWorkflowRunEvent = `-- name: CreateWorkflowRunEvent :one
+WITH run AS (
+    UPDATE
+        "WorkflowRun"
+    SET
+        "lastEventSequence" = "lastEventSequence" + 1
+    WHERE
+        "tenantId" = $1::uuid AND
+        "id" = CASE
+            WHEN $2::"WorkflowRunEventResourceType" = 'STEP_RUN' THEN (
+                SELECT
+                    jr."workflowRunId"
+                FROM
+                    "StepRun" sr
+                JOIN
+                    "JobRun" jr ON jr."id" = sr."jobRunId"
+                WHERE
+                    sr."id" = $3::uuid
+            )
+            ELSE $3::uuid
+        END
+    RETURNING "id", "lastEventSequence"
+)
+INSERT INTO "WorkflowRunEvent" (
+    "workflowRunId",
+    "sequence",
+    "createdAt",
+    "tenantId",
+    "resourceType",
+    "resourceId",
+    "eventType",
+    "payload"
+)
+SELECT
+    run."id",
+    run."lastEventSequence",
+    CURRENT_TIMESTAMP,
+    $1::uuid,
+    $2::"WorkflowRunEventResourceType",
+    $3::uuid,
+    $4::"WorkflowRunEventType",
+    $5::text
+FROM
+    run
+RETURNING "workflowRunId", sequence, "createdAt", "tenantId", "resourceType", "resourceId", "eventType", payload
+`
+
+type CreateWorkflowRunEventParams struct {
+	Tenantid     pgtype.UUID                  `json:"tenantid"`
+	Resourcetype WorkflowRunEventResourceType `json:"resourcetype"`
+	Resourceid   pgtype.UUID                  `json:"resourceid"`
+	Eventtype    WorkflowRunEventType         `json:"eventtype"`
+	Payload      pgtype.Text                  `json:"payload"`
+}
+
+// Appends an event to the event log of a workflow run. The workflow run is derived from the step run for step run
+// events. Incrementing the run's last event sequence locks the run, so events are committed in sequence order.
+func (q *Queries) CreateWorkflowRunEvent(ctx context.Context, db DBTX, arg CreateWorkflowRunEventParams) (*WorkflowRunEvent, error) {
+	row := db.QueryRow(ctx, createWorkflowRunEvent,
+		arg.Tenantid,
+		arg.Resourcetype,
+		arg.Resourceid,
+		arg.Eventtype,
+		arg.Payload,
+	)
+	var i WorkflowRunEvent
+	err := row.Scan(
+		&i.WorkflowRunId,
+		&i.Sequence,
+		&i.CreatedAt,
+		&i.TenantId,
+		&i.ResourceType,
+		&i.ResourceId,
+		&i.EventType,
+		&i.Payload,
 	)
 	return &i, err
 }
@@ -460,6 +545,8 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     "eventId",
     "cronParentId",
     "cronSchedule",
+    "cronTimezone",
+    "cronFireAt",
     "scheduledId"
 ) VALUES (
     gen_random_uuid(), -- Generates a new UUID for id
@@ -471,17 +558,21 @@ INSERT INTO "WorkflowRunTriggeredBy" (
     $3::uuid, -- NULL if not provided
     $4::uuid, -- NULL if not provided
     $5::text, -- NULL if not provided
-    $6::uuid -- NULL if not provided
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId"
+    $6::text, -- NULL if not provided
+    $7::timestamp, -- NULL if not provided
+    $8::uuid -- NULL if not provided
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "eventId", "cronParentId", "cronSchedule", "scheduledId", input, "parentId", "cronFireAt", "cronTimezone"
 `
 
 type CreateWorkflowRunTriggeredByParams struct {
-	Tenantid      pgtype.UUID `json:"tenantid"`
-	Workflowrunid pgtype.UUID `json:"workflowrunid"`
-	EventId       pgtype.UUID `json:"eventId"`
-	CronParentId  pgtype.UUID `json:"cronParentId"`
-	Cron          pgtype.Text `json:"cron"`
-	ScheduledId   pgtype.UUID `json:"scheduledId"`
+	Tenantid      pgtype.UUID      `json:"tenantid"`
+	Workflowrunid pgtype.UUID      `json:"workflowrunid"`
+	EventId       pgtype.UUID      `json:"eventId"`
+	CronParentId  pgtype.UUID      `json:"cronParentId"`
+	Cron          pgtype.Text      `json:"cron"`
+	CronTimezone  pgtype.Text      `json:"cronTimezone"`
+	CronFireAt    pgtype.Timestamp `json:"cronFireAt"`
+	ScheduledId   pgtype.UUID      `json:"scheduledId"`
 }
 
 func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg CreateWorkflowRunTriggeredByParams) (*WorkflowRunTriggeredBy, error) {
@@ -491,6 +582,8 @@ func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg
 		arg.EventId,
 		arg.CronParentId,
 		arg.Cron,
+		arg.CronTimezone,
+		arg.CronFireAt,
 		arg.ScheduledId,
 	)
 	var i WorkflowRunTriggeredBy
@@ -506,6 +599,8 @@ func (q *Queries) CreateWorkflowRunTriggeredBy(ctx context.Context, db DBTX, arg
 		&i.ScheduledId,
 		&i.Input,
 		&i.ParentId,
+		&i.CronFireAt,
+		&i.CronTimezone,
 	)
 	return &i, err
 }
@@ -530,7 +625,7 @@ func (q *Queries) LinkStepRunParents(ctx context.Context, db DBTX, jobrunid pgty
 
 const listStartableStepRuns = `-- name: ListStartableStepRuns :many
 SELECT 
-    child_run.id, child_run."createdAt", child_run."updatedAt", child_run."deletedAt", child_run."tenantId", child_run."jobRunId", child_run."stepId", child_run."order", child_run."workerId", child_run."tickerId", child_run.status, child_run.input, child_run.output, child_run."requeueAfter", child_run."scheduleTimeoutAt", child_run.error, child_run."startedAt", child_run."finishedAt", child_run."timeoutAt", child_run."cancelledAt", child_run."cancelledReason", child_run."cancelledError", child_run."inputSchema", child_run."callerFiles", child_run."gitRepoBranch", child_run."retryCount"
+    child_run.id, child_run."createdAt", child_run."updatedAt", child_run."deletedAt", child_run."tenantId", child_run."jobRunId", child_run."stepId", child_run."order", child_run."workerId", child_run."tickerId", child_run.status, child_run.input, child_run.output, child_run."requeueAfter", child_run."scheduleTimeoutAt", child_run.error, child_run."startedAt", child_run."finishedAt", child_run."timeoutAt", child_run."cancelledAt", child_run."cancelledReason", child_run."cancelledError", child_run."inputSchema", child_run."callerFiles", child_run."gitRepoBranch", child_run."retryCount", child_run."concurrencyKey", child_run.progress, child_run."progressMessage", child_run."ackedAt", child_run."shutdownRequeueCount"
 FROM 
     "StepRun" AS child_run
 JOIN 
@@ -592,6 +687,59 @@ func (q *Queries) ListStartableStepRuns(ctx context.Context, db DBTX, arg ListSt
 			&i.CallerFiles,
 			&i.GitRepoBranch,
 			&i.RetryCount,
+			&i.ConcurrencyKey,
+			&i.Progress,
+			&i.ProgressMessage,
+			&i.AckedAt,
+			&i.ShutdownRequeueCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowRunEvents = `-- name: ListWorkflowRunEvents :many
+SELECT
+    "workflowRunId", sequence, "createdAt", "tenantId", "resourceType", "resourceId", "eventType", payload
+FROM
+    "WorkflowRunEvent"
+WHERE
+    "tenantId" = $1::uuid AND
+    "workflowRunId" = $2::uuid AND
+    "sequence" > $3::bigint
+ORDER BY
+    "sequence" ASC
+`
+
+type ListWorkflowRunEventsParams struct {
+	Tenantid      pgtype.UUID `json:"tenantid"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Aftersequence int64       `json:"aftersequence"`
+}
+
+func (q *Queries) ListWorkflowRunEvents(ctx context.Context, db DBTX, arg ListWorkflowRunEventsParams) ([]*WorkflowRunEvent, error) {
+	rows, err := db.Query(ctx, listWorkflowRunEvents, arg.Tenantid, arg.Workflowrunid, arg.Aftersequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WorkflowRunEvent
+	for rows.Next() {
+		var i WorkflowRunEvent
+		if err := rows.Scan(
+			&i.WorkflowRunId,
+			&i.Sequence,
+			&i.CreatedAt,
+			&i.TenantId,
+			&i.ResourceType,
+			&i.ResourceId,
+			&i.EventType,
+			&i.Payload,
 		); err != nil {
 			return nil, err
 		}
@@ -605,10 +753,10 @@ func (q *Queries) ListStartableStepRuns(ctx context.Context, db DBTX, arg ListSt
 
 const listWorkflowRuns = `-- name: ListWorkflowRuns :many
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", 
-    workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, 
-    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", 
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", 
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs."lastEventSequence", 
+    workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, workflow."isPaused", workflow."pausedAt", workflow."queueEventsWhilePaused", workflow."pinnedVersionId", workflow."canaryVersionId", workflow."canaryPercentage", 
+    runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronFireAt", runtriggers."cronTimezone", 
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."workerSelectionStrategy", 
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable events field
     events.id, events.key, events."createdAt", events."updatedAt"
 FROM
@@ -708,6 +856,7 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRun.DisplayName,
 			&i.WorkflowRun.ID,
 			&i.WorkflowRun.GitRepoBranch,
+			&i.WorkflowRun.LastEventSequence,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -715,6 +864,12 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.Workflow.TenantId,
 			&i.Workflow.Name,
 			&i.Workflow.Description,
+			&i.Workflow.IsPaused,
+			&i.Workflow.PausedAt,
+			&i.Workflow.QueueEventsWhilePaused,
+			&i.Workflow.PinnedVersionId,
+			&i.Workflow.CanaryVersionId,
+			&i.Workflow.CanaryPercentage,
 			&i.WorkflowRunTriggeredBy.ID,
 			&i.WorkflowRunTriggeredBy.CreatedAt,
 			&i.WorkflowRunTriggeredBy.UpdatedAt,
@@ -726,6 +881,8 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRunTriggeredBy.ScheduledId,
 			&i.WorkflowRunTriggeredBy.Input,
 			&i.WorkflowRunTriggeredBy.ParentId,
+			&i.WorkflowRunTriggeredBy.CronFireAt,
+			&i.WorkflowRunTriggeredBy.CronTimezone,
 			&i.WorkflowVersion.ID,
 			&i.WorkflowVersion.CreatedAt,
 			&i.WorkflowVersion.UpdatedAt,
@@ -735,6 +892,7 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowVersion.WorkflowId,
 			&i.WorkflowVersion.Checksum,
 			&i.WorkflowVersion.ScheduleTimeout,
+			&i.WorkflowVersion.WorkerSelectionStrategy,
 			&i.ID,
 			&i.Key,
 			&i.CreatedAt,
@@ -794,7 +952,7 @@ FROM
 WHERE
     "WorkflowRun".id = eligible_runs.id
 RETURNING
-    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun"."lastEventSequence"
 `
 
 type PopWorkflowRunsRoundRobinParams struct {
@@ -826,6 +984,7 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 			&i.DisplayName,
 			&i.ID,
 			&i.GitRepoBranch,
+			&i.LastEventSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -886,7 +1045,7 @@ WHERE "id" = (
     FROM "JobRun"
     WHERE "id" = $1::uuid
 ) AND "tenantId" = $2::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun"."lastEventSequence"
 `
 
 type ResolveWorkflowRunStatusParams struct {
@@ -911,6 +1070,7 @@ func (q *Queries) ResolveWorkflowRunStatus(ctx context.Context, db DBTX, arg Res
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.LastEventSequence,
 	)
 	return &i, err
 }
@@ -926,7 +1086,7 @@ SET
 WHERE 
     "tenantId" = $5::uuid AND
     "id" = ANY($6::uuid[])
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun"."lastEventSequence"
 `
 
 type UpdateManyWorkflowRunParams struct {
@@ -968,6 +1128,7 @@ func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg Update
 			&i.DisplayName,
 			&i.ID,
 			&i.GitRepoBranch,
+			&i.LastEventSequence,
 		); err != nil {
 			return nil, err
 		}
@@ -990,7 +1151,7 @@ SET
 WHERE 
     "id" = $5::uuid AND
     "tenantId" = $6::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch"
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."gitRepoBranch", "WorkflowRun"."lastEventSequence"
 `
 
 type UpdateWorkflowRunParams struct {
@@ -1026,6 +1187,7 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.LastEventSequence,
 	)
 	return &i, err
 }
@@ -1059,7 +1221,7 @@ FROM
 WHERE 
 workflowRun."id" = groupKeyRun."workflowRunId" AND
 workflowRun."tenantId" = $1::uuid
-RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."gitRepoBranch"
+RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."gitRepoBranch", workflowrun."lastEventSequence"
 `
 
 type UpdateWorkflowRunGroupKeyParams struct {
@@ -1084,6 +1246,63 @@ func (q *Queries) UpdateWorkflowRunGroupKey(ctx context.Context, db DBTX, arg Up
 		&i.DisplayName,
 		&i.ID,
 		&i.GitRepoBranch,
+		&i.LastEventSequence,
+	)
+	return &i, err
+}
+
+const updateWorkflowRunGroupKeyFromExpr = `-- name: UpdateWorkflowRunGroupKeyFromExpr :one
+UPDATE "WorkflowRun" workflowRun
+SET "status" = CASE
+    -- Final states are final, cannot be updated. We also can't move out of a queued state
+    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    -- When the group key expression could not be evaluated, then the workflow is failed
+    WHEN $1::text IS NOT NULL THEN 'FAILED'
+    ELSE 'QUEUED'
+END, "finishedAt" = CASE
+    -- Final states are final, cannot be updated
+    WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
+    WHEN $1::text IS NOT NULL THEN NOW()
+    ELSE "finishedAt"
+END,
+"error" = COALESCE($1::text, "error"),
+"concurrencyGroupId" = COALESCE($2::text, "concurrencyGroupId")
+WHERE
+    workflowRun."id" = $3::uuid AND
+    workflowRun."tenantId" = $4::uuid
+RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."gitRepoBranch", workflowrun."lastEventSequence"
+`
+
+type UpdateWorkflowRunGroupKeyFromExprParams struct {
+	Error         pgtype.Text `json:"error"`
+	GroupKey      pgtype.Text `json:"groupKey"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) UpdateWorkflowRunGroupKeyFromExpr(ctx context.Context, db DBTX, arg UpdateWorkflowRunGroupKeyFromExprParams) (*WorkflowRun, error) {
+	row := db.QueryRow(ctx, updateWorkflowRunGroupKeyFromExpr,
+		arg.Error,
+		arg.GroupKey,
+		arg.Workflowrunid,
+		arg.Tenantid,
+	)
+	var i WorkflowRun
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.WorkflowVersionId,
+		&i.Status,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ConcurrencyGroupId,
+		&i.DisplayName,
+		&i.ID,
+		&i.GitRepoBranch,
+		&i.LastEventSequence,
 	)
 	return &i, err
 }
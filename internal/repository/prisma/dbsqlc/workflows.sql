@@ -127,6 +127,37 @@ OFFSET
 LIMIT
     COALESCE(sqlc.narg('limit'), 50);
 
+-- name: ListActiveWorkflowCrons :many
+SELECT
+    latestVersion."workflowId" AS "workflowId",
+    sqlc.embed(crons)
+FROM (
+    SELECT
+        DISTINCT ON(workflowVersion."workflowId") workflowVersion."id", workflowVersion."workflowId"
+    FROM
+        "WorkflowVersion" as workflowVersion
+    WHERE
+        workflowVersion."workflowId" = ANY(@workflowIds::uuid[])
+        AND workflowVersion."deletedAt" IS NULL
+    ORDER BY
+        workflowVersion."workflowId", workflowVersion."order" DESC
+) as latestVersion
+JOIN
+    "WorkflowTriggers" as workflowTrigger ON latestVersion."id" = workflowTrigger."workflowVersionId"
+JOIN
+    "WorkflowTriggerCronRef" as crons ON workflowTrigger."id" = crons."parentId";
+
+-- name: UpdateWorkflowRollout :exec
+UPDATE "Workflow"
+SET
+    "pinnedVersionId" = sqlc.narg('pinnedVersionId')::uuid,
+    "canaryVersionId" = sqlc.narg('canaryVersionId')::uuid,
+    "canaryPercentage" = sqlc.narg('canaryPercentage')::int,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+    AND "tenantId" = @tenantId::uuid;
+
 -- name: CreateWorkflow :one
 INSERT INTO "Workflow" (
     "id",
@@ -155,7 +186,8 @@ INSERT INTO "WorkflowVersion" (
     "checksum",
     "version",
     "workflowId",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "workerSelectionStrategy"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -164,7 +196,8 @@ INSERT INTO "WorkflowVersion" (
     @checksum::text,
     sqlc.narg('version')::text,
     @workflowId::uuid,
-    coalesce(sqlc.narg('scheduleTimeout')::text, '5m')
+    coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
+    sqlc.narg('workerSelectionStrategy')::"WorkerSelectionStrategy"
 ) RETURNING *;
 
 -- name: CreateWorkflowConcurrency :one
@@ -175,15 +208,17 @@ INSERT INTO "WorkflowConcurrency" (
     "workflowVersionId",
     "getConcurrencyGroupId",
     "maxRuns",
-    "limitStrategy"
+    "limitStrategy",
+    "concurrencyGroupExpression"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
     coalesce(sqlc.narg('updatedAt')::timestamp, CURRENT_TIMESTAMP),
     @workflowVersionId::uuid,
-    @getConcurrencyGroupId::uuid,
+    sqlc.narg('getConcurrencyGroupId')::uuid,
     coalesce(sqlc.narg('maxRuns')::integer, 1),
-    coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS')
+    coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
+    sqlc.narg('concurrencyGroupExpression')::text
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -222,7 +257,9 @@ INSERT INTO "Step" (
     "timeout",
     "customUserData",
     "retries",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "concurrencyKeyExpr",
+    "concurrencyMaxRuns"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -235,7 +272,9 @@ INSERT INTO "Step" (
     @timeout::text,
     coalesce(sqlc.narg('customUserData')::jsonb, '{}'),
     coalesce(sqlc.narg('retries')::integer, 0),
-    coalesce(sqlc.narg('scheduleTimeout')::text, '5m')
+    coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
+    sqlc.narg('concurrencyKeyExpr')::text,
+    sqlc.narg('concurrencyMaxRuns')::integer
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -319,12 +358,32 @@ INSERT INTO "WorkflowTriggerEventRef" (
 -- name: CreateWorkflowTriggerCronRef :one
 INSERT INTO "WorkflowTriggerCronRef" (
     "parentId",
-    "cron"
+    "cron",
+    "timezone",
+    "input",
+    "misfirePolicy",
+    "maxMisfires",
+    "jitter"
 ) VALUES (
     @workflowTriggersId::uuid,
-    @cronTrigger::text
+    @cronTrigger::text,
+    @timezone::text,
+    sqlc.narg('input')::jsonb,
+    coalesce(sqlc.narg('misfirePolicy')::"CronMisfirePolicy", 'SKIP'),
+    sqlc.narg('maxMisfires')::int,
+    sqlc.narg('jitter')::text
 ) RETURNING *;
 
+-- name: UpdateWorkflowTriggerCronRefLastFiredAt :exec
+UPDATE "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = @lastFiredAt::timestamp
+WHERE
+    "parentId" = @cronParentId::uuid
+    AND "cron" = @cron::text
+    AND "timezone" = @timezone::text
+    AND ("lastFiredAt" IS NULL OR "lastFiredAt" < @lastFiredAt::timestamp);
+
 -- name: CreateWorkflowTriggerScheduledRef :one
 INSERT INTO "WorkflowTriggerScheduledRef" (
     "id",
@@ -339,3 +398,92 @@ INSERT INTO "WorkflowTriggerScheduledRef" (
     NULL, -- or provide a tickerId if applicable
     NULL -- or provide input if applicable
 ) RETURNING *;
+
+-- name: CountScheduledWorkflows :one
+SELECT
+    count(*) AS total
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."scheduledId" = scheduled."id"
+WHERE
+    workflows."tenantId" = @tenantId::uuid
+    AND workflows."deletedAt" IS NULL
+    AND (
+        sqlc.narg('workflowId')::uuid IS NULL OR
+        workflows."id" = sqlc.narg('workflowId')::uuid
+    )
+    AND (
+        sqlc.narg('triggered')::boolean IS NULL OR
+        (triggeredBy."id" IS NOT NULL) = sqlc.narg('triggered')::boolean
+    );
+
+-- name: ListScheduledWorkflows :many
+SELECT
+    sqlc.embed(scheduled),
+    workflows."id" AS "workflowId",
+    workflows."name" AS "workflowName",
+    triggeredBy."parentId" AS "workflowRunId"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."scheduledId" = scheduled."id"
+WHERE
+    workflows."tenantId" = @tenantId::uuid
+    AND workflows."deletedAt" IS NULL
+    AND (
+        sqlc.narg('workflowId')::uuid IS NULL OR
+        workflows."id" = sqlc.narg('workflowId')::uuid
+    )
+    AND (
+        sqlc.narg('triggered')::boolean IS NULL OR
+        (triggeredBy."id" IS NOT NULL) = sqlc.narg('triggered')::boolean
+    )
+ORDER BY
+    scheduled."triggerAt" ASC,
+    scheduled."id" ASC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: LockScheduledWorkflow :one
+-- Locks a scheduled workflow of the tenant, waiting for a ticker which is triggering it. Schedules are only
+-- changed after the lock is held, so they can't change while their workflow run is created.
+SELECT
+    scheduled."id"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+WHERE
+    scheduled."id" = @id::uuid
+    AND workflows."tenantId" = @tenantId::uuid
+FOR UPDATE OF scheduled;
+
+-- name: UpdateScheduledWorkflow :one
+-- Updates a scheduled workflow which has not triggered a workflow run. This must run after LockScheduledWorkflow
+-- in the same transaction, so it sees a workflow run created by a ticker which held the lock.
+UPDATE "WorkflowTriggerScheduledRef" as scheduled
+SET
+    "triggerAt" = COALESCE(sqlc.narg('triggerAt')::timestamp, scheduled."triggerAt"),
+    "input" = COALESCE(sqlc.narg('input')::jsonb, scheduled."input"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    scheduled."id" = @id::uuid
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunTriggeredBy" as triggeredBy
+        WHERE triggeredBy."scheduledId" = scheduled."id"
+    )
+RETURNING *;
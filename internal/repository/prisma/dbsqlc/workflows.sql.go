@@ -49,6 +49,43 @@ func (q *Queries) AddWorkflowTag(ctx context.Context, db DBTX, arg AddWorkflowTa
 	return err
 }
 
+const countScheduledWorkflows = `-- name: CountScheduledWorkflows :one
+SELECT
+    count(*) AS total
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."scheduledId" = scheduled."id"
+WHERE
+    workflows."tenantId" = $1::uuid
+    AND workflows."deletedAt" IS NULL
+    AND (
+        $2::uuid IS NULL OR
+        workflows."id" = $2::uuid
+    )
+    AND (
+        $3::boolean IS NULL OR
+        (triggeredBy."id" IS NOT NULL) = $3::boolean
+    )
+`
+
+type CountScheduledWorkflowsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	WorkflowId pgtype.UUID `json:"workflowId"`
+	Triggered  pgtype.Bool `json:"triggered"`
+}
+
+func (q *Queries) CountScheduledWorkflows(ctx context.Context, db DBTX, arg CountScheduledWorkflowsParams) (int64, error) {
+	row := db.QueryRow(ctx, countScheduledWorkflows, arg.Tenantid, arg.WorkflowId, arg.Triggered)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countWorkflows = `-- name: CountWorkflows :one
 SELECT
     count(workflows) OVER() AS total
@@ -172,7 +209,9 @@ INSERT INTO "Step" (
     "timeout",
     "customUserData",
     "retries",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "concurrencyKeyExpr",
+    "concurrencyMaxRuns"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -185,23 +224,27 @@ INSERT INTO "Step" (
     $9::text,
     coalesce($10::jsonb, '{}'),
     coalesce($11::integer, 0),
-    coalesce($12::text, '5m')
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "scheduleTimeout"
+    coalesce($12::text, '5m'),
+    $13::text,
+    $14::integer
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "scheduleTimeout", "concurrencyKeyExpr", "concurrencyMaxRuns"
 `
 
 type CreateStepParams struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	Deletedat       pgtype.Timestamp `json:"deletedat"`
-	Readableid      string           `json:"readableid"`
-	Tenantid        pgtype.UUID      `json:"tenantid"`
-	Jobid           pgtype.UUID      `json:"jobid"`
-	Actionid        string           `json:"actionid"`
-	Timeout         string           `json:"timeout"`
-	CustomUserData  []byte           `json:"customUserData"`
-	Retries         pgtype.Int4      `json:"retries"`
-	ScheduleTimeout pgtype.Text      `json:"scheduleTimeout"`
+	ID                 pgtype.UUID      `json:"id"`
+	CreatedAt          pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp `json:"updatedAt"`
+	Deletedat          pgtype.Timestamp `json:"deletedat"`
+	Readableid         string           `json:"readableid"`
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Jobid              pgtype.UUID      `json:"jobid"`
+	Actionid           string           `json:"actionid"`
+	Timeout            string           `json:"timeout"`
+	CustomUserData     []byte           `json:"customUserData"`
+	Retries            pgtype.Int4      `json:"retries"`
+	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
+	ConcurrencyKeyExpr pgtype.Text      `json:"concurrencyKeyExpr"`
+	ConcurrencyMaxRuns pgtype.Int4      `json:"concurrencyMaxRuns"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -218,6 +261,8 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.CustomUserData,
 		arg.Retries,
 		arg.ScheduleTimeout,
+		arg.ConcurrencyKeyExpr,
+		arg.ConcurrencyMaxRuns,
 	)
 	var i Step
 	err := row.Scan(
@@ -233,6 +278,8 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.CustomUserData,
 		&i.Retries,
 		&i.ScheduleTimeout,
+		&i.ConcurrencyKeyExpr,
+		&i.ConcurrencyMaxRuns,
 	)
 	return &i, err
 }
@@ -254,7 +301,7 @@ INSERT INTO "Workflow" (
     $5::uuid,
     $6::text,
     $7::text
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused", "pausedAt", "queueEventsWhilePaused", "pinnedVersionId", "canaryVersionId", "canaryPercentage"
 `
 
 type CreateWorkflowParams struct {
@@ -286,6 +333,12 @@ func (q *Queries) CreateWorkflow(ctx context.Context, db DBTX, arg CreateWorkflo
 		&i.TenantId,
 		&i.Name,
 		&i.Description,
+		&i.IsPaused,
+		&i.PausedAt,
+		&i.QueueEventsWhilePaused,
+		&i.PinnedVersionId,
+		&i.CanaryVersionId,
+		&i.CanaryPercentage,
 	)
 	return &i, err
 }
@@ -298,7 +351,8 @@ INSERT INTO "WorkflowConcurrency" (
     "workflowVersionId",
     "getConcurrencyGroupId",
     "maxRuns",
-    "limitStrategy"
+    "limitStrategy",
+    "concurrencyGroupExpression"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -306,18 +360,20 @@ INSERT INTO "WorkflowConcurrency" (
     $4::uuid,
     $5::uuid,
     coalesce($6::integer, 1),
-    coalesce($7::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS')
-) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy"
+    coalesce($7::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
+    $8::text
+) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "concurrencyGroupExpression"
 `
 
 type CreateWorkflowConcurrencyParams struct {
-	ID                    pgtype.UUID                  `json:"id"`
-	CreatedAt             pgtype.Timestamp             `json:"createdAt"`
-	UpdatedAt             pgtype.Timestamp             `json:"updatedAt"`
-	Workflowversionid     pgtype.UUID                  `json:"workflowversionid"`
-	Getconcurrencygroupid pgtype.UUID                  `json:"getconcurrencygroupid"`
-	MaxRuns               pgtype.Int4                  `json:"maxRuns"`
-	LimitStrategy         NullConcurrencyLimitStrategy `json:"limitStrategy"`
+	ID                         pgtype.UUID                  `json:"id"`
+	CreatedAt                  pgtype.Timestamp             `json:"createdAt"`
+	UpdatedAt                  pgtype.Timestamp             `json:"updatedAt"`
+	Workflowversionid          pgtype.UUID                  `json:"workflowversionid"`
+	GetConcurrencyGroupId      pgtype.UUID                  `json:"getConcurrencyGroupId"`
+	MaxRuns                    pgtype.Int4                  `json:"maxRuns"`
+	LimitStrategy              NullConcurrencyLimitStrategy `json:"limitStrategy"`
+	ConcurrencyGroupExpression pgtype.Text                  `json:"concurrencyGroupExpression"`
 }
 
 func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg CreateWorkflowConcurrencyParams) (*WorkflowConcurrency, error) {
@@ -326,9 +382,10 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.Workflowversionid,
-		arg.Getconcurrencygroupid,
+		arg.GetConcurrencyGroupId,
 		arg.MaxRuns,
 		arg.LimitStrategy,
+		arg.ConcurrencyGroupExpression,
 	)
 	var i WorkflowConcurrency
 	err := row.Scan(
@@ -339,6 +396,7 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		&i.GetConcurrencyGroupId,
 		&i.MaxRuns,
 		&i.LimitStrategy,
+		&i.ConcurrencyGroupExpression,
 	)
 	return &i, err
 }
@@ -346,26 +404,54 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 const createWorkflowTriggerCronRef = `-- name: CreateWorkflowTriggerCronRef :one
 INSERT INTO "WorkflowTriggerCronRef" (
     "parentId",
-    "cron"
+    "cron",
+    "timezone",
+    "input",
+    "misfirePolicy",
+    "maxMisfires",
+    "jitter"
 ) VALUES (
     $1::uuid,
-    $2::text
-) RETURNING "parentId", cron, "tickerId", input
+    $2::text,
+    $3::text,
+    $4::jsonb,
+    coalesce($5::"CronMisfirePolicy", 'SKIP'),
+    $6::int,
+    $7::text
+) RETURNING "parentId", cron, "tickerId", input, timezone, "misfirePolicy", "maxMisfires", "lastFiredAt", jitter
 `
 
 type CreateWorkflowTriggerCronRefParams struct {
-	Workflowtriggersid pgtype.UUID `json:"workflowtriggersid"`
-	Crontrigger        string      `json:"crontrigger"`
+	Workflowtriggersid pgtype.UUID           `json:"workflowtriggersid"`
+	Crontrigger        string                `json:"crontrigger"`
+	Timezone           string                `json:"timezone"`
+	Input              []byte                `json:"input"`
+	MisfirePolicy      NullCronMisfirePolicy `json:"misfirePolicy"`
+	MaxMisfires        pgtype.Int4           `json:"maxMisfires"`
+	Jitter             pgtype.Text           `json:"jitter"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefParams) (*WorkflowTriggerCronRef, error) {
-	row := db.QueryRow(ctx, createWorkflowTriggerCronRef, arg.Workflowtriggersid, arg.Crontrigger)
+	row := db.QueryRow(ctx, createWorkflowTriggerCronRef,
+		arg.Workflowtriggersid,
+		arg.Crontrigger,
+		arg.Timezone,
+		arg.Input,
+		arg.MisfirePolicy,
+		arg.MaxMisfires,
+		arg.Jitter,
+	)
 	var i WorkflowTriggerCronRef
 	err := row.Scan(
 		&i.ParentId,
 		&i.Cron,
 		&i.TickerId,
 		&i.Input,
+		&i.Timezone,
+		&i.MisfirePolicy,
+		&i.MaxMisfires,
+		&i.LastFiredAt,
+		&i.Jitter,
 	)
 	return &i, err
 }
@@ -405,7 +491,7 @@ INSERT INTO "WorkflowTriggerScheduledRef" (
     $2::timestamp,
     NULL, -- or provide a tickerId if applicable
     NULL -- or provide input if applicable
-) RETURNING id, "parentId", "triggerAt", "tickerId", input
+) RETURNING id, "parentId", "triggerAt", "tickerId", input, "createdAt", "updatedAt"
 `
 
 type CreateWorkflowTriggerScheduledRefParams struct {
@@ -422,6 +508,8 @@ func (q *Queries) CreateWorkflowTriggerScheduledRef(ctx context.Context, db DBTX
 		&i.TriggerAt,
 		&i.TickerId,
 		&i.Input,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return &i, err
 }
@@ -473,7 +561,8 @@ INSERT INTO "WorkflowVersion" (
     "checksum",
     "version",
     "workflowId",
-    "scheduleTimeout"
+    "scheduleTimeout",
+    "workerSelectionStrategy"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -482,19 +571,21 @@ INSERT INTO "WorkflowVersion" (
     $5::text,
     $6::text,
     $7::uuid,
-    coalesce($8::text, '5m')
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout"
+    coalesce($8::text, '5m'),
+    $9::"WorkerSelectionStrategy"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "workerSelectionStrategy"
 `
 
 type CreateWorkflowVersionParams struct {
-	ID              pgtype.UUID      `json:"id"`
-	CreatedAt       pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp `json:"updatedAt"`
-	Deletedat       pgtype.Timestamp `json:"deletedat"`
-	Checksum        string           `json:"checksum"`
-	Version         pgtype.Text      `json:"version"`
-	Workflowid      pgtype.UUID      `json:"workflowid"`
-	ScheduleTimeout pgtype.Text      `json:"scheduleTimeout"`
+	ID                      pgtype.UUID                 `json:"id"`
+	CreatedAt               pgtype.Timestamp            `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp            `json:"updatedAt"`
+	Deletedat               pgtype.Timestamp            `json:"deletedat"`
+	Checksum                string                      `json:"checksum"`
+	Version                 pgtype.Text                 `json:"version"`
+	Workflowid              pgtype.UUID                 `json:"workflowid"`
+	ScheduleTimeout         pgtype.Text                 `json:"scheduleTimeout"`
+	WorkerSelectionStrategy NullWorkerSelectionStrategy `json:"workerSelectionStrategy"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -507,6 +598,7 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.Version,
 		arg.Workflowid,
 		arg.ScheduleTimeout,
+		arg.WorkerSelectionStrategy,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -519,21 +611,165 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.WorkflowId,
 		&i.Checksum,
 		&i.ScheduleTimeout,
+		&i.WorkerSelectionStrategy,
 	)
 	return &i, err
 }
 
+const listActiveWorkflowCrons = `-- name: ListActiveWorkflowCrons :many
+SELECT
+    latestVersion."workflowId" AS "workflowId",
+    crons."parentId", crons.cron, crons."tickerId", crons.input, crons.timezone, crons."misfirePolicy", crons."maxMisfires", crons."lastFiredAt", crons.jitter
+FROM (
+    SELECT
+        DISTINCT ON(workflowVersion."workflowId") workflowVersion."id", workflowVersion."workflowId"
+    FROM
+        "WorkflowVersion" as workflowVersion
+    WHERE
+        workflowVersion."workflowId" = ANY($1::uuid[])
+        AND workflowVersion."deletedAt" IS NULL
+    ORDER BY
+        workflowVersion."workflowId", workflowVersion."order" DESC
+) as latestVersion
+JOIN
+    "WorkflowTriggers" as workflowTrigger ON latestVersion."id" = workflowTrigger."workflowVersionId"
+JOIN
+    "WorkflowTriggerCronRef" as crons ON workflowTrigger."id" = crons."parentId"
+`
+
+type ListActiveWorkflowCronsRow struct {
+	WorkflowId             pgtype.UUID            `json:"workflowId"`
+	WorkflowTriggerCronRef WorkflowTriggerCronRef `json:"workflow_trigger_cron_ref"`
+}
+
+func (q *Queries) ListActiveWorkflowCrons(ctx context.Context, db DBTX, workflowids []pgtype.UUID) ([]*ListActiveWorkflowCronsRow, error) {
+	rows, err := db.Query(ctx, listActiveWorkflowCrons, workflowids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListActiveWorkflowCronsRow
+	for rows.Next() {
+		var i ListActiveWorkflowCronsRow
+		if err := rows.Scan(
+			&i.WorkflowId,
+			&i.WorkflowTriggerCronRef.ParentId,
+			&i.WorkflowTriggerCronRef.Cron,
+			&i.WorkflowTriggerCronRef.TickerId,
+			&i.WorkflowTriggerCronRef.Input,
+			&i.WorkflowTriggerCronRef.Timezone,
+			&i.WorkflowTriggerCronRef.MisfirePolicy,
+			&i.WorkflowTriggerCronRef.MaxMisfires,
+			&i.WorkflowTriggerCronRef.LastFiredAt,
+			&i.WorkflowTriggerCronRef.Jitter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledWorkflows = `-- name: ListScheduledWorkflows :many
+SELECT
+    scheduled.id, scheduled."parentId", scheduled."triggerAt", scheduled."tickerId", scheduled.input, scheduled."createdAt", scheduled."updatedAt",
+    workflows."id" AS "workflowId",
+    workflows."name" AS "workflowName",
+    triggeredBy."parentId" AS "workflowRunId"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" as triggeredBy ON triggeredBy."scheduledId" = scheduled."id"
+WHERE
+    workflows."tenantId" = $1::uuid
+    AND workflows."deletedAt" IS NULL
+    AND (
+        $2::uuid IS NULL OR
+        workflows."id" = $2::uuid
+    )
+    AND (
+        $3::boolean IS NULL OR
+        (triggeredBy."id" IS NOT NULL) = $3::boolean
+    )
+ORDER BY
+    scheduled."triggerAt" ASC,
+    scheduled."id" ASC
+OFFSET
+    COALESCE($4, 0)
+LIMIT
+    COALESCE($5, 50)
+`
+
+type ListScheduledWorkflowsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	WorkflowId pgtype.UUID `json:"workflowId"`
+	Triggered  pgtype.Bool `json:"triggered"`
+	Offset     interface{} `json:"offset"`
+	Limit      interface{} `json:"limit"`
+}
+
+type ListScheduledWorkflowsRow struct {
+	WorkflowTriggerScheduledRef WorkflowTriggerScheduledRef `json:"workflow_trigger_scheduled_ref"`
+	WorkflowId                  pgtype.UUID                 `json:"workflowId"`
+	WorkflowName                string                      `json:"workflowName"`
+	WorkflowRunId               pgtype.UUID                 `json:"workflowRunId"`
+}
+
+func (q *Queries) ListScheduledWorkflows(ctx context.Context, db DBTX, arg ListScheduledWorkflowsParams) ([]*ListScheduledWorkflowsRow, error) {
+	rows, err := db.Query(ctx, listScheduledWorkflows,
+		arg.Tenantid,
+		arg.WorkflowId,
+		arg.Triggered,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListScheduledWorkflowsRow
+	for rows.Next() {
+		var i ListScheduledWorkflowsRow
+		if err := rows.Scan(
+			&i.WorkflowTriggerScheduledRef.ID,
+			&i.WorkflowTriggerScheduledRef.ParentId,
+			&i.WorkflowTriggerScheduledRef.TriggerAt,
+			&i.WorkflowTriggerScheduledRef.TickerId,
+			&i.WorkflowTriggerScheduledRef.Input,
+			&i.WorkflowTriggerScheduledRef.CreatedAt,
+			&i.WorkflowTriggerScheduledRef.UpdatedAt,
+			&i.WorkflowId,
+			&i.WorkflowName,
+			&i.WorkflowRunId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT 
-    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description
+    workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused", workflows."pausedAt", workflows."queueEventsWhilePaused", workflows."pinnedVersionId", workflows."canaryVersionId", workflows."canaryPercentage"
 FROM (
     SELECT
-        DISTINCT ON(workflows."id") workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description
+        DISTINCT ON(workflows."id") workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused", workflows."pausedAt", workflows."queueEventsWhilePaused", workflows."pinnedVersionId", workflows."canaryVersionId", workflows."canaryPercentage"
     FROM
         "Workflow" as workflows 
     LEFT JOIN
         (
-            SELECT id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout" FROM "WorkflowVersion" as workflowVersion ORDER BY workflowVersion."order" DESC LIMIT 1
+            SELECT id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "workerSelectionStrategy" FROM "WorkflowVersion" as workflowVersion ORDER BY workflowVersion."order" DESC LIMIT 1
         ) as workflowVersion ON workflows."id" = workflowVersion."workflowId"
     LEFT JOIN
         "WorkflowTriggers" as workflowTrigger ON workflowVersion."id" = workflowTrigger."workflowVersionId"
@@ -614,6 +850,12 @@ func (q *Queries) ListWorkflows(ctx context.Context, db DBTX, arg ListWorkflowsP
 			&i.Workflow.TenantId,
 			&i.Workflow.Name,
 			&i.Workflow.Description,
+			&i.Workflow.IsPaused,
+			&i.Workflow.PausedAt,
+			&i.Workflow.QueueEventsWhilePaused,
+			&i.Workflow.PinnedVersionId,
+			&i.Workflow.CanaryVersionId,
+			&i.Workflow.CanaryPercentage,
 		); err != nil {
 			return nil, err
 		}
@@ -627,7 +869,7 @@ func (q *Queries) ListWorkflows(ctx context.Context, db DBTX, arg ListWorkflowsP
 
 const listWorkflowsLatestRuns = `-- name: ListWorkflowsLatestRuns :many
 SELECT
-    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", workflow."id" as "workflowId"
+    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."gitRepoBranch", runs."lastEventSequence", workflow."id" as "workflowId"
 FROM
     "WorkflowRun" as runs
 LEFT JOIN
@@ -699,6 +941,7 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 			&i.WorkflowRun.DisplayName,
 			&i.WorkflowRun.ID,
 			&i.WorkflowRun.GitRepoBranch,
+			&i.WorkflowRun.LastEventSequence,
 			&i.WorkflowId,
 		); err != nil {
 			return nil, err
@@ -711,6 +954,133 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 	return items, nil
 }
 
+const lockScheduledWorkflow = `-- name: LockScheduledWorkflow :one
+SELECT
+    scheduled."id"
+FROM
+    "WorkflowTriggerScheduledRef" as scheduled
+JOIN
+    "WorkflowVersion" as versions ON versions."id" = scheduled."parentId"
+JOIN
+    "Workflow" as workflows ON workflows."id" = versions."workflowId"
+WHERE
+    scheduled."id" = $1::uuid
+    AND workflows."tenantId" = $2::uuid
+FOR UPDATE OF scheduled
+`
+
+type LockScheduledWorkflowParams struct {
+	ID       pgtype.UUID `json:"id"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+// Locks a scheduled workflow of the tenant, waiting for a ticker which is triggering it. Schedules are only
+// changed after the lock is held, so they can't change while their workflow run is created.
+func (q *Queries) LockScheduledWorkflow(ctx context.Context, db DBTX, arg LockScheduledWorkflowParams) (pgtype.UUID, error) {
+	row := db.QueryRow(ctx, lockScheduledWorkflow, arg.ID, arg.Tenantid)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateScheduledWorkflow = `-- name: UpdateScheduledWorkflow :one
+UPDATE "WorkflowTriggerScheduledRef" as scheduled
+SET
+    "triggerAt" = COALESCE($1::timestamp, scheduled."triggerAt"),
+    "input" = COALESCE($2::jsonb, scheduled."input"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    scheduled."id" = $3::uuid
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunTriggeredBy" as triggeredBy
+        WHERE triggeredBy."scheduledId" = scheduled."id"
+    )
+RETURNING id, "parentId", "triggerAt", "tickerId", input, "createdAt", "updatedAt"
+`
+
+type UpdateScheduledWorkflowParams struct {
+	TriggerAt pgtype.Timestamp `json:"triggerAt"`
+	Input     []byte           `json:"input"`
+	ID        pgtype.UUID      `json:"id"`
+}
+
+// Updates a scheduled workflow which has not triggered a workflow run. This must run after LockScheduledWorkflow
+// in the same transaction, so it sees a workflow run created by a ticker which held the lock.
+func (q *Queries) UpdateScheduledWorkflow(ctx context.Context, db DBTX, arg UpdateScheduledWorkflowParams) (*WorkflowTriggerScheduledRef, error) {
+	row := db.QueryRow(ctx, updateScheduledWorkflow, arg.TriggerAt, arg.Input, arg.ID)
+	var i WorkflowTriggerScheduledRef
+	err := row.Scan(
+		&i.ID,
+		&i.ParentId,
+		&i.TriggerAt,
+		&i.TickerId,
+		&i.Input,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const updateWorkflowRollout = `-- name: UpdateWorkflowRollout :exec
+UPDATE "Workflow"
+SET
+    "pinnedVersionId" = $1::uuid,
+    "canaryVersionId" = $2::uuid,
+    "canaryPercentage" = $3::int,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $4::uuid
+    AND "tenantId" = $5::uuid
+`
+
+type UpdateWorkflowRolloutParams struct {
+	PinnedVersionId  pgtype.UUID `json:"pinnedVersionId"`
+	CanaryVersionId  pgtype.UUID `json:"canaryVersionId"`
+	CanaryPercentage pgtype.Int4 `json:"canaryPercentage"`
+	ID               pgtype.UUID `json:"id"`
+	Tenantid         pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) UpdateWorkflowRollout(ctx context.Context, db DBTX, arg UpdateWorkflowRolloutParams) error {
+	_, err := db.Exec(ctx, updateWorkflowRollout,
+		arg.PinnedVersionId,
+		arg.CanaryVersionId,
+		arg.CanaryPercentage,
+		arg.ID,
+		arg.Tenantid,
+	)
+	return err
+}
+
+const updateWorkflowTriggerCronRefLastFiredAt = `-- name: UpdateWorkflowTriggerCronRefLastFiredAt :exec
+UPDATE "WorkflowTriggerCronRef"
+SET
+    "lastFiredAt" = $1::timestamp
+WHERE
+    "parentId" = $2::uuid
+    AND "cron" = $3::text
+    AND "timezone" = $4::text
+    AND ("lastFiredAt" IS NULL OR "lastFiredAt" < $1::timestamp)
+`
+
+type UpdateWorkflowTriggerCronRefLastFiredAtParams struct {
+	Lastfiredat  pgtype.Timestamp `json:"lastfiredat"`
+	Cronparentid pgtype.UUID      `json:"cronparentid"`
+	Cron         string           `json:"cron"`
+	Timezone     string           `json:"timezone"`
+}
+
+func (q *Queries) UpdateWorkflowTriggerCronRefLastFiredAt(ctx context.Context, db DBTX, arg UpdateWorkflowTriggerCronRefLastFiredAtParams) error {
+	_, err := db.Exec(ctx, updateWorkflowTriggerCronRefLastFiredAt,
+		arg.Lastfiredat,
+		arg.Cronparentid,
+		arg.Cron,
+		arg.Timezone,
+	)
+	return err
+}
+
 const upsertAction = `-- name: UpsertAction :one
 INSERT INTO "Action" (
     "id",
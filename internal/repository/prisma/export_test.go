@@ -0,0 +1,4 @@
+package prisma
+
+// SelectLeastLoaded is exported for the integration tests in package prisma_test.
+var SelectLeastLoaded = selectLeastLoaded
@@ -125,6 +125,10 @@ func (s *getGroupKeyRunRepository) UpdateGetGroupKeyRun(tenantId, getGroupKeyRun
 		updateParams.ScheduleTimeoutAt = sqlchelpers.TimestampFromTime(*opts.ScheduleTimeoutAt)
 	}
 
+	if opts.TimeoutAt != nil {
+		updateParams.TimeoutAt = sqlchelpers.TimestampFromTime(*opts.TimeoutAt)
+	}
+
 	tx, err := s.pool.Begin(context.Background())
 
 	if err != nil {
@@ -101,6 +101,10 @@ func (j *jobRunRepository) UpdateJobRun(tenantId, jobRunId string, opts *reposit
 		params = append(params, db.JobRun.Status.Set(*opts.Status))
 	}
 
+	if opts.TimeoutAt != nil {
+		params = append(params, db.JobRun.TimeoutAt.Set(*opts.TimeoutAt))
+	}
+
 	return j.client.JobRun.FindUnique(
 		db.JobRun.ID.Equals(jobRunId),
 	).With(
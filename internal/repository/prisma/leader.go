@@ -0,0 +1,94 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+type leaderRepository struct {
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+}
+
+func NewLeaderRepository(pool *pgxpool.Pool) repository.LeaderRepository {
+	queries := dbsqlc.New()
+
+	return &leaderRepository{
+		pool:    pool,
+		queries: queries,
+	}
+}
+
+// TryAcquire takes a session-level advisory lock on a connection which is taken out of the pool, so the lock is
+// held for as long as the lease keeps the connection.
+func (r *leaderRepository) TryAcquire(ctx context.Context, name string) (repository.LeaderLease, error) {
+	key := leaderLockKey(name)
+
+	conn, err := r.pool.Acquire(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire connection: %w", err)
+	}
+
+	locked, err := r.queries.TryAdvisoryLock(ctx, conn, key)
+
+	if err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("could not try advisory lock: %w", err)
+	}
+
+	if !locked {
+		conn.Release()
+		return nil, nil
+	}
+
+	return &leaderLease{
+		conn:    conn,
+		queries: r.queries,
+		key:     key,
+	}, nil
+}
+
+type leaderLease struct {
+	conn    *pgxpool.Conn
+	queries *dbsqlc.Queries
+	key     int64
+}
+
+func (l *leaderLease) Alive(ctx context.Context) bool {
+	return l.conn.Ping(ctx) == nil
+}
+
+func (l *leaderLease) Release(ctx context.Context) error {
+	_, err := l.queries.AdvisoryUnlock(ctx, l.conn, l.key)
+
+	if err != nil {
+		// closing the connection releases the lock, and keeps the connection from being reused
+		closeErr := l.conn.Conn().Close(ctx)
+		l.conn.Release()
+
+		if closeErr != nil {
+			return fmt.Errorf("could not unlock advisory lock: %w, and could not close connection: %v", err, closeErr)
+		}
+
+		return nil
+	}
+
+	l.conn.Release()
+
+	return nil
+}
+
+// leaderLockKey hashes the lock name into the key space of Postgres advisory locks.
+func leaderLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("hatchet-leader:" + name)) // nolint: errcheck
+
+	return int64(h.Sum64()) // nolint: gosec
+}
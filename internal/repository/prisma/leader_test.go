@@ -0,0 +1,41 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestLeaderLockIsExclusive(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		leaders := conf.Repository.Leader()
+		name := uuid.New().String()
+
+		lease, err := leaders.TryAcquire(ctx, name)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		assert.True(t, lease.Alive(ctx))
+
+		// the lock is held on its own connection, so another campaign for it fails
+		other, err := leaders.TryAcquire(ctx, name)
+		require.NoError(t, err)
+		assert.Nil(t, other)
+
+		require.NoError(t, lease.Release(ctx))
+
+		other, err = leaders.TryAcquire(ctx, name)
+		require.NoError(t, err)
+		require.NotNil(t, other)
+
+		return other.Release(ctx)
+	})
+}
@@ -29,6 +29,7 @@ type prismaRepository struct {
 	userSession    repository.UserSessionRepository
 	user           repository.UserRepository
 	health         repository.HealthRepository
+	leader         repository.LeaderRepository
 }
 
 type PrismaRepositoryOpt func(*PrismaRepositoryOpts)
@@ -86,6 +87,7 @@ func NewPrismaRepository(client *db.PrismaClient, pool *pgxpool.Pool, fs ...Pris
 		userSession:    NewUserSessionRepository(client, opts.v),
 		user:           NewUserRepository(client, opts.v),
 		health:         NewHealthRepository(client, pool),
+		leader:         NewLeaderRepository(pool),
 	}
 }
 
@@ -164,3 +166,7 @@ func (r *prismaRepository) UserSession() repository.UserSessionRepository {
 func (r *prismaRepository) User() repository.UserRepository {
 	return r.user
 }
+
+func (r *prismaRepository) Leader() repository.LeaderRepository {
+	return r.leader
+}
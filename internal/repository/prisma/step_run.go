@@ -2,7 +2,9 @@ package prisma
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
 
@@ -126,6 +129,325 @@ func (s *stepRunRepository) ListStepRunsToReassign(tenantId string) ([]*dbsqlc.S
 	return stepRuns, nil
 }
 
+func (s *stepRunRepository) ListLostStepRuns(tenantId string, opts *repository.ListLostStepRunsOpts) ([]*dbsqlc.StepRun, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	inFlightStepRunIds := make([]pgtype.UUID, len(opts.InFlightStepRunIds))
+
+	for i, stepRunId := range opts.InFlightStepRunIds {
+		inFlightStepRunIds[i] = sqlchelpers.UUIDFromStr(stepRunId)
+	}
+
+	return s.queries.ListLostStepRuns(context.Background(), s.pool, dbsqlc.ListLostStepRunsParams{
+		Tenantid:           sqlchelpers.UUIDFromStr(tenantId),
+		Workerid:           sqlchelpers.UUIDFromStr(opts.WorkerId),
+		Inflightsteprunids: inFlightStepRunIds,
+		Updatedbefore:      sqlchelpers.TimestampFromTime(opts.UpdatedBefore.UTC()),
+	})
+}
+
+func (s *stepRunRepository) AssignStepRuns(tenantId string, opts *repository.AssignStepRunsOpts) (*repository.AssignStepRunsResult, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	tx, err := s.pool.Begin(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(context.Background(), s.l, tx.Rollback)
+
+	// claim the step runs, skipping any which are locked by a concurrent pass
+	stepRuns, err := s.queries.ListStepRunsToAssign(context.Background(), tx, dbsqlc.ListStepRunsToAssignParams{
+		Tenantid:           pgTenantId,
+		Batchsize:          int32(opts.BatchSize),
+		Lastheartbeatafter: sqlchelpers.TimestampFromTime(opts.LastHeartbeatAfter),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs to assign: %w", err)
+	}
+
+	res := &repository.AssignStepRunsResult{
+		Claimed: len(stepRuns),
+	}
+
+	if len(stepRuns) == 0 {
+		return res, nil
+	}
+
+	actionIds := []string{}
+	seenActionIds := map[string]bool{}
+
+	concurrencyKeys := []string{}
+	lockKeys := []string{}
+	seenLockKeys := map[string]bool{}
+
+	for _, stepRun := range stepRuns {
+		if !seenActionIds[stepRun.ActionId] {
+			seenActionIds[stepRun.ActionId] = true
+			actionIds = append(actionIds, stepRun.ActionId)
+		}
+
+		if stepRun.ConcurrencyKey.Valid {
+			lockKey := stepRunConcurrencyLockKey(tenantId, stepRun.ActionId, stepRun.ConcurrencyKey.String)
+
+			if !seenLockKeys[lockKey] {
+				seenLockKeys[lockKey] = true
+				lockKeys = append(lockKeys, lockKey)
+				concurrencyKeys = append(concurrencyKeys, stepRun.ConcurrencyKey.String)
+			}
+		}
+	}
+
+	workers, err := s.queries.ListWorkersToAssign(context.Background(), tx, dbsqlc.ListWorkersToAssignParams{
+		Tenantid:           pgTenantId,
+		Lastheartbeatafter: sqlchelpers.TimestampFromTime(opts.LastHeartbeatAfter),
+		Actionids:          actionIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workers to assign: %w", err)
+	}
+
+	if len(workers) == 0 {
+		return res, nil
+	}
+
+	// if any step runs have a concurrency key, lock the keys and count the step runs which are already active
+	activeByLockKey := map[string]int64{}
+
+	if len(lockKeys) > 0 {
+		err = s.queries.AcquireStepRunConcurrencyLocks(context.Background(), tx, lockKeys)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not acquire step run concurrency locks: %w", err)
+		}
+
+		counts, err := s.queries.CountActiveStepRunsByConcurrencyKey(context.Background(), tx, dbsqlc.CountActiveStepRunsByConcurrencyKeyParams{
+			Tenantid:        pgTenantId,
+			Concurrencykeys: concurrencyKeys,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not count active step runs: %w", err)
+		}
+
+		for _, count := range counts {
+			activeByLockKey[stepRunConcurrencyLockKey(tenantId, count.ActionId, count.ConcurrencyKey.String)] = count.Total
+		}
+	}
+
+	// count the active step runs for the actions which the workers limit with action slots
+	pgWorkerIds := make([]pgtype.UUID, 0, len(workers))
+
+	for _, worker := range workers {
+		pgWorkerIds = append(pgWorkerIds, worker.ID)
+	}
+
+	actionSlots, err := s.queries.ListWorkerActionSlots(context.Background(), tx, pgWorkerIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list worker action slots: %w", err)
+	}
+
+	stepRunIds, workerIds := planStepRunAssignments(tenantId, stepRuns, workers, actionSlots, activeByLockKey, opts.SelectWorker)
+
+	if len(stepRunIds) == 0 {
+		return res, nil
+	}
+
+	// the timeout of a step run starts when it is assigned
+	timeouts := make(map[string]pgtype.Text, len(stepRuns))
+
+	for _, stepRun := range stepRuns {
+		timeouts[sqlchelpers.UUIDToStr(stepRun.ID)] = stepRun.Timeout
+	}
+
+	now := time.Now().UTC()
+	timeoutAts := make([]pgtype.Timestamp, 0, len(stepRunIds))
+
+	for _, stepRunId := range stepRunIds {
+		timeoutAts = append(timeoutAts, sqlchelpers.TimestampFromTime(now.Add(stepRunTimeout(timeouts[sqlchelpers.UUIDToStr(stepRunId)]))))
+	}
+
+	assigned, err := s.queries.BulkAssignStepRunsToWorkers(context.Background(), tx, dbsqlc.BulkAssignStepRunsToWorkersParams{
+		Tenantid:   pgTenantId,
+		Steprunids: stepRunIds,
+		Workerids:  workerIds,
+		Timeoutats: timeoutAts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not assign step runs to workers: %w", err)
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	dispatcherIds := map[string]string{}
+
+	for _, worker := range workers {
+		dispatcherIds[sqlchelpers.UUIDToStr(worker.ID)] = sqlchelpers.UUIDToStr(worker.DispatcherId)
+	}
+
+	for _, row := range assigned {
+		workerId := sqlchelpers.UUIDToStr(row.WorkerId)
+
+		res.Assignments = append(res.Assignments, &repository.StepRunAssignment{
+			StepRunId:    sqlchelpers.UUIDToStr(row.ID),
+			JobRunId:     sqlchelpers.UUIDToStr(row.JobRunId),
+			WorkerId:     workerId,
+			DispatcherId: dispatcherIds[workerId],
+		})
+	}
+
+	return res, nil
+}
+
+// planStepRunAssignments matches step runs to workers in order, using the step run's worker selection strategy to
+// pick from the workers with a free slot for the step run's action. A worker has a free slot if it is below both its
+// max runs and the slots for the action, if it set any. Step runs whose concurrency key has reached the step's limit
+// are skipped. It returns the ids of the assigned step runs and their workers.
+func planStepRunAssignments(
+	tenantId string,
+	stepRuns []*dbsqlc.ListStepRunsToAssignRow,
+	workers []*dbsqlc.ListWorkersToAssignRow,
+	actionSlots []*dbsqlc.ListWorkerActionSlotsRow,
+	activeByLockKey map[string]int64,
+	selectWorker repository.SelectWorkerFunc,
+) (stepRunIds []pgtype.UUID, workerIds []pgtype.UUID) {
+	// order the workers by id, so selectors see the candidates in a stable order across passes
+	workers = append([]*dbsqlc.ListWorkersToAssignRow{}, workers...)
+
+	sort.Slice(workers, func(i, j int) bool {
+		return sqlchelpers.UUIDToStr(workers[i].ID) < sqlchelpers.UUIDToStr(workers[j].ID)
+	})
+
+	// the number of active step runs per worker, including the ones assigned in this pass
+	load := make([]int64, len(workers))
+	workersByAction := map[string][]int{}
+
+	for i, worker := range workers {
+		load[i] = worker.ActiveStepRuns
+
+		for _, action := range worker.Actions {
+			workersByAction[action] = append(workersByAction[action], i)
+		}
+	}
+
+	// the action slots per worker and action, with the active step runs including the ones assigned in this pass
+	slotsByWorkerAction := map[string]*dbsqlc.ListWorkerActionSlotsRow{}
+
+	for _, slot := range actionSlots {
+		slot := *slot
+		slotsByWorkerAction[workerActionKey(slot.WorkerId, slot.ActionId)] = &slot
+	}
+
+	for _, stepRun := range stepRuns {
+		var lockKey string
+
+		if stepRun.ConcurrencyKey.Valid {
+			lockKey = stepRunConcurrencyLockKey(tenantId, stepRun.ActionId, stepRun.ConcurrencyKey.String)
+
+			maxRuns := int64(1)
+
+			if stepRun.ConcurrencyMaxRuns.Valid {
+				maxRuns = int64(stepRun.ConcurrencyMaxRuns.Int32)
+			}
+
+			if activeByLockKey[lockKey] >= maxRuns {
+				continue
+			}
+		}
+
+		candidates := []*repository.WorkerCandidate{}
+		candidateWorkers := []int{}
+
+		for _, i := range workersByAction[stepRun.ActionId] {
+			if workers[i].MaxRuns.Valid && load[i] >= int64(workers[i].MaxRuns.Int32) {
+				continue
+			}
+
+			if slot, ok := slotsByWorkerAction[workerActionKey(workers[i].ID, stepRun.ActionId)]; ok && slot.ActiveStepRuns >= int64(slot.MaxRuns) {
+				continue
+			}
+
+			candidate := &repository.WorkerCandidate{
+				WorkerId:        sqlchelpers.UUIDToStr(workers[i].ID),
+				ActiveStepRuns:  int(load[i]),
+				LastHeartbeatAt: workers[i].LastHeartbeatAt.Time,
+			}
+
+			if workers[i].MaxRuns.Valid {
+				maxRuns := int(workers[i].MaxRuns.Int32)
+				candidate.MaxRuns = &maxRuns
+			}
+
+			candidates = append(candidates, candidate)
+			candidateWorkers = append(candidateWorkers, i)
+		}
+
+		if len(candidates) == 0 {
+			continue
+		}
+
+		selectedCandidate, ok := selectWorker(stepRun.WorkerSelectionStrategy, candidates)
+
+		if !ok || selectedCandidate < 0 || selectedCandidate >= len(candidates) {
+			continue
+		}
+
+		selected := candidateWorkers[selectedCandidate]
+
+		load[selected]++
+
+		if slot, ok := slotsByWorkerAction[workerActionKey(workers[selected].ID, stepRun.ActionId)]; ok {
+			slot.ActiveStepRuns++
+		}
+
+		if lockKey != "" {
+			activeByLockKey[lockKey]++
+		}
+
+		stepRunIds = append(stepRunIds, stepRun.ID)
+		workerIds = append(workerIds, workers[selected].ID)
+	}
+
+	return stepRunIds, workerIds
+}
+
+// stepRunTimeout returns the duration of a step's timeout, or the default step run timeout if the step does not
+// have a valid timeout.
+func stepRunTimeout(timeout pgtype.Text) time.Duration {
+	if timeout.Valid && timeout.String != "" {
+		if duration, err := time.ParseDuration(timeout.String); err == nil {
+			return duration
+		}
+	}
+
+	duration, _ := time.ParseDuration(defaults.DefaultStepRunTimeout)
+
+	return duration
+}
+
+func workerActionKey(workerId pgtype.UUID, actionId string) string {
+	return fmt.Sprintf("%s:%s", sqlchelpers.UUIDToStr(workerId), actionId)
+}
+
+func stepRunConcurrencyLockKey(tenantId, actionId, concurrencyKey string) string {
+	return fmt.Sprintf("%s:%s:%s", tenantId, actionId, concurrencyKey)
+}
+
 func (s *stepRunRepository) ListStepRuns(tenantId string, opts *repository.ListStepRunsOpts) ([]db.StepRunModel, error) {
 	if err := s.v.Validate(opts); err != nil {
 		return nil, err
@@ -350,6 +672,89 @@ func (s *stepRunRepository) UpdateStepRunInputSchema(tenantId, stepRunId string,
 	return inputSchema, nil
 }
 
+func (s *stepRunRepository) RefreshStepRunTimeout(tenantId, stepRunId string, timeoutAt time.Time) (*dbsqlc.StepRun, error) {
+	stepRun, err := s.queries.RefreshStepRunTimeout(context.Background(), s.pool, dbsqlc.RefreshStepRunTimeoutParams{
+		ID:        sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Timeoutat: sqlchelpers.TimestampFromTime(timeoutAt.UTC()),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrStepRunIsNotRunning
+		}
+
+		return nil, err
+	}
+
+	return stepRun, nil
+}
+
+func (s *stepRunRepository) UpdateStepRunProgress(tenantId, stepRunId string, opts *repository.UpdateStepRunProgressOpts) (*dbsqlc.StepRun, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateStepRunProgressParams{
+		ID:       sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Progress: int32(opts.Progress),
+	}
+
+	if opts.Message != nil {
+		params.ProgressMessage = sqlchelpers.TextFromStr(*opts.Message)
+	}
+
+	stepRun, err := s.queries.UpdateStepRunProgress(context.Background(), s.pool, params)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrStepRunIsNotRunning
+		}
+
+		return nil, err
+	}
+
+	return stepRun, nil
+}
+
+func (s *stepRunRepository) AckStepRunAssignment(tenantId, stepRunId, workerId string) (*dbsqlc.StepRun, error) {
+	stepRun, err := s.queries.AckStepRunAssignment(context.Background(), s.pool, dbsqlc.AckStepRunAssignmentParams{
+		ID:       sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Workerid: sqlchelpers.UUIDFromStr(workerId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrStepRunIsNotAssigned
+		}
+
+		return nil, err
+	}
+
+	return stepRun, nil
+}
+
+func (s *stepRunRepository) RequeueUnackedStepRun(tenantId, stepRunId, workerId string, requeueAfter time.Time) (bool, error) {
+	_, err := s.queries.RequeueUnackedStepRun(context.Background(), s.pool, dbsqlc.RequeueUnackedStepRunParams{
+		ID:           sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Workerid:     sqlchelpers.UUIDFromStr(workerId),
+		Requeueafter: sqlchelpers.TimestampFromTime(requeueAfter.UTC()),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (s *stepRunRepository) QueueStepRun(tenantId, stepRunId string, opts *repository.UpdateStepRunOpts) (*db.StepRunModel, error) {
 	if err := s.v.Validate(opts); err != nil {
 		return nil, err
@@ -499,6 +904,17 @@ func getUpdateParams(
 		updateParams.CancelledReason = sqlchelpers.TextFromStr(*opts.CancelledReason)
 	}
 
+	if opts.Attempt != nil {
+		updateParams.AttemptWorkerId = sqlchelpers.UUIDFromStr(opts.Attempt.WorkerId)
+
+		if opts.Attempt.RetryCount != nil {
+			updateParams.AttemptRetryCount = pgtype.Int4{
+				Valid: true,
+				Int32: int32(*opts.Attempt.RetryCount),
+			}
+		}
+	}
+
 	if opts.RetryCount != nil {
 		updateParams.RetryCount = pgtype.Int4{
 			Valid: true,
@@ -506,6 +922,17 @@ func getUpdateParams(
 		}
 	}
 
+	if opts.ShutdownRequeueCount != nil {
+		updateParams.ShutdownRequeueCount = pgtype.Int4{
+			Valid: true,
+			Int32: int32(*opts.ShutdownRequeueCount),
+		}
+	}
+
+	if opts.ConcurrencyKey != nil {
+		updateParams.ConcurrencyKey = sqlchelpers.TextFromStr(*opts.ConcurrencyKey)
+	}
+
 	return updateParams, updateJobRunLookupDataParams, resolveJobRunParams, resolveLaterStepRunsParams, nil
 }
 
@@ -520,6 +947,12 @@ func (s *stepRunRepository) updateStepRun(
 	_, err := s.queries.UpdateStepRun(context.Background(), tx, updateParams)
 
 	if err != nil {
+		// updates which belong to an attempt do not match the step run once it finished, was retried or was
+		// reassigned
+		if updateParams.AttemptWorkerId.Valid && errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrStepRunAttemptIsStale
+		}
+
 		return nil, fmt.Errorf("could not update step run: %w", err)
 	}
 
@@ -0,0 +1,62 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestAckStepRunAssignment(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		// step runs assigned to another worker cannot be acknowledged
+		_, err := repo.StepRun().AckStepRunAssignment(tenantId, stepRunIds[0], uuid.New().String())
+		assert.ErrorIs(t, err, repository.ErrStepRunIsNotAssigned)
+
+		stepRun, err := repo.StepRun().AckStepRunAssignment(tenantId, stepRunIds[0], workerId)
+		require.NoError(t, err)
+		assert.True(t, stepRun.AckedAt.Valid)
+
+		// acknowledged step runs are not requeued
+		requeued, err := repo.StepRun().RequeueUnackedStepRun(tenantId, stepRunIds[0], workerId, time.Now())
+		require.NoError(t, err)
+		assert.False(t, requeued)
+
+		return nil
+	})
+}
+
+func TestRequeueUnackedStepRun(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		requeued, err := repo.StepRun().RequeueUnackedStepRun(tenantId, stepRunIds[0], workerId, time.Now())
+		require.NoError(t, err)
+		assert.True(t, requeued)
+
+		stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, db.StepRunStatusPendingAssignment, stepRun.Status)
+
+		// the worker can no longer acknowledge the reassigned step run
+		_, err = repo.StepRun().AckStepRunAssignment(tenantId, stepRunIds[0], workerId)
+		assert.ErrorIs(t, err, repository.ErrStepRunIsNotAssigned)
+
+		return nil
+	})
+}
@@ -0,0 +1,308 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+const (
+	benchAction          = "bench:step"
+	benchWorkers         = 10
+	benchStepRunsPerOp   = 100
+	benchAssignBatchSize = 100
+)
+
+type assignBenchmark struct {
+	repo            repository.Repository
+	tenantId        string
+	workflowVersion *db.WorkflowVersionModel
+
+	// all workers are created with a recent heartbeat, so this includes them for the whole benchmark
+	heartbeatAfter time.Time
+
+	// (optional) the concurrency key of the created step runs
+	concurrencyKey *string
+}
+
+// BenchmarkAssignStepRuns measures assigning pending step runs to workers in batches, which is what the jobs
+// controller does on every scheduling pass.
+func BenchmarkAssignStepRuns(b *testing.B) {
+	testutils.RunTestWithDatabase(b, func(conf *database.Config) error {
+		b.Run("Batch", func(b *testing.B) {
+			bench := setupAssignBenchmark(b, conf.Repository)
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				bench.createPendingStepRuns(b, benchStepRunsPerOp)
+				b.StartTimer()
+
+				assigned := 0
+
+				for assigned < benchStepRunsPerOp {
+					res, err := bench.repo.StepRun().AssignStepRuns(bench.tenantId, &repository.AssignStepRunsOpts{
+						BatchSize:          benchAssignBatchSize,
+						LastHeartbeatAfter: bench.heartbeatAfter,
+						SelectWorker:       prisma.SelectLeastLoaded,
+					})
+
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					if len(res.Assignments) == 0 {
+						b.Fatalf("expected step runs to be assigned, %d of %d assigned", assigned, benchStepRunsPerOp)
+					}
+
+					assigned += len(res.Assignments)
+				}
+			}
+		})
+
+		return nil
+	})
+}
+
+func setupAssignBenchmark(b testing.TB, repo repository.Repository) *assignBenchmark {
+	b.Helper()
+
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := encryption.GenerateRandomBytes(8)
+
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, err = repo.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "bench-tenant",
+		Slug: fmt.Sprintf("bench-tenant-%s", slugSuffix),
+	})
+
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	dispatcher, err := repo.Dispatcher().CreateNewDispatcher(&repository.CreateDispatcherOpts{
+		ID: uuid.New().String(),
+	})
+
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	now := time.Now().UTC()
+
+	for i := 0; i < benchWorkers; i++ {
+		worker, err := repo.Worker().CreateNewWorker(tenantId, &repository.CreateWorkerOpts{
+			DispatcherId: dispatcher.ID,
+			Name:         fmt.Sprintf("bench-worker-%d", i),
+			Actions:      []string{benchAction},
+		})
+
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		_, err = repo.Worker().UpdateWorker(tenantId, worker.ID, &repository.UpdateWorkerOpts{
+			LastHeartbeatAt: &now,
+		})
+
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	workflowVersion, err := repo.Workflow().CreateNewWorkflow(tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "bench-assign",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     benchAction,
+					},
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return &assignBenchmark{
+		repo:            repo,
+		tenantId:        tenantId,
+		workflowVersion: workflowVersion,
+		heartbeatAfter:  now.Add(-time.Hour),
+	}
+}
+
+// createPendingStepRuns creates workflow runs with a single step run each and moves the step runs to a pending
+// assignment state, which is what the jobs controller does when a step run is queued.
+func (a *assignBenchmark) createPendingStepRuns(b testing.TB, count int) []string {
+	b.Helper()
+
+	stepRunIds := make([]string, 0, count)
+	pendingAssignment := db.StepRunStatusPendingAssignment
+
+	for i := 0; i < count; i++ {
+		opts, err := repository.GetCreateWorkflowRunOptsFromManual(a.workflowVersion, []byte("{}"))
+
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		workflowRun, err := a.repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), a.tenantId, opts)
+
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		stepRuns, err := a.repo.StepRun().ListStepRuns(a.tenantId, &repository.ListStepRunsOpts{
+			WorkflowRunId: &workflowRun.ID,
+		})
+
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for _, stepRun := range stepRuns {
+			_, err = a.repo.StepRun().QueueStepRun(a.tenantId, stepRun.ID, &repository.UpdateStepRunOpts{
+				Status:         &pendingAssignment,
+				ConcurrencyKey: a.concurrencyKey,
+			})
+
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			stepRunIds = append(stepRunIds, stepRun.ID)
+		}
+	}
+
+	return stepRunIds
+}
+
+// TestAssignStepRunsSkipsStepRunsWithoutWorkers checks that older step runs which can't be assigned don't hold back
+// newer step runs.
+func TestAssignStepRunsSkipsStepRunsWithoutWorkers(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		bench := setupAssignBenchmark(t, conf.Repository)
+
+		missingVersion, err := bench.repo.Workflow().CreateNewWorkflow(bench.tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "bench-missing",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "step",
+							Action:     "bench:missing",
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		// no worker runs the action of the oldest step runs
+		missing := *bench
+		missing.workflowVersion = missingVersion
+		missing.createPendingStepRuns(t, 3)
+
+		stepRunIds := bench.createPendingStepRuns(t, 1)
+
+		res, err := bench.repo.StepRun().AssignStepRuns(bench.tenantId, &repository.AssignStepRunsOpts{
+			BatchSize:          3,
+			LastHeartbeatAfter: bench.heartbeatAfter,
+			SelectWorker:       prisma.SelectLeastLoaded,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Assignments, 1)
+		assert.Equal(t, stepRunIds[0], res.Assignments[0].StepRunId)
+
+		return nil
+	})
+}
+
+// TestAssignStepRunsSkipsStepRunsAtConcurrencyLimit checks that step runs whose concurrency key is at the step's
+// default limit of 1 don't fill every batch and hold back newer step runs.
+func TestAssignStepRunsSkipsStepRunsAtConcurrencyLimit(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		bench := setupAssignBenchmark(t, conf.Repository)
+
+		// the step has a key expression without max runs
+		limitedVersion, err := bench.repo.Workflow().CreateNewWorkflow(bench.tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "bench-limited",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "step",
+							Action:     benchAction,
+							Concurrency: &repository.CreateWorkflowStepConcurrencyOpts{
+								Key: "input.customer_id",
+							},
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		// more step runs than fit in a batch share the same key
+		limited := *bench
+		limited.workflowVersion = limitedVersion
+		limited.concurrencyKey = repository.StringPtr("customer-1")
+		limited.createPendingStepRuns(t, benchAssignBatchSize+20)
+
+		stepRunIds := bench.createPendingStepRuns(t, 1)
+
+		assign := func() *repository.AssignStepRunsResult {
+			res, err := bench.repo.StepRun().AssignStepRuns(bench.tenantId, &repository.AssignStepRunsOpts{
+				BatchSize:          benchAssignBatchSize,
+				LastHeartbeatAfter: bench.heartbeatAfter,
+				SelectWorker:       prisma.SelectLeastLoaded,
+			})
+
+			require.NoError(t, err)
+
+			return res
+		}
+
+		// the first pass claims a full batch of the oldest step runs, and only assigns one of them
+		res := assign()
+		assert.Equal(t, benchAssignBatchSize, res.Claimed)
+		require.Len(t, res.Assignments, 1)
+		assert.NotEqual(t, stepRunIds[0], res.Assignments[0].StepRunId)
+
+		// the next pass skips the step runs which are at the key's limit
+		res = assign()
+		require.Len(t, res.Assignments, 1)
+		assert.Equal(t, stepRunIds[0], res.Assignments[0].StepRunId)
+
+		return nil
+	})
+}
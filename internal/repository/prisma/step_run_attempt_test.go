@@ -0,0 +1,116 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func finishStepRunAttempt(repo repository.Repository, tenantId, stepRunId string, attempt *repository.StepRunAttempt) error {
+	finishedAt := time.Now().UTC()
+
+	_, _, err := repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
+		FinishedAt: &finishedAt,
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+		Output:     []byte(`{"ok":true}`),
+		Attempt:    attempt,
+	})
+
+	return err
+}
+
+func TestUpdateStepRunAttemptConcurrentDuplicates(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		retryCount := 0
+		attempt := &repository.StepRunAttempt{
+			WorkerId:   workerId,
+			RetryCount: &retryCount,
+		}
+
+		const duplicates = 5
+
+		errs := make([]error, duplicates)
+		wg := sync.WaitGroup{}
+
+		for i := 0; i < duplicates; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = finishStepRunAttempt(repo, tenantId, stepRunIds[0], attempt)
+			}(i)
+		}
+
+		wg.Wait()
+
+		finished := 0
+
+		for _, err := range errs {
+			if err == nil {
+				finished++
+				continue
+			}
+
+			assert.ErrorIs(t, err, repository.ErrStepRunAttemptIsStale)
+		}
+
+		// exactly one of the duplicates finishes the step run
+		assert.Equal(t, 1, finished)
+
+		stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, db.StepRunStatusSucceeded, stepRun.Status)
+
+		return nil
+	})
+}
+
+func TestUpdateStepRunAttemptRejectsStaleAttempts(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		// a completion from a worker the step run is not assigned to
+		err := finishStepRunAttempt(repo, tenantId, stepRunIds[0], &repository.StepRunAttempt{
+			WorkerId: uuid.New().String(),
+		})
+
+		assert.ErrorIs(t, err, repository.ErrStepRunAttemptIsStale)
+
+		// a completion from another attempt of the step run
+		staleRetryCount := 1
+
+		err = finishStepRunAttempt(repo, tenantId, stepRunIds[0], &repository.StepRunAttempt{
+			WorkerId:   workerId,
+			RetryCount: &staleRetryCount,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrStepRunAttemptIsStale)
+
+		stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, db.StepRunStatusAssigned, stepRun.Status)
+
+		// events sent without an attempt are applied as before
+		err = finishStepRunAttempt(repo, tenantId, stepRunIds[0], nil)
+		require.NoError(t, err)
+
+		return nil
+	})
+}
@@ -0,0 +1,238 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+// createAssignedStepRuns creates count workflow runs with a single step run each, and assigns the step runs to a
+// new worker in the way the jobs controller does.
+func createAssignedStepRuns(t *testing.T, repo repository.Repository, count int) (tenantId, workerId string, stepRunIds []string) {
+	t.Helper()
+
+	tenantId = uuid.New().String()
+
+	slugSuffix, err := encryption.GenerateRandomBytes(8)
+	require.NoError(t, err)
+
+	_, err = repo.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "assigned-tenant",
+		Slug: fmt.Sprintf("assigned-tenant-%s", slugSuffix),
+	})
+
+	require.NoError(t, err)
+
+	dispatcher, err := repo.Dispatcher().CreateNewDispatcher(&repository.CreateDispatcherOpts{
+		ID: uuid.New().String(),
+	})
+
+	require.NoError(t, err)
+
+	worker, err := repo.Worker().CreateNewWorker(tenantId, &repository.CreateWorkerOpts{
+		DispatcherId: dispatcher.ID,
+		Name:         "assigned-worker",
+		Actions:      []string{"assigned:step"},
+	})
+
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+
+	_, err = repo.Worker().UpdateWorker(tenantId, worker.ID, &repository.UpdateWorkerOpts{
+		LastHeartbeatAt: &now,
+	})
+
+	require.NoError(t, err)
+
+	retries := 1
+
+	workflowVersion, err := repo.Workflow().CreateNewWorkflow(tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "assigned-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     "assigned:step",
+						Retries:    &retries,
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	for i := 0; i < count; i++ {
+		opts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte("{}"))
+		require.NoError(t, err)
+
+		workflowRun, err := repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, opts)
+		require.NoError(t, err)
+
+		stepRuns, err := repo.StepRun().ListStepRuns(tenantId, &repository.ListStepRunsOpts{
+			WorkflowRunId: &workflowRun.ID,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, stepRuns, 1)
+
+		_, err = repo.StepRun().QueueStepRun(tenantId, stepRuns[0].ID, &repository.UpdateStepRunOpts{
+			Status: repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment),
+		})
+
+		require.NoError(t, err)
+
+		stepRunIds = append(stepRunIds, stepRuns[0].ID)
+	}
+
+	res, err := repo.StepRun().AssignStepRuns(tenantId, &repository.AssignStepRunsOpts{
+		BatchSize:          count,
+		LastHeartbeatAfter: now.Add(-time.Minute),
+		SelectWorker:       prisma.SelectLeastLoaded,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, res.Assignments, count)
+
+	return tenantId, worker.ID, stepRunIds
+}
+
+func TestListLostStepRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 3)
+
+		// step runs which the worker reports are not lost
+		lost, err := repo.StepRun().ListLostStepRuns(tenantId, &repository.ListLostStepRunsOpts{
+			WorkerId:           workerId,
+			InFlightStepRunIds: stepRunIds[:1],
+			UpdatedBefore:      time.Now().Add(time.Minute),
+		})
+
+		require.NoError(t, err)
+
+		lostIds := make([]string, len(lost))
+
+		for i, stepRun := range lost {
+			lostIds[i] = sqlchelpers.UUIDToStr(stepRun.ID)
+		}
+
+		assert.ElementsMatch(t, stepRunIds[1:], lostIds)
+
+		// step runs which were updated within the grace period are not lost
+		lost, err = repo.StepRun().ListLostStepRuns(tenantId, &repository.ListLostStepRunsOpts{
+			WorkerId:      workerId,
+			UpdatedBefore: time.Now().Add(-time.Minute),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, lost)
+
+		return nil
+	})
+}
+
+// TestDeleteWorkerRequeuesStepRuns checks that the step runs of a worker which shuts down mid-run and then
+// unregisters are requeued, even though the failures which the worker sent on shutdown are processed after the
+// worker was deleted.
+func TestDeleteWorkerRequeuesStepRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 3)
+
+		attempt := &repository.StepRunAttempt{
+			WorkerId: workerId,
+		}
+
+		now := time.Now().UTC()
+		maxShutdownRequeues := defaults.MaxShutdownRequeues
+
+		// the first two step runs are running, and the second one was already requeued on shutdown too many times
+		for i, stepRunId := range stepRunIds[:2] {
+			opts := &repository.UpdateStepRunOpts{
+				Status:    repository.StepRunStatusPtr(db.StepRunStatusRunning),
+				StartedAt: &now,
+				Attempt:   attempt,
+			}
+
+			if i == 1 {
+				opts.ShutdownRequeueCount = &maxShutdownRequeues
+			}
+
+			_, _, err := repo.StepRun().UpdateStepRun(tenantId, stepRunId, opts)
+			require.NoError(t, err)
+		}
+
+		draining := db.WorkerSchedulingStatusDraining
+
+		_, err := repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+			SchedulingStatus: &draining,
+		})
+
+		require.NoError(t, err)
+
+		err = repo.Worker().DeleteWorker(tenantId, workerId)
+		require.NoError(t, err)
+
+		// the failure which the worker sent when it cancelled the first step run on shutdown is dropped, because
+		// the step run was already requeued
+		shutdownRequeueCount := 1
+
+		_, _, err = repo.StepRun().UpdateStepRun(tenantId, stepRunIds[0], &repository.UpdateStepRunOpts{
+			Status:               repository.StepRunStatusPtr(db.StepRunStatusPending),
+			Attempt:              attempt,
+			ShutdownRequeueCount: &shutdownRequeueCount,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrStepRunAttemptIsStale)
+
+		for i, expected := range []struct {
+			status               db.StepRunStatus
+			shutdownRequeueCount int
+		}{
+			{db.StepRunStatusPendingAssignment, 1},
+			{db.StepRunStatusRunning, maxShutdownRequeues},
+			{db.StepRunStatusPendingAssignment, 0},
+		} {
+			stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[i])
+			require.NoError(t, err)
+
+			_, hasWorker := stepRun.WorkerID()
+
+			assert.False(t, hasWorker)
+			assert.Equal(t, expected.status, stepRun.Status)
+			assert.Equal(t, expected.shutdownRequeueCount, stepRun.ShutdownRequeueCount)
+		}
+
+		// the step run which was left running without a worker is lost, so it is reassigned
+		reassign, err := repo.StepRun().ListStepRunsToReassign(tenantId)
+		require.NoError(t, err)
+
+		require.Len(t, reassign, 1)
+		assert.Equal(t, stepRunIds[1], sqlchelpers.UUIDToStr(reassign[0].ID))
+
+		return nil
+	})
+}
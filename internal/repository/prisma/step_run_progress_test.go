@@ -0,0 +1,82 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestRefreshStepRunTimeout(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, _, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		timeoutAt := time.Now().UTC().Add(time.Hour)
+
+		stepRun, err := repo.StepRun().RefreshStepRunTimeout(tenantId, stepRunIds[0], timeoutAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, timeoutAt, stepRun.TimeoutAt.Time, time.Millisecond)
+
+		// the timeout of a finished step run cannot be refreshed
+		_, _, err = repo.StepRun().UpdateStepRun(tenantId, stepRunIds[0], &repository.UpdateStepRunOpts{
+			Status: repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+		})
+
+		require.NoError(t, err)
+
+		_, err = repo.StepRun().RefreshStepRunTimeout(tenantId, stepRunIds[0], timeoutAt)
+		assert.ErrorIs(t, err, repository.ErrStepRunIsNotRunning)
+
+		return nil
+	})
+}
+
+func TestUpdateStepRunProgress(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, _, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		message := "halfway there"
+
+		stepRun, err := repo.StepRun().UpdateStepRunProgress(tenantId, stepRunIds[0], &repository.UpdateStepRunProgressOpts{
+			Progress: 50,
+			Message:  &message,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(50), stepRun.Progress.Int32)
+		assert.Equal(t, message, stepRun.ProgressMessage.String)
+
+		_, err = repo.StepRun().UpdateStepRunProgress(tenantId, stepRunIds[0], &repository.UpdateStepRunProgressOpts{
+			Progress: 101,
+		})
+
+		assert.Error(t, err, "progress above 100 is invalid")
+
+		// progress is not recorded once the step run has finished
+		_, _, err = repo.StepRun().UpdateStepRun(tenantId, stepRunIds[0], &repository.UpdateStepRunOpts{
+			Status: repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+		})
+
+		require.NoError(t, err)
+
+		_, err = repo.StepRun().UpdateStepRunProgress(tenantId, stepRunIds[0], &repository.UpdateStepRunProgressOpts{
+			Progress: 100,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrStepRunIsNotRunning)
+
+		return nil
+	})
+}
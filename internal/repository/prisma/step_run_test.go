@@ -0,0 +1,182 @@
+package prisma
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+)
+
+func newPlanStepRun(actionId string, concurrencyKey string, maxRuns int32) *dbsqlc.ListStepRunsToAssignRow {
+	stepRun := &dbsqlc.ListStepRunsToAssignRow{
+		ID:                      sqlchelpers.UUIDFromStr(uuid.New().String()),
+		ActionId:                actionId,
+		WorkerSelectionStrategy: dbsqlc.WorkerSelectionStrategyLEASTLOADED,
+	}
+
+	if concurrencyKey != "" {
+		stepRun.ConcurrencyKey = pgtype.Text{String: concurrencyKey, Valid: true}
+		stepRun.ConcurrencyMaxRuns = pgtype.Int4{Int32: maxRuns, Valid: true}
+	}
+
+	return stepRun
+}
+
+func newPlanWorker(maxRuns int32, active int64, actions ...string) *dbsqlc.ListWorkersToAssignRow {
+	worker := &dbsqlc.ListWorkersToAssignRow{
+		ID:             sqlchelpers.UUIDFromStr(uuid.New().String()),
+		ActiveStepRuns: active,
+		Actions:        actions,
+	}
+
+	if maxRuns > 0 {
+		worker.MaxRuns = pgtype.Int4{Int32: maxRuns, Valid: true}
+	}
+
+	return worker
+}
+
+// selectLeastLoaded only supports the least loaded strategy, and picks the worker with the fewest active step runs.
+func selectLeastLoaded(strategy dbsqlc.WorkerSelectionStrategy, candidates []*repository.WorkerCandidate) (int, bool) {
+	if strategy != dbsqlc.WorkerSelectionStrategyLEASTLOADED {
+		return 0, false
+	}
+
+	selected := 0
+
+	for i, candidate := range candidates {
+		if candidate.ActiveStepRuns < candidates[selected].ActiveStepRuns {
+			selected = i
+		}
+	}
+
+	return selected, true
+}
+
+func countAssignments(workerIds []pgtype.UUID) map[string]int {
+	res := map[string]int{}
+
+	for _, workerId := range workerIds {
+		res[sqlchelpers.UUIDToStr(workerId)]++
+	}
+
+	return res
+}
+
+func TestPlanStepRunAssignmentsBalancesWorkers(t *testing.T) {
+	busy := newPlanWorker(0, 3, "default:step")
+	idle := newPlanWorker(0, 0, "default:step")
+
+	stepRuns := []*dbsqlc.ListStepRunsToAssignRow{}
+
+	for i := 0; i < 5; i++ {
+		stepRuns = append(stepRuns, newPlanStepRun("default:step", "", 0))
+	}
+
+	stepRunIds, workerIds := planStepRunAssignments("tenant", stepRuns, []*dbsqlc.ListWorkersToAssignRow{busy, idle}, nil, map[string]int64{}, selectLeastLoaded)
+
+	assert.Len(t, stepRunIds, 5)
+
+	counts := countAssignments(workerIds)
+
+	// the idle worker catches up with the busy worker before they alternate
+	assert.Equal(t, 1, counts[sqlchelpers.UUIDToStr(busy.ID)])
+	assert.Equal(t, 4, counts[sqlchelpers.UUIDToStr(idle.ID)])
+}
+
+func TestPlanStepRunAssignmentsRespectsSlotsAndActions(t *testing.T) {
+	full := newPlanWorker(2, 2, "default:step")
+	oneSlot := newPlanWorker(2, 1, "default:step")
+	other := newPlanWorker(0, 0, "default:other")
+
+	stepRuns := []*dbsqlc.ListStepRunsToAssignRow{
+		newPlanStepRun("default:step", "", 0),
+		newPlanStepRun("default:step", "", 0),
+		newPlanStepRun("default:other", "", 0),
+		newPlanStepRun("default:missing", "", 0),
+	}
+
+	stepRunIds, workerIds := planStepRunAssignments("tenant", stepRuns, []*dbsqlc.ListWorkersToAssignRow{full, oneSlot, other}, nil, map[string]int64{}, selectLeastLoaded)
+
+	assert.Equal(t, []pgtype.UUID{stepRuns[0].ID, stepRuns[2].ID}, stepRunIds)
+	assert.Equal(t, []pgtype.UUID{oneSlot.ID, other.ID}, workerIds)
+}
+
+func TestPlanStepRunAssignmentsRespectsActionSlots(t *testing.T) {
+	worker := newPlanWorker(10, 0, "default:send-email", "default:render-video")
+
+	stepRuns := []*dbsqlc.ListStepRunsToAssignRow{
+		newPlanStepRun("default:render-video", "", 0),
+		newPlanStepRun("default:render-video", "", 0),
+		newPlanStepRun("default:send-email", "", 0),
+		newPlanStepRun("default:send-email", "", 0),
+		newPlanStepRun("default:send-email", "", 0),
+	}
+
+	// the worker runs one render at a time, and two emails of which one is already running
+	actionSlots := []*dbsqlc.ListWorkerActionSlotsRow{
+		{WorkerId: worker.ID, ActionId: "default:render-video", MaxRuns: 1},
+		{WorkerId: worker.ID, ActionId: "default:send-email", MaxRuns: 2, ActiveStepRuns: 1},
+	}
+
+	stepRunIds, _ := planStepRunAssignments("tenant", stepRuns, []*dbsqlc.ListWorkersToAssignRow{worker}, actionSlots, map[string]int64{}, selectLeastLoaded)
+
+	assert.Equal(t, []pgtype.UUID{stepRuns[0].ID, stepRuns[2].ID}, stepRunIds)
+}
+
+func TestPlanStepRunAssignmentsRespectsConcurrencyLimits(t *testing.T) {
+	worker := newPlanWorker(0, 0, "default:step")
+
+	stepRuns := []*dbsqlc.ListStepRunsToAssignRow{
+		newPlanStepRun("default:step", "customer-1", 2),
+		newPlanStepRun("default:step", "customer-1", 2),
+		newPlanStepRun("default:step", "customer-2", 1),
+		newPlanStepRun("default:step", "customer-2", 1),
+	}
+
+	// one step run for customer-1 is already running
+	active := map[string]int64{
+		stepRunConcurrencyLockKey("tenant", "default:step", "customer-1"): 1,
+	}
+
+	stepRunIds, _ := planStepRunAssignments("tenant", stepRuns, []*dbsqlc.ListWorkersToAssignRow{worker}, nil, active, selectLeastLoaded)
+
+	assert.Equal(t, []pgtype.UUID{stepRuns[0].ID, stepRuns[2].ID}, stepRunIds)
+}
+
+func TestPlanStepRunAssignmentsUsesStepRunStrategy(t *testing.T) {
+	workers := []*dbsqlc.ListWorkersToAssignRow{
+		newPlanWorker(0, 0, "default:step"),
+		newPlanWorker(0, 0, "default:step"),
+	}
+
+	lastWorker := workers[0]
+
+	for _, worker := range workers {
+		if sqlchelpers.UUIDToStr(worker.ID) > sqlchelpers.UUIDToStr(lastWorker.ID) {
+			lastWorker = worker
+		}
+	}
+
+	roundRobin := newPlanStepRun("default:step", "", 0)
+	roundRobin.WorkerSelectionStrategy = dbsqlc.WorkerSelectionStrategyROUNDROBIN
+
+	random := newPlanStepRun("default:step", "", 0)
+	random.WorkerSelectionStrategy = dbsqlc.WorkerSelectionStrategyRANDOM
+
+	// only round robin is supported, and picks the last candidate
+	selectLast := func(strategy dbsqlc.WorkerSelectionStrategy, candidates []*repository.WorkerCandidate) (int, bool) {
+		return len(candidates) - 1, strategy == dbsqlc.WorkerSelectionStrategyROUNDROBIN
+	}
+
+	stepRunIds, workerIds := planStepRunAssignments("tenant", []*dbsqlc.ListStepRunsToAssignRow{roundRobin, random}, workers, nil, map[string]int64{}, selectLast)
+
+	// candidates are ordered by worker id, and step runs with a strategy without a selector are not assigned
+	assert.Equal(t, []pgtype.UUID{roundRobin.ID}, stepRunIds)
+	assert.Equal(t, []pgtype.UUID{lastWorker.ID}, workerIds)
+}
@@ -48,6 +48,24 @@ func (r *tenantRepository) GetTenantBySlug(slug string) (*db.TenantModel, error)
 	).Exec(context.Background())
 }
 
+func (r *tenantRepository) UpdateTenant(tenantId string, opts *repository.UpdateTenantOpts) (*db.TenantModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := []db.TenantSetParam{}
+
+	if opts.WorkerSelectionStrategy != nil {
+		params = append(params, db.Tenant.WorkerSelectionStrategy.Set(db.WorkerSelectionStrategy(*opts.WorkerSelectionStrategy)))
+	}
+
+	return r.client.Tenant.FindUnique(
+		db.Tenant.ID.Equals(tenantId),
+	).Update(
+		params...,
+	).Exec(context.Background())
+}
+
 func (r *tenantRepository) CreateTenantMember(tenantId string, opts *repository.CreateTenantMemberOpts) (*db.TenantMemberModel, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
@@ -2,8 +2,10 @@ package prisma
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
@@ -82,44 +84,15 @@ func (t *tickerRepository) Delete(tickerId string) error {
 	return err
 }
 
-func (t *tickerRepository) AddJobRun(tickerId string, jobRun *db.JobRunModel) (*db.TickerModel, error) {
-	return t.client.Ticker.FindUnique(
-		db.Ticker.ID.Equals(tickerId),
-	).Update(
-		db.Ticker.JobRuns.Link(
-			db.JobRun.ID.Equals(jobRun.ID),
-		),
-	).Exec(context.Background())
-}
-
-func (t *tickerRepository) AddStepRun(tickerId, stepRunId string) (*db.TickerModel, error) {
-	return t.client.Ticker.FindUnique(
-		db.Ticker.ID.Equals(tickerId),
-	).Update(
-		db.Ticker.StepRuns.Link(
-			db.StepRun.ID.Equals(stepRunId),
-		),
-	).Exec(context.Background())
-}
-
-func (t *tickerRepository) AddGetGroupKeyRun(tickerId, getGroupKeyRunId string) (*db.TickerModel, error) {
-	return t.client.Ticker.FindUnique(
-		db.Ticker.ID.Equals(tickerId),
-	).Update(
-		db.Ticker.GroupKeyRuns.Link(
-			db.GetGroupKeyRun.ID.Equals(getGroupKeyRunId),
-		),
-	).Exec(context.Background())
-}
-
 func (t *tickerRepository) AddCron(tickerId string, cron *db.WorkflowTriggerCronRefModel) (*db.TickerModel, error) {
 	return t.client.Ticker.FindUnique(
 		db.Ticker.ID.Equals(tickerId),
 	).Update(
 		db.Ticker.Crons.Link(
-			db.WorkflowTriggerCronRef.ParentIDCron(
+			db.WorkflowTriggerCronRef.ParentIDCronTimezone(
 				db.WorkflowTriggerCronRef.ParentID.Equals(cron.ParentID),
 				db.WorkflowTriggerCronRef.Cron.Equals(cron.Cron),
+				db.WorkflowTriggerCronRef.Timezone.Equals(cron.Timezone),
 			),
 		),
 	).Exec(context.Background())
@@ -130,34 +103,15 @@ func (t *tickerRepository) RemoveCron(tickerId string, cron *db.WorkflowTriggerC
 		db.Ticker.ID.Equals(tickerId),
 	).Update(
 		db.Ticker.Crons.Unlink(
-			db.WorkflowTriggerCronRef.ParentIDCron(
+			db.WorkflowTriggerCronRef.ParentIDCronTimezone(
 				db.WorkflowTriggerCronRef.ParentID.Equals(cron.ParentID),
 				db.WorkflowTriggerCronRef.Cron.Equals(cron.Cron),
+				db.WorkflowTriggerCronRef.Timezone.Equals(cron.Timezone),
 			),
 		),
 	).Exec(context.Background())
 }
 
-func (t *tickerRepository) AddScheduledWorkflow(tickerId string, schedule *db.WorkflowTriggerScheduledRefModel) (*db.TickerModel, error) {
-	return t.client.Ticker.FindUnique(
-		db.Ticker.ID.Equals(tickerId),
-	).Update(
-		db.Ticker.Scheduled.Link(
-			db.WorkflowTriggerScheduledRef.ID.Equals(schedule.ID),
-		),
-	).Exec(context.Background())
-}
-
-func (t *tickerRepository) RemoveScheduledWorkflow(tickerId string, schedule *db.WorkflowTriggerScheduledRefModel) (*db.TickerModel, error) {
-	return t.client.Ticker.FindUnique(
-		db.Ticker.ID.Equals(tickerId),
-	).Update(
-		db.Ticker.Scheduled.Unlink(
-			db.WorkflowTriggerScheduledRef.ID.Equals(schedule.ID),
-		),
-	).Exec(context.Background())
-}
-
 func (t *tickerRepository) GetTickerById(tickerId string) (*db.TickerModel, error) {
 	return t.client.Ticker.FindUnique(
 		db.Ticker.ID.Equals(tickerId),
@@ -169,14 +123,159 @@ func (t *tickerRepository) GetTickerById(tickerId string) (*db.TickerModel, erro
 				),
 			),
 		),
-		db.Ticker.Scheduled.Fetch().With(
-			db.WorkflowTriggerScheduledRef.Parent.Fetch().With(
-				db.WorkflowVersion.Workflow.Fetch(),
-			),
-		),
 	).Exec(context.Background())
 }
 
+func (t *tickerRepository) PollStepRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*repository.StepRunTimeout) error) (int, error) {
+	claimed := 0
+
+	err := t.pollInTx(ctx, func(tx pgx.Tx) error {
+		rows, err := t.queries.PollStepRunTimeouts(ctx, tx, int32(batchSize))
+
+		if err != nil {
+			return fmt.Errorf("could not poll step run timeouts: %w", err)
+		}
+
+		claimed = len(rows)
+		timeouts := make([]*repository.StepRunTimeout, 0, len(rows))
+
+		for _, row := range rows {
+			timeouts = append(timeouts, &repository.StepRunTimeout{
+				TenantId:  sqlchelpers.UUIDToStr(row.TenantId),
+				JobRunId:  sqlchelpers.UUIDToStr(row.JobRunId),
+				StepRunId: sqlchelpers.UUIDToStr(row.ID),
+			})
+		}
+
+		if len(timeouts) == 0 {
+			return nil
+		}
+
+		return onTimeout(timeouts)
+	})
+
+	return claimed, err
+}
+
+func (t *tickerRepository) PollJobRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*repository.JobRunTimeout) error) (int, error) {
+	claimed := 0
+
+	err := t.pollInTx(ctx, func(tx pgx.Tx) error {
+		rows, err := t.queries.PollJobRunTimeouts(ctx, tx, int32(batchSize))
+
+		if err != nil {
+			return fmt.Errorf("could not poll job run timeouts: %w", err)
+		}
+
+		claimed = len(rows)
+		timeouts := make([]*repository.JobRunTimeout, 0, len(rows))
+
+		for _, row := range rows {
+			if row.Status != dbsqlc.JobRunStatusPENDING && row.Status != dbsqlc.JobRunStatusRUNNING {
+				continue
+			}
+
+			timeouts = append(timeouts, &repository.JobRunTimeout{
+				TenantId: sqlchelpers.UUIDToStr(row.TenantId),
+				JobRunId: sqlchelpers.UUIDToStr(row.ID),
+			})
+		}
+
+		if len(timeouts) == 0 {
+			return nil
+		}
+
+		return onTimeout(timeouts)
+	})
+
+	return claimed, err
+}
+
+func (t *tickerRepository) PollGetGroupKeyRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*repository.GetGroupKeyRunTimeout) error) (int, error) {
+	claimed := 0
+
+	err := t.pollInTx(ctx, func(tx pgx.Tx) error {
+		rows, err := t.queries.PollGetGroupKeyRunTimeouts(ctx, tx, int32(batchSize))
+
+		if err != nil {
+			return fmt.Errorf("could not poll get group key run timeouts: %w", err)
+		}
+
+		claimed = len(rows)
+		timeouts := make([]*repository.GetGroupKeyRunTimeout, 0, len(rows))
+
+		for _, row := range rows {
+			if row.Status != dbsqlc.StepRunStatusASSIGNED && row.Status != dbsqlc.StepRunStatusRUNNING {
+				continue
+			}
+
+			timeouts = append(timeouts, &repository.GetGroupKeyRunTimeout{
+				TenantId:         sqlchelpers.UUIDToStr(row.TenantId),
+				WorkflowRunId:    sqlchelpers.UUIDToStr(row.WorkflowRunId),
+				GetGroupKeyRunId: sqlchelpers.UUIDToStr(row.ID),
+			})
+		}
+
+		if len(timeouts) == 0 {
+			return nil
+		}
+
+		return onTimeout(timeouts)
+	})
+
+	return claimed, err
+}
+
+func (t *tickerRepository) PollScheduledWorkflows(ctx context.Context, batchSize int, onDue func(schedules []*repository.DueScheduledWorkflow) error) (int, error) {
+	claimed := 0
+
+	err := t.pollInTx(ctx, func(tx pgx.Tx) error {
+		rows, err := t.queries.PollScheduledWorkflows(ctx, tx, int32(batchSize))
+
+		if err != nil {
+			return fmt.Errorf("could not poll scheduled workflows: %w", err)
+		}
+
+		claimed = len(rows)
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		schedules := make([]*repository.DueScheduledWorkflow, 0, len(rows))
+
+		for _, row := range rows {
+			schedules = append(schedules, &repository.DueScheduledWorkflow{
+				TenantId:            sqlchelpers.UUIDToStr(row.TenantId),
+				WorkflowVersionId:   sqlchelpers.UUIDToStr(row.ParentId),
+				ScheduledWorkflowId: sqlchelpers.UUIDToStr(row.ID),
+			})
+		}
+
+		return onDue(schedules)
+	})
+
+	return claimed, err
+}
+
+// pollInTx runs poll in a transaction which is only committed if poll succeeds. The rows which poll claims stay
+// locked until then, and are released without changes if the ticker dies before the commit.
+func (t *tickerRepository) pollInTx(ctx context.Context, poll func(tx pgx.Tx) error) error {
+	tx, err := t.pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer deferRollback(context.Background(), t.l, tx.Rollback)
+
+	if err := poll(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 func (t *tickerRepository) UpdateStaleTickers(onStale func(tickerId string, getValidTickerId func() string) error) error {
 	tx, err := t.pool.Begin(context.Background())
 
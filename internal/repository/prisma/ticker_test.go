@@ -0,0 +1,237 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/encryption"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func createTickerTestWorkflow(t *testing.T, repo repository.Repository) (string, *db.WorkflowVersionModel) {
+	t.Helper()
+
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := encryption.GenerateRandomBytes(8)
+	require.NoError(t, err)
+
+	_, err = repo.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "ticker-tenant",
+		Slug: fmt.Sprintf("ticker-tenant-%s", slugSuffix),
+	})
+
+	require.NoError(t, err)
+
+	workflowVersion, err := repo.Workflow().CreateNewWorkflow(tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "ticker-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     "ticker:step",
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	return tenantId, workflowVersion
+}
+
+// pollTenantJobRunTimeouts polls job run timeouts and returns the ones of the tenant, as the database may contain
+// due timeouts of other tenants.
+func pollTenantJobRunTimeouts(t *testing.T, repo repository.Repository, tenantId string) []*repository.JobRunTimeout {
+	t.Helper()
+
+	res := []*repository.JobRunTimeout{}
+
+	_, err := repo.Ticker().PollJobRunTimeouts(context.Background(), 100, func(timeouts []*repository.JobRunTimeout) error {
+		for _, timeout := range timeouts {
+			if timeout.TenantId == tenantId {
+				res = append(res, timeout)
+			}
+		}
+
+		return nil
+	})
+
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestJobRunTimeoutSurvivesTickerCrash(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, workflowVersion := createTickerTestWorkflow(t, repo)
+
+		opts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte("{}"))
+		require.NoError(t, err)
+
+		workflowRun, err := repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, opts)
+		require.NoError(t, err)
+
+		jobRunId := workflowRun.JobRuns()[0].ID
+		timeoutAt := time.Now().UTC().Add(-time.Second)
+
+		_, err = repo.JobRun().UpdateJobRun(tenantId, jobRunId, &repository.UpdateJobRunOpts{
+			TimeoutAt: &timeoutAt,
+		})
+
+		require.NoError(t, err)
+
+		// the first ticker claims the timeout and dies before it has handled it
+		ctx, kill := context.WithCancel(context.Background())
+		claimed := make(chan struct{})
+		crashed := make(chan error, 1)
+
+		go func() {
+			_, err := repo.Ticker().PollJobRunTimeouts(ctx, 100, func(timeouts []*repository.JobRunTimeout) error {
+				close(claimed)
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+			crashed <- err
+		}()
+
+		select {
+		case <-claimed:
+		case <-time.After(10 * time.Second):
+			t.Fatal("the first ticker did not claim the timeout")
+		}
+
+		// the timeout is locked while the first ticker handles it
+		assert.Empty(t, pollTenantJobRunTimeouts(t, repo, tenantId))
+
+		kill()
+		assert.Error(t, <-crashed)
+
+		// the timeout was not cleared, so another ticker picks it up
+		timeouts := pollTenantJobRunTimeouts(t, repo, tenantId)
+
+		if assert.Len(t, timeouts, 1) {
+			assert.Equal(t, jobRunId, timeouts[0].JobRunId)
+		}
+
+		// the timeout is cleared once it has been handled
+		assert.Empty(t, pollTenantJobRunTimeouts(t, repo, tenantId))
+
+		return nil
+	})
+}
+
+func TestScheduledWorkflowRunsAfterTickerDowntime(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, workflowVersion := createTickerTestWorkflow(t, repo)
+
+		// the schedule becomes due while no ticker is running
+		_, err := repo.Workflow().CreateSchedules(tenantId, workflowVersion.ID, &repository.CreateWorkflowSchedulesOpts{
+			ScheduledTriggers: []time.Time{workflowVersion.CreatedAt},
+		})
+
+		require.NoError(t, err)
+
+		poll := func() []*repository.DueScheduledWorkflow {
+			res := []*repository.DueScheduledWorkflow{}
+
+			_, err := repo.Ticker().PollScheduledWorkflows(context.Background(), 100, func(schedules []*repository.DueScheduledWorkflow) error {
+				for _, schedule := range schedules {
+					if schedule.TenantId != tenantId {
+						continue
+					}
+
+					res = append(res, schedule)
+
+					scheduledTrigger, err := repo.Workflow().GetScheduledById(tenantId, schedule.ScheduledWorkflowId)
+
+					if err != nil {
+						return err
+					}
+
+					createOpts, err := repository.GetCreateWorkflowRunOptsFromSchedule(scheduledTrigger, workflowVersion)
+
+					if err != nil {
+						return err
+					}
+
+					_, err = repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, createOpts)
+
+					if err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+
+			require.NoError(t, err)
+
+			return res
+		}
+
+		schedules := poll()
+
+		if assert.Len(t, schedules, 1) {
+			assert.Equal(t, workflowVersion.ID, schedules[0].WorkflowVersionId)
+		}
+
+		// the scheduled workflow is not due anymore once its workflow run exists
+		assert.Empty(t, poll())
+
+		return nil
+	})
+}
+
+func TestStepRunTimeoutClearedOnFinish(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		// the timeout is stored with the assignment
+		stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+
+		_, ok := stepRun.TimeoutAt()
+		require.True(t, ok)
+
+		finishedAt := time.Now().UTC()
+
+		_, _, err = repo.StepRun().UpdateStepRun(tenantId, stepRunIds[0], &repository.UpdateStepRunOpts{
+			Status:     repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
+			FinishedAt: &finishedAt,
+			Attempt: &repository.StepRunAttempt{
+				WorkerId: workerId,
+			},
+		})
+
+		require.NoError(t, err)
+
+		stepRun, err = repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+
+		_, ok = stepRun.TimeoutAt()
+		assert.False(t, ok)
+
+		return nil
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
 	"github.com/hatchet-dev/hatchet/internal/validator"
 )
 
@@ -47,6 +48,19 @@ func (w *workerRepository) GetWorkerById(workerId string) (*db.WorkerModel, erro
 	).Exec(context.Background())
 }
 
+func (w *workerRepository) CountActiveStepRuns(tenantId, workerId string) (int, error) {
+	count, err := w.queries.CountActiveWorkerStepRuns(context.Background(), w.pool, dbsqlc.CountActiveWorkerStepRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Workerid: sqlchelpers.UUIDFromStr(workerId),
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
 func (w *workerRepository) ListRecentWorkerStepRuns(tenantId, workerId string) ([]db.StepRunModel, error) {
 	return w.client.StepRun.FindMany(
 		db.StepRun.WorkerID.Equals(workerId),
@@ -197,6 +211,16 @@ func (w *workerRepository) CreateNewWorker(tenantId string, opts *repository.Cre
 		}
 	}
 
+	for actionId, maxRuns := range opts.ActionSlots {
+		txs = append(txs, w.client.WorkerActionSlot.CreateOne(
+			db.WorkerActionSlot.Worker.Link(
+				db.Worker.ID.Equals(workerId),
+			),
+			db.WorkerActionSlot.ActionID.Set(actionId),
+			db.WorkerActionSlot.MaxRuns.Set(maxRuns),
+		).Tx())
+	}
+
 	err := w.client.Prisma.Transaction(txs...).Exec(context.Background())
 
 	if err != nil {
@@ -219,6 +243,10 @@ func (w *workerRepository) UpdateWorker(tenantId, workerId string, opts *reposit
 		optionals = append(optionals, db.Worker.Status.Set(*opts.Status))
 	}
 
+	if opts.SchedulingStatus != nil {
+		optionals = append(optionals, db.Worker.SchedulingStatus.Set(*opts.SchedulingStatus))
+	}
+
 	if opts.LastHeartbeatAt != nil {
 		optionals = append(optionals, db.Worker.LastHeartbeatAt.Set(*opts.LastHeartbeatAt))
 	}
@@ -277,31 +305,43 @@ func (w *workerRepository) UpdateWorker(tenantId, workerId string, opts *reposit
 }
 
 func (w *workerRepository) DeleteWorker(tenantId, workerId string) error {
-	_, err := w.client.Worker.FindUnique(
-		db.Worker.ID.Equals(workerId),
-	).Delete().Exec(context.Background())
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkerId := sqlchelpers.UUIDFromStr(workerId)
 
-	return err
-}
+	tx, err := w.pool.Begin(context.Background())
 
-func (w *workerRepository) AddStepRun(tenantId, workerId, stepRunId string) error {
-	tx1 := w.client.Worker.FindUnique(
-		db.Worker.ID.Equals(workerId),
-	).Update(
-		db.Worker.StepRuns.Link(
-			db.StepRun.ID.Equals(stepRunId),
-		),
-	).Tx()
+	if err != nil {
+		return err
+	}
 
-	tx2 := w.client.StepRun.FindUnique(
-		db.StepRun.ID.Equals(stepRunId),
-	).Update(
-		db.StepRun.Status.Set(db.StepRunStatusAssigned),
-	).Tx()
+	defer deferRollback(context.Background(), w.l, tx.Rollback)
 
-	err := w.client.Prisma.Transaction(tx1, tx2).Exec(context.Background())
+	// the step runs are requeued before the worker is deleted, because deleting the worker unsets their worker and
+	// updates from the worker's attempts are dropped afterwards
+	_, err = w.queries.RequeueWorkerStepRuns(context.Background(), tx, dbsqlc.RequeueWorkerStepRunsParams{
+		Tenantid:            pgTenantId,
+		Workerid:            pgWorkerId,
+		Maxshutdownrequeues: defaults.MaxShutdownRequeues,
+	})
 
-	return err
+	if err != nil {
+		return fmt.Errorf("could not requeue worker step runs: %w", err)
+	}
+
+	_, err = w.queries.DeleteWorker(context.Background(), tx, dbsqlc.DeleteWorkerParams{
+		Workerid: pgWorkerId,
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.ErrNotFound
+		}
+
+		return fmt.Errorf("could not delete worker: %w", err)
+	}
+
+	return tx.Commit(context.Background())
 }
 
 func (w *workerRepository) AddGetGroupKeyRun(tenantId, workerId, getGroupKeyRunId string) error {
@@ -0,0 +1,65 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestWorkerSchedulingStatus(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, workerId, _ := createAssignedStepRuns(t, repo, 2)
+
+		activeStepRuns, err := repo.Worker().CountActiveStepRuns(tenantId, workerId)
+		require.NoError(t, err)
+		assert.Equal(t, 2, activeStepRuns)
+
+		heartbeatAfter := time.Now().UTC().Add(-time.Minute)
+
+		listAssignable := func() int {
+			workers, err := repo.Worker().ListWorkers(tenantId, &repository.ListWorkersOpts{
+				LastHeartbeatAfter: &heartbeatAfter,
+				Assignable:         repository.BoolPtr(true),
+			})
+
+			require.NoError(t, err)
+
+			return len(workers)
+		}
+
+		assert.Equal(t, 1, listAssignable())
+
+		// paused and draining workers are not assigned new step runs
+		for _, status := range []db.WorkerSchedulingStatus{db.WorkerSchedulingStatusPaused, db.WorkerSchedulingStatusDraining} {
+			worker, err := repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+				SchedulingStatus: &status,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, status, worker.SchedulingStatus)
+			assert.Equal(t, 0, listAssignable(), "worker should not be assignable while %s", status)
+		}
+
+		active := db.WorkerSchedulingStatusActive
+
+		_, err = repo.Worker().UpdateWorker(tenantId, workerId, &repository.UpdateWorkerOpts{
+			SchedulingStatus: &active,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, listAssignable())
+
+		return nil
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -118,6 +119,25 @@ func (r *workflowRepository) ListWorkflows(tenantId string, opts *repository.Lis
 		latestRunsMap[uuid] = &latestRuns[i].WorkflowRun
 	}
 
+	workflowIds := make([]pgtype.UUID, len(workflows))
+
+	for i := range workflows {
+		workflowIds[i] = workflows[i].Workflow.ID
+	}
+
+	activeCrons, err := r.queries.ListActiveWorkflowCrons(context.Background(), tx, workflowIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch crons: %w", err)
+	}
+
+	cronsMap := map[string][]*dbsqlc.WorkflowTriggerCronRef{}
+
+	for i := range activeCrons {
+		uuid := sqlchelpers.UUIDToStr(activeCrons[i].WorkflowId)
+		cronsMap[uuid] = append(cronsMap[uuid], &activeCrons[i].WorkflowTriggerCronRef)
+	}
+
 	count, err := r.queries.CountWorkflows(context.Background(), tx, countParams)
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
@@ -153,6 +173,7 @@ func (r *workflowRepository) ListWorkflows(tenantId string, opts *repository.Lis
 		rows = append(rows, &repository.ListWorkflowsRow{
 			WorkflowModel: prismaWorkflows[i],
 			LatestRun:     prismaRun,
+			Crons:         sqlctoprisma.NewConverter[dbsqlc.WorkflowTriggerCronRef, db.WorkflowTriggerCronRefModel]().ToPrismaList(cronsMap[workflow.ID]),
 		})
 	}
 
@@ -391,11 +412,170 @@ func (r *workflowRepository) GetWorkflowByName(tenantId, workflowName string) (*
 }
 
 func (r *workflowRepository) GetScheduledById(tenantId, scheduleTriggerId string) (*db.WorkflowTriggerScheduledRefModel, error) {
-	return r.client.WorkflowTriggerScheduledRef.FindUnique(
+	return r.client.WorkflowTriggerScheduledRef.FindFirst(
 		db.WorkflowTriggerScheduledRef.ID.Equals(scheduleTriggerId),
+		db.WorkflowTriggerScheduledRef.Parent.Where(
+			db.WorkflowVersion.Workflow.Where(
+				db.Workflow.TenantID.Equals(tenantId),
+			),
+		),
+	).With(
+		db.WorkflowTriggerScheduledRef.Parent.Fetch().With(
+			db.WorkflowVersion.Workflow.Fetch(),
+		),
+		db.WorkflowTriggerScheduledRef.Triggered.Fetch(),
 	).Exec(context.Background())
 }
 
+func (r *workflowRepository) ListScheduledWorkflows(tenantId string, opts *repository.ListScheduledWorkflowsOpts) (*repository.ListScheduledWorkflowsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &repository.ListScheduledWorkflowsResult{}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	queryParams := dbsqlc.ListScheduledWorkflowsParams{
+		Tenantid: pgTenantId,
+	}
+
+	countParams := dbsqlc.CountScheduledWorkflowsParams{
+		Tenantid: pgTenantId,
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	if opts.WorkflowId != nil {
+		pgWorkflowId := sqlchelpers.UUIDFromStr(*opts.WorkflowId)
+
+		queryParams.WorkflowId = pgWorkflowId
+		countParams.WorkflowId = pgWorkflowId
+	}
+
+	if opts.Triggered != nil {
+		triggered := pgtype.Bool{Bool: *opts.Triggered, Valid: true}
+
+		queryParams.Triggered = triggered
+		countParams.Triggered = triggered
+	}
+
+	tx, err := r.pool.Begin(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(context.Background(), r.l, tx.Rollback)
+
+	scheduled, err := r.queries.ListScheduledWorkflows(context.Background(), tx, queryParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.queries.CountScheduledWorkflows(context.Background(), tx, countParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Rows = scheduled
+	res.Count = int(count)
+
+	return res, nil
+}
+
+func (r *workflowRepository) UpdateScheduledWorkflow(tenantId, scheduleTriggerId string, opts *repository.UpdateScheduledWorkflowOpts) (*db.WorkflowTriggerScheduledRefModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer deferRollback(context.Background(), r.l, tx.Rollback)
+
+	pgScheduledId := sqlchelpers.UUIDFromStr(scheduleTriggerId)
+
+	// wait for a ticker which is triggering the schedule, so the update below sees its workflow run
+	_, err = r.queries.LockScheduledWorkflow(context.Background(), tx, dbsqlc.LockScheduledWorkflowParams{
+		ID:       pgScheduledId,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, db.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	updateParams := dbsqlc.UpdateScheduledWorkflowParams{
+		ID:    pgScheduledId,
+		Input: opts.Input,
+	}
+
+	if opts.TriggerAt != nil {
+		updateParams.TriggerAt = sqlchelpers.TimestampFromTime(*opts.TriggerAt)
+	}
+
+	_, err = r.queries.UpdateScheduledWorkflow(context.Background(), tx, updateParams)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrScheduledWorkflowTriggered
+		}
+
+		return nil, err
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetScheduledById(tenantId, scheduleTriggerId)
+}
+
+func (r *workflowRepository) DeleteScheduledWorkflow(tenantId, scheduleTriggerId string) error {
+	res, err := r.client.WorkflowTriggerScheduledRef.FindMany(
+		db.WorkflowTriggerScheduledRef.ID.Equals(scheduleTriggerId),
+		db.WorkflowTriggerScheduledRef.Parent.Where(
+			db.WorkflowVersion.Workflow.Where(
+				db.Workflow.TenantID.Equals(tenantId),
+			),
+		),
+	).Delete().Exec(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	if res.Count == 0 {
+		return db.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *workflowRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]db.WorkflowVersionModel, error) {
 	ctx, span := telemetry.NewSpan(ctx, "db-list-workflows-for-event")
 	defer span.End()
@@ -469,6 +649,13 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 		createParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 	}
 
+	if opts.WorkerSelectionStrategy != nil {
+		createParams.WorkerSelectionStrategy = dbsqlc.NullWorkerSelectionStrategy{
+			WorkerSelectionStrategy: dbsqlc.WorkerSelectionStrategy(*opts.WorkerSelectionStrategy),
+			Valid:                   true,
+		}
+	}
+
 	sqlcWorkflowVersion, err := r.queries.CreateWorkflowVersion(
 		context.Background(),
 		tx,
@@ -481,24 +668,29 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 
 	// create concurrency group
 	if opts.Concurrency != nil {
-		// upsert the action
-		action, err := r.queries.UpsertAction(
-			context.Background(),
-			tx,
-			dbsqlc.UpsertActionParams{
-				Action:   opts.Concurrency.Action,
-				Tenantid: tenantId,
-			},
-		)
-
-		if err != nil {
-			return "", fmt.Errorf("could not upsert action: %w", err)
+		params := dbsqlc.CreateWorkflowConcurrencyParams{
+			ID:                sqlchelpers.UUIDFromStr(uuid.New().String()),
+			Workflowversionid: sqlcWorkflowVersion.ID,
 		}
 
-		params := dbsqlc.CreateWorkflowConcurrencyParams{
-			ID:                    sqlchelpers.UUIDFromStr(uuid.New().String()),
-			Workflowversionid:     sqlcWorkflowVersion.ID,
-			Getconcurrencygroupid: action.ID,
+		if opts.Concurrency.Expression != nil {
+			params.ConcurrencyGroupExpression = sqlchelpers.TextFromStr(*opts.Concurrency.Expression)
+		} else {
+			// upsert the action
+			action, err := r.queries.UpsertAction(
+				context.Background(),
+				tx,
+				dbsqlc.UpsertActionParams{
+					Action:   opts.Concurrency.Action,
+					Tenantid: tenantId,
+				},
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not upsert action: %w", err)
+			}
+
+			params.GetConcurrencyGroupId = action.ID
 		}
 
 		if opts.Concurrency.MaxRuns != nil {
@@ -615,6 +807,19 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 				createStepParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 			}
 
+			if stepOpts.Concurrency != nil {
+				createStepParams.ConcurrencyKeyExpr = sqlchelpers.TextFromStr(stepOpts.Concurrency.Key)
+
+				// the default is stored, so steps with a key expression always have a max runs
+				maxRuns := int32(1)
+
+				if stepOpts.Concurrency.MaxRuns != nil {
+					maxRuns = *stepOpts.Concurrency.MaxRuns
+				}
+
+				createStepParams.ConcurrencyMaxRuns = sqlchelpers.ToInt(maxRuns)
+			}
+
 			_, err = r.queries.CreateStep(
 				context.Background(),
 				tx,
@@ -676,13 +881,41 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx pg
 	}
 
 	for _, cronTrigger := range opts.CronTriggers {
+		timezone := cronTrigger.Timezone
+
+		if timezone == "" {
+			timezone = "UTC"
+		}
+
+		createCronParams := dbsqlc.CreateWorkflowTriggerCronRefParams{
+			Workflowtriggersid: sqlcWorkflowTriggers.ID,
+			Crontrigger:        cronTrigger.Cron,
+			Timezone:           timezone,
+			Input:              cronTrigger.Input,
+		}
+
+		if cronTrigger.MisfirePolicy != nil {
+			createCronParams.MisfirePolicy = dbsqlc.NullCronMisfirePolicy{
+				CronMisfirePolicy: dbsqlc.CronMisfirePolicy(*cronTrigger.MisfirePolicy),
+				Valid:             true,
+			}
+		}
+
+		if cronTrigger.MaxMisfires != nil {
+			createCronParams.MaxMisfires = pgtype.Int4{
+				Int32: *cronTrigger.MaxMisfires,
+				Valid: true,
+			}
+		}
+
+		if cronTrigger.Jitter != nil {
+			createCronParams.Jitter = sqlchelpers.TextFromStr(*cronTrigger.Jitter)
+		}
+
 		_, err := r.queries.CreateWorkflowTriggerCronRef(
 			context.Background(),
 			tx,
-			dbsqlc.CreateWorkflowTriggerCronRefParams{
-				Workflowtriggersid: sqlcWorkflowTriggers.ID,
-				Crontrigger:        cronTrigger,
-			},
+			createCronParams,
 		)
 
 		if err != nil {
@@ -716,6 +949,253 @@ func (r *workflowRepository) DeleteWorkflow(tenantId, workflowId string) (*db.Wo
 	).Delete().Exec(context.Background())
 }
 
+func (r *workflowRepository) PauseWorkflow(tenantId, workflowId string, opts *repository.PauseWorkflowOpts) (*db.WorkflowModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workflow, err := r.GetWorkflowById(workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.TenantID != tenantId {
+		return nil, db.ErrNotFound
+	}
+
+	// pausing a paused workflow keeps the time it was first paused
+	pausedAt, ok := workflow.PausedAt()
+
+	if !ok {
+		pausedAt = time.Now().UTC()
+	}
+
+	return r.client.Workflow.FindUnique(
+		db.Workflow.ID.Equals(workflowId),
+	).With(
+		defaultWorkflowPopulator()...,
+	).Update(
+		db.Workflow.IsPaused.Set(true),
+		db.Workflow.PausedAt.Set(pausedAt),
+		db.Workflow.QueueEventsWhilePaused.Set(opts.QueueEvents),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) ResumeWorkflow(tenantId, workflowId string, opts *repository.ResumeWorkflowOpts) (*db.WorkflowModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workflow, err := r.GetWorkflowById(workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.TenantID != tenantId {
+		return nil, db.ErrNotFound
+	}
+
+	txs := []db.PrismaTransaction{
+		r.client.Workflow.FindUnique(
+			db.Workflow.ID.Equals(workflowId),
+		).Update(
+			db.Workflow.IsPaused.Set(false),
+			db.Workflow.PausedAt.SetOptional(nil),
+			db.Workflow.QueueEventsWhilePaused.Set(false),
+		).Tx(),
+	}
+
+	// events which are not replayed are dropped
+	if !opts.ReplayEvents && !workflow.QueueEventsWhilePaused {
+		txs = append(txs, r.client.WorkflowHeldEvent.FindMany(
+			db.WorkflowHeldEvent.WorkflowID.Equals(workflowId),
+		).Delete().Tx())
+	}
+
+	if err := r.client.Prisma.Transaction(txs...).Exec(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return r.GetWorkflowById(workflowId)
+}
+
+func (r *workflowRepository) HoldEvent(tenantId, workflowId, eventId string) error {
+	_, err := r.client.WorkflowHeldEvent.UpsertOne(
+		db.WorkflowHeldEvent.WorkflowIDEventID(
+			db.WorkflowHeldEvent.WorkflowID.Equals(workflowId),
+			db.WorkflowHeldEvent.EventID.Equals(eventId),
+		),
+	).Create(
+		db.WorkflowHeldEvent.Workflow.Link(
+			db.Workflow.ID.Equals(workflowId),
+		),
+		db.WorkflowHeldEvent.Event.Link(
+			db.Event.ID.Equals(eventId),
+		),
+	).Update().Exec(context.Background())
+
+	return err
+}
+
+func (r *workflowRepository) ListHeldEvents(tenantId, workflowId string) ([]db.WorkflowHeldEventModel, error) {
+	return r.client.WorkflowHeldEvent.FindMany(
+		db.WorkflowHeldEvent.WorkflowID.Equals(workflowId),
+	).With(
+		db.WorkflowHeldEvent.Event.Fetch(),
+	).OrderBy(
+		db.WorkflowHeldEvent.CreatedAt.Order(db.SortOrderAsc),
+	).Exec(context.Background())
+}
+
+func (r *workflowRepository) DeleteHeldEvent(tenantId, heldEventId string) error {
+	_, err := r.client.WorkflowHeldEvent.FindUnique(
+		db.WorkflowHeldEvent.ID.Equals(heldEventId),
+	).Delete().Exec(context.Background())
+
+	return err
+}
+
+func (r *workflowRepository) UpdateWorkflowRollout(tenantId, workflowId string, opts *repository.UpdateWorkflowRolloutOpts) (*db.WorkflowModel, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workflow, err := r.GetWorkflowById(workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.TenantID != tenantId {
+		return nil, db.ErrNotFound
+	}
+
+	versionIds := map[string]bool{}
+
+	for _, version := range workflow.Versions() {
+		versionIds[version.ID] = true
+	}
+
+	for _, versionId := range []*string{opts.PinnedVersionId, opts.CanaryVersionId} {
+		if versionId != nil && !versionIds[*versionId] {
+			return nil, repository.ErrWorkflowVersionNotInWorkflow
+		}
+	}
+
+	return r.setWorkflowRollout(tenantId, workflowId, opts.PinnedVersionId, opts.CanaryVersionId, opts.CanaryPercentage)
+}
+
+func (r *workflowRepository) PromoteWorkflowCanary(tenantId, workflowId string) (*db.WorkflowModel, error) {
+	workflow, err := r.GetWorkflowById(workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.TenantID != tenantId {
+		return nil, db.ErrNotFound
+	}
+
+	canaryVersionId, ok := workflow.CanaryVersionID()
+
+	if !ok {
+		return nil, repository.ErrNoCanaryVersion
+	}
+
+	pinnedVersionId := &canaryVersionId
+
+	// promoting the latest version unpins the workflow, so runs keep following new versions
+	if versions := workflow.Versions(); len(versions) > 0 && versions[0].ID == canaryVersionId {
+		pinnedVersionId = nil
+	}
+
+	return r.setWorkflowRollout(tenantId, workflowId, pinnedVersionId, nil, nil)
+}
+
+func (r *workflowRepository) RollbackWorkflow(tenantId, workflowId string) (*db.WorkflowModel, error) {
+	workflow, err := r.GetWorkflowById(workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if workflow.TenantID != tenantId {
+		return nil, db.ErrNotFound
+	}
+
+	pinnedVersionId, isPinned := workflow.PinnedVersionID()
+
+	// rolling back a canary keeps the version the other runs use
+	if _, ok := workflow.CanaryVersionID(); ok {
+		if !isPinned {
+			return r.setWorkflowRollout(tenantId, workflowId, nil, nil, nil)
+		}
+
+		return r.setWorkflowRollout(tenantId, workflowId, &pinnedVersionId, nil, nil)
+	}
+
+	// versions are ordered from the latest, so the previous version of the one runs use comes after it
+	versions := workflow.Versions()
+	current := 0
+
+	if isPinned {
+		for i, version := range versions {
+			if version.ID == pinnedVersionId {
+				current = i
+			}
+		}
+	}
+
+	if current+1 >= len(versions) {
+		return nil, repository.ErrNoPreviousWorkflowVersion
+	}
+
+	return r.setWorkflowRollout(tenantId, workflowId, &versions[current+1].ID, nil, nil)
+}
+
+func (r *workflowRepository) setWorkflowRollout(tenantId, workflowId string, pinnedVersionId, canaryVersionId *string, canaryPercentage *int) (*db.WorkflowModel, error) {
+	params := dbsqlc.UpdateWorkflowRolloutParams{
+		ID:       sqlchelpers.UUIDFromStr(workflowId),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if pinnedVersionId != nil {
+		params.PinnedVersionId = sqlchelpers.UUIDFromStr(*pinnedVersionId)
+	}
+
+	if canaryVersionId != nil {
+		params.CanaryVersionId = sqlchelpers.UUIDFromStr(*canaryVersionId)
+	}
+
+	if canaryPercentage != nil {
+		params.CanaryPercentage = pgtype.Int4{
+			Int32: int32(*canaryPercentage),
+			Valid: true,
+		}
+	}
+
+	if err := r.queries.UpdateWorkflowRollout(context.Background(), r.pool, params); err != nil {
+		return nil, fmt.Errorf("could not update workflow rollout: %w", err)
+	}
+
+	return r.GetWorkflowById(workflowId)
+}
+
+func (r *workflowRepository) SkipCronFire(cronParentId, cron, timezone string, fireAt time.Time) error {
+	return r.queries.UpdateWorkflowTriggerCronRefLastFiredAt(
+		context.Background(),
+		r.pool,
+		dbsqlc.UpdateWorkflowTriggerCronRefLastFiredAtParams{
+			Lastfiredat:  sqlchelpers.TimestampFromTime(fireAt),
+			Cronparentid: sqlchelpers.UUIDFromStr(cronParentId),
+			Cron:         cron,
+			Timezone:     timezone,
+		},
+	)
+}
+
 func (r *workflowRepository) GetWorkflowVersionById(tenantId, workflowVersionId string) (*db.WorkflowVersionModel, error) {
 	return r.client.WorkflowVersion.FindUnique(
 		db.WorkflowVersion.ID.Equals(workflowVersionId),
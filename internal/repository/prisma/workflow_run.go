@@ -234,7 +234,8 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 
 		var (
 			eventId, cronParentId, scheduledWorkflowId pgtype.UUID
-			cronId                                     pgtype.Text
+			cronId, cronTimezone                       pgtype.Text
+			cronFireAt                                 pgtype.Timestamp
 		)
 
 		if opts.TriggeringEventId != nil {
@@ -249,6 +250,14 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 			cronId = sqlchelpers.TextFromStr(*opts.Cron)
 		}
 
+		if opts.CronTimezone != nil {
+			cronTimezone = sqlchelpers.TextFromStr(*opts.CronTimezone)
+		}
+
+		if opts.CronFireAt != nil {
+			cronFireAt = sqlchelpers.TimestampFromTime(*opts.CronFireAt)
+		}
+
 		if opts.ScheduledWorkflowId != nil {
 			scheduledWorkflowId = sqlchelpers.UUIDFromStr(*opts.ScheduledWorkflowId)
 		}
@@ -262,6 +271,8 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 				EventId:       eventId,
 				CronParentId:  cronParentId,
 				Cron:          cronId,
+				CronTimezone:  cronTimezone,
+				CronFireAt:    cronFireAt,
 				ScheduledId:   scheduledWorkflowId,
 			},
 		)
@@ -270,6 +281,25 @@ func (w *workflowRunRepository) CreateNewWorkflowRun(ctx context.Context, tenant
 			return nil, err
 		}
 
+		// record the fire in the same transaction as the workflow run, so a ticker which takes over the cron
+		// knows which fires were missed
+		if opts.CronParentId != nil && opts.Cron != nil && opts.CronTimezone != nil && opts.CronFireAt != nil {
+			err = w.queries.UpdateWorkflowTriggerCronRefLastFiredAt(
+				tx1Ctx,
+				tx,
+				dbsqlc.UpdateWorkflowTriggerCronRefLastFiredAtParams{
+					Lastfiredat:  cronFireAt,
+					Cronparentid: cronParentId,
+					Cron:         *opts.Cron,
+					Timezone:     *opts.CronTimezone,
+				},
+			)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		requeueAfter := time.Now().UTC().Add(5 * time.Second)
 
 		if opts.GetGroupKeyRun != nil {
@@ -408,6 +438,27 @@ func (w *workflowRunRepository) GetWorkflowRunById(tenantId, id string) (*db.Wor
 	).Exec(context.Background())
 }
 
+func (w *workflowRunRepository) UpdateWorkflowRunGroupKey(tenantId, workflowRunId string, opts *repository.UpdateWorkflowRunGroupKeyOpts) (*dbsqlc.WorkflowRun, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateWorkflowRunGroupKeyFromExprParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+	}
+
+	if opts.GroupKey != nil {
+		params.GroupKey = sqlchelpers.TextFromStr(*opts.GroupKey)
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	return w.queries.UpdateWorkflowRunGroupKeyFromExpr(context.Background(), w.pool, params)
+}
+
 func (s *workflowRunRepository) CreateWorkflowRunPullRequest(tenantId, workflowRunId string, opts *repository.CreateWorkflowRunPullRequestOpts) (*db.GithubPullRequestModel, error) {
 	return s.client.GithubPullRequest.CreateOne(
 		db.GithubPullRequest.Tenant.Link(
@@ -477,3 +528,40 @@ func defaultWorkflowRunPopulator() []db.WorkflowRunRelationWith {
 		),
 	}
 }
+
+func (w *workflowRunRepository) CreateWorkflowRunEvent(tenantId string, opts *repository.CreateWorkflowRunEventOpts) (*dbsqlc.WorkflowRunEvent, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateWorkflowRunEventParams{
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Resourcetype: opts.ResourceType,
+		Resourceid:   sqlchelpers.UUIDFromStr(opts.ResourceId),
+		Eventtype:    opts.EventType,
+	}
+
+	if opts.Payload != nil {
+		params.Payload = sqlchelpers.TextFromStr(*opts.Payload)
+	}
+
+	event, err := w.queries.CreateWorkflowRunEvent(context.Background(), w.pool, params)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrWorkflowRunEventResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (w *workflowRunRepository) ListWorkflowRunEvents(tenantId, workflowRunId string, afterSequence int64) ([]*dbsqlc.WorkflowRunEvent, error) {
+	return w.queries.ListWorkflowRunEvents(context.Background(), w.pool, dbsqlc.ListWorkflowRunEventsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Aftersequence: afterSequence,
+	})
+}
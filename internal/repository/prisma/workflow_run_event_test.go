@@ -0,0 +1,104 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestCreateWorkflowRunEventSequences(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, _, stepRunIds := createAssignedStepRuns(t, repo, 1)
+
+		stepRun, err := repo.StepRun().GetStepRunById(tenantId, stepRunIds[0])
+		require.NoError(t, err)
+
+		workflowRunId := stepRun.JobRun().WorkflowRunID
+
+		const events = 10
+
+		wg := sync.WaitGroup{}
+
+		for i := 0; i < events; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := repo.WorkflowRun().CreateWorkflowRunEvent(tenantId, &repository.CreateWorkflowRunEventOpts{
+					ResourceType: dbsqlc.WorkflowRunEventResourceTypeSTEPRUN,
+					ResourceId:   stepRunIds[0],
+					EventType:    dbsqlc.WorkflowRunEventTypePROGRESS,
+				})
+
+				assert.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+
+		finished, err := repo.WorkflowRun().CreateWorkflowRunEvent(tenantId, &repository.CreateWorkflowRunEventOpts{
+			ResourceType: dbsqlc.WorkflowRunEventResourceTypeWORKFLOWRUN,
+			ResourceId:   workflowRunId,
+			EventType:    dbsqlc.WorkflowRunEventTypeCOMPLETED,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(events+1), finished.Sequence)
+
+		// concurrent events of a workflow run get consecutive sequences
+		all, err := repo.WorkflowRun().ListWorkflowRunEvents(tenantId, workflowRunId, 0)
+		require.NoError(t, err)
+		require.Len(t, all, events+1)
+
+		for i, event := range all {
+			assert.Equal(t, int64(i+1), event.Sequence)
+			assert.Equal(t, workflowRunId, sqlchelpers.UUIDToStr(event.WorkflowRunId))
+		}
+
+		// events after a cursor are returned in order
+		after, err := repo.WorkflowRun().ListWorkflowRunEvents(tenantId, workflowRunId, events-1)
+		require.NoError(t, err)
+		require.Len(t, after, 2)
+		assert.Equal(t, int64(events), after[0].Sequence)
+		assert.Equal(t, dbsqlc.WorkflowRunEventResourceTypeWORKFLOWRUN, after[1].ResourceType)
+
+		// events are not visible to other tenants
+		other, err := repo.WorkflowRun().ListWorkflowRunEvents(uuid.New().String(), workflowRunId, 0)
+		require.NoError(t, err)
+		assert.Empty(t, other)
+
+		return nil
+	})
+}
+
+func TestCreateWorkflowRunEventUnknownResource(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+
+		tenantId, _, _ := createAssignedStepRuns(t, repo, 1)
+
+		_, err := repo.WorkflowRun().CreateWorkflowRunEvent(tenantId, &repository.CreateWorkflowRunEventOpts{
+			ResourceType: dbsqlc.WorkflowRunEventResourceTypeSTEPRUN,
+			ResourceId:   uuid.New().String(),
+			EventType:    dbsqlc.WorkflowRunEventTypeSTARTED,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrWorkflowRunEventResourceNotFound)
+
+		return nil
+	})
+}
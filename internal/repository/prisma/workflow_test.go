@@ -0,0 +1,331 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/config/database"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+func TestPauseWorkflowHoldsEvents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, workflowVersion := createTickerTestWorkflow(t, repo)
+		workflowId := workflowVersion.WorkflowID
+
+		workflow, err := repo.Workflow().PauseWorkflow(tenantId, workflowId, &repository.PauseWorkflowOpts{
+			QueueEvents: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, workflow.IsPaused)
+
+		pausedAt, ok := workflow.PausedAt()
+		require.True(t, ok)
+
+		// pausing again keeps the time the workflow was first paused
+		workflow, err = repo.Workflow().PauseWorkflow(tenantId, workflowId, &repository.PauseWorkflowOpts{
+			QueueEvents: true,
+		})
+
+		require.NoError(t, err)
+
+		repausedAt, ok := workflow.PausedAt()
+		require.True(t, ok)
+		assert.True(t, pausedAt.Equal(repausedAt))
+
+		event, err := repo.Event().CreateEvent(context.Background(), &repository.CreateEventOpts{
+			TenantId: tenantId,
+			Key:      "paused:event",
+		})
+
+		require.NoError(t, err)
+
+		// holding the same event twice records it once
+		require.NoError(t, repo.Workflow().HoldEvent(tenantId, workflowId, event.ID))
+		require.NoError(t, repo.Workflow().HoldEvent(tenantId, workflowId, event.ID))
+
+		// queued events are kept on resume, so they can be replayed
+		workflow, err = repo.Workflow().ResumeWorkflow(tenantId, workflowId, &repository.ResumeWorkflowOpts{})
+		require.NoError(t, err)
+		assert.False(t, workflow.IsPaused)
+
+		_, ok = workflow.PausedAt()
+		assert.False(t, ok)
+
+		heldEvents, err := repo.Workflow().ListHeldEvents(tenantId, workflowId)
+		require.NoError(t, err)
+
+		if assert.Len(t, heldEvents, 1) {
+			assert.Equal(t, event.ID, heldEvents[0].Event().ID)
+		}
+
+		return nil
+	})
+}
+
+func TestResumeWorkflowDropsHeldEvents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, workflowVersion := createTickerTestWorkflow(t, repo)
+		workflowId := workflowVersion.WorkflowID
+
+		_, err := repo.Workflow().PauseWorkflow(tenantId, workflowId, &repository.PauseWorkflowOpts{})
+		require.NoError(t, err)
+
+		event, err := repo.Event().CreateEvent(context.Background(), &repository.CreateEventOpts{
+			TenantId: tenantId,
+			Key:      "paused:event",
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, repo.Workflow().HoldEvent(tenantId, workflowId, event.ID))
+
+		_, err = repo.Workflow().ResumeWorkflow(tenantId, workflowId, &repository.ResumeWorkflowOpts{})
+		require.NoError(t, err)
+
+		heldEvents, err := repo.Workflow().ListHeldEvents(tenantId, workflowId)
+		require.NoError(t, err)
+		assert.Empty(t, heldEvents)
+
+		return nil
+	})
+}
+
+func TestManageScheduledWorkflows(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, workflowVersion := createTickerTestWorkflow(t, repo)
+		workflowId := workflowVersion.WorkflowID
+
+		schedules, err := repo.Workflow().CreateSchedules(tenantId, workflowVersion.ID, &repository.CreateWorkflowSchedulesOpts{
+			ScheduledTriggers: []time.Time{time.Now().UTC().Add(time.Hour)},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+
+		scheduledId := schedules[0].ID
+
+		// schedules of other tenants are not found
+		_, err = repo.Workflow().GetScheduledById(uuid.New().String(), scheduledId)
+		assert.ErrorIs(t, err, db.ErrNotFound)
+
+		triggered := false
+
+		list, err := repo.Workflow().ListScheduledWorkflows(tenantId, &repository.ListScheduledWorkflowsOpts{
+			WorkflowId: &workflowId,
+			Triggered:  &triggered,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, list.Count)
+
+		if assert.Len(t, list.Rows, 1) {
+			assert.Equal(t, scheduledId, sqlchelpers.UUIDToStr(list.Rows[0].WorkflowTriggerScheduledRef.ID))
+			assert.Equal(t, "ticker-workflow", list.Rows[0].WorkflowName)
+			assert.False(t, list.Rows[0].WorkflowRunId.Valid)
+		}
+
+		triggerAt := time.Now().UTC().Add(2 * time.Hour).Truncate(time.Millisecond)
+
+		scheduled, err := repo.Workflow().UpdateScheduledWorkflow(tenantId, scheduledId, &repository.UpdateScheduledWorkflowOpts{
+			TriggerAt: &triggerAt,
+			Input:     []byte(`{"key":"value"}`),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, triggerAt.Equal(scheduled.TriggerAt))
+
+		input, ok := scheduled.Input()
+		require.True(t, ok)
+		assert.JSONEq(t, `{"key":"value"}`, string(input))
+
+		// fire the schedule
+		opts, err := repository.GetCreateWorkflowRunOptsFromSchedule(scheduled, workflowVersion)
+		require.NoError(t, err)
+
+		workflowRun, err := repo.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, opts)
+		require.NoError(t, err)
+
+		_, err = repo.Workflow().UpdateScheduledWorkflow(tenantId, scheduledId, &repository.UpdateScheduledWorkflowOpts{
+			TriggerAt: &triggerAt,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrScheduledWorkflowTriggered)
+
+		triggered = true
+
+		list, err = repo.Workflow().ListScheduledWorkflows(tenantId, &repository.ListScheduledWorkflowsOpts{
+			Triggered: &triggered,
+		})
+
+		require.NoError(t, err)
+
+		if assert.Len(t, list.Rows, 1) {
+			assert.Equal(t, workflowRun.ID, sqlchelpers.UUIDToStr(list.Rows[0].WorkflowRunId))
+		}
+
+		// deleting the schedule keeps the workflow run it triggered
+		require.NoError(t, repo.Workflow().DeleteScheduledWorkflow(tenantId, scheduledId))
+
+		_, err = repo.Workflow().GetScheduledById(tenantId, scheduledId)
+		assert.ErrorIs(t, err, db.ErrNotFound)
+
+		assert.ErrorIs(t, repo.Workflow().DeleteScheduledWorkflow(tenantId, scheduledId), db.ErrNotFound)
+
+		_, err = repo.WorkflowRun().GetWorkflowRunById(tenantId, workflowRun.ID)
+		assert.NoError(t, err)
+
+		return nil
+	})
+}
+
+func TestListWorkflowsReturnsLatestCrons(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, _ := createTickerTestWorkflow(t, repo)
+
+		createVersion := func(cron repository.CreateWorkflowCronTriggerOpts) {
+			_, err := repo.Workflow().CreateWorkflowVersion(tenantId, &repository.CreateWorkflowVersionOpts{
+				Name:         "ticker-workflow",
+				CronTriggers: []repository.CreateWorkflowCronTriggerOpts{cron},
+				Jobs: []repository.CreateWorkflowJobOpts{
+					{
+						Name: "job",
+						Steps: []repository.CreateWorkflowStepOpts{
+							{
+								ReadableId: "step",
+								Action:     "ticker:step",
+							},
+						},
+					},
+				},
+			})
+
+			require.NoError(t, err)
+		}
+
+		createVersion(repository.CreateWorkflowCronTriggerOpts{Cron: "0 * * * *"})
+		createVersion(repository.CreateWorkflowCronTriggerOpts{Cron: "random_daily", Jitter: repository.StringPtr("30m")})
+
+		listResp, err := repo.Workflow().ListWorkflows(tenantId, &repository.ListWorkflowsOpts{})
+		require.NoError(t, err)
+		require.Len(t, listResp.Rows, 1)
+
+		// only the crons of the latest version are scheduled
+		crons := listResp.Rows[0].Crons
+		require.Len(t, crons, 1)
+		assert.Equal(t, "random_daily", crons[0].Cron)
+
+		jitter, ok := crons[0].Jitter()
+		assert.True(t, ok)
+		assert.Equal(t, "30m", jitter)
+
+		return nil
+	})
+}
+
+func TestWorkflowRolloutPromoteAndRollback(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repo := conf.Repository
+		tenantId, firstVersion := createTickerTestWorkflow(t, repo)
+
+		secondVersion, err := repo.Workflow().CreateWorkflowVersion(tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "ticker-workflow",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "step",
+							Action:     "ticker:step-v2",
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		workflowId := firstVersion.WorkflowID
+
+		// versions of other workflows are rejected
+		_, err = repo.Workflow().UpdateWorkflowRollout(tenantId, workflowId, &repository.UpdateWorkflowRolloutOpts{
+			PinnedVersionId: repository.StringPtr(uuid.New().String()),
+		})
+
+		assert.ErrorIs(t, err, repository.ErrWorkflowVersionNotInWorkflow)
+
+		_, err = repo.Workflow().PromoteWorkflowCanary(tenantId, workflowId)
+		assert.ErrorIs(t, err, repository.ErrNoCanaryVersion)
+
+		canaryPercentage := 50
+
+		workflow, err := repo.Workflow().UpdateWorkflowRollout(tenantId, workflowId, &repository.UpdateWorkflowRolloutOpts{
+			PinnedVersionId:  &firstVersion.ID,
+			CanaryVersionId:  &secondVersion.ID,
+			CanaryPercentage: &canaryPercentage,
+		})
+
+		require.NoError(t, err)
+
+		pinnedVersionId, _ := workflow.PinnedVersionID()
+		assert.Equal(t, firstVersion.ID, pinnedVersionId)
+
+		canaryVersionId, _ := workflow.CanaryVersionID()
+		assert.Equal(t, secondVersion.ID, canaryVersionId)
+
+		// rolling back the canary keeps the pinned version
+		workflow, err = repo.Workflow().RollbackWorkflow(tenantId, workflowId)
+		require.NoError(t, err)
+
+		pinnedVersionId, _ = workflow.PinnedVersionID()
+		assert.Equal(t, firstVersion.ID, pinnedVersionId)
+
+		_, ok := workflow.CanaryVersionID()
+		assert.False(t, ok)
+		assert.Equal(t, firstVersion.ID, repository.SelectWorkflowVersionId(workflow, secondVersion.ID))
+
+		// promoting the latest version unpins the workflow
+		_, err = repo.Workflow().UpdateWorkflowRollout(tenantId, workflowId, &repository.UpdateWorkflowRolloutOpts{
+			PinnedVersionId:  &firstVersion.ID,
+			CanaryVersionId:  &secondVersion.ID,
+			CanaryPercentage: &canaryPercentage,
+		})
+
+		require.NoError(t, err)
+
+		workflow, err = repo.Workflow().PromoteWorkflowCanary(tenantId, workflowId)
+		require.NoError(t, err)
+
+		_, ok = workflow.PinnedVersionID()
+		assert.False(t, ok)
+
+		_, ok = workflow.CanaryVersionID()
+		assert.False(t, ok)
+
+		// rolling back without a canary pins the version before the latest one
+		workflow, err = repo.Workflow().RollbackWorkflow(tenantId, workflowId)
+		require.NoError(t, err)
+
+		pinnedVersionId, _ = workflow.PinnedVersionID()
+		assert.Equal(t, firstVersion.ID, pinnedVersionId)
+
+		_, err = repo.Workflow().RollbackWorkflow(tenantId, workflowId)
+		assert.ErrorIs(t, err, repository.ErrNoPreviousWorkflowVersion)
+
+		return nil
+	})
+}
@@ -20,6 +20,7 @@ type Repository interface {
 	Worker() WorkerRepository
 	UserSession() UserSessionRepository
 	User() UserRepository
+	Leader() LeaderRepository
 }
 
 func BoolPtr(b bool) *bool {
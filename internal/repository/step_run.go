@@ -50,6 +50,22 @@ type UpdateStepRunOpts struct {
 	Output []byte
 
 	RetryCount *int
+
+	ShutdownRequeueCount *int
+
+	ConcurrencyKey *string
+
+	// (optional) the attempt which the update belongs to. If set, the step run is only updated while it is
+	// assigned to or running the attempt, and ErrStepRunAttemptIsStale is returned otherwise.
+	Attempt *StepRunAttempt
+}
+
+type StepRunAttempt struct {
+	// (required) the id of the worker which the attempt was assigned to
+	WorkerId string `validate:"required,uuid"`
+
+	// (optional) the retry count of the attempt
+	RetryCount *int
 }
 
 type UpdateStepRunOverridesDataOpts struct {
@@ -64,6 +80,58 @@ func StepRunStatusPtr(status db.StepRunStatus) *db.StepRunStatus {
 
 var ErrStepRunIsNotPending = fmt.Errorf("step run is not pending")
 
+var ErrStepRunIsNotRunning = fmt.Errorf("step run is not running")
+
+var ErrStepRunIsNotAssigned = fmt.Errorf("step run is not assigned to the worker")
+
+var ErrStepRunAttemptIsStale = fmt.Errorf("step run attempt is stale")
+
+type UpdateStepRunProgressOpts struct {
+	// (required) the progress of the step run in percent
+	Progress int `validate:"min=0,max=100"`
+
+	// (optional) a message which describes the progress
+	Message *string
+}
+
+type AssignStepRunsOpts struct {
+	// (required) the maximum number of step runs to claim in a single pass
+	BatchSize int `validate:"required,min=1"`
+
+	// (required) only workers with a heartbeat after this time are assigned step runs
+	LastHeartbeatAfter time.Time `validate:"required"`
+
+	// (required) selects the worker for each step run
+	SelectWorker SelectWorkerFunc `validate:"required"`
+}
+
+type StepRunAssignment struct {
+	StepRunId    string
+	JobRunId     string
+	WorkerId     string
+	DispatcherId string
+}
+
+type AssignStepRunsResult struct {
+	// the step runs which were assigned to a worker
+	Assignments []*StepRunAssignment
+
+	// the number of pending step runs which were claimed in this pass, including step runs which could not be
+	// assigned because no worker had a free slot or the step's concurrency limit was reached
+	Claimed int
+}
+
+type ListLostStepRunsOpts struct {
+	// (required) the id of the worker which reported its step runs
+	WorkerId string `validate:"required,uuid"`
+
+	// (optional) the ids of the step runs which the worker is running
+	InFlightStepRunIds []string `validate:"dive,uuid"`
+
+	// (required) only step runs which were last updated before this time are returned
+	UpdatedBefore time.Time `validate:"required"`
+}
+
 type StepRunUpdateInfo struct {
 	JobRunFinalState      bool
 	WorkflowRunFinalState bool
@@ -84,6 +152,15 @@ type StepRunRepository interface {
 	// ListStepRunsToReassign returns a list of step runs which are in a reassignable state.
 	ListStepRunsToReassign(tenantId string) ([]*dbsqlc.StepRun, error)
 
+	// ListLostStepRuns returns the step runs which are assigned to or running on a worker, but which the worker
+	// did not report as in flight.
+	ListLostStepRuns(tenantId string, opts *ListLostStepRunsOpts) ([]*dbsqlc.StepRun, error)
+
+	// AssignStepRuns claims a batch of step runs which are pending assignment and distributes them across the
+	// least loaded workers with free slots in a single transaction. Step runs which cannot be assigned stay pending
+	// assignment.
+	AssignStepRuns(tenantId string, opts *AssignStepRunsOpts) (*AssignStepRunsResult, error)
+
 	UpdateStepRun(tenantId, stepRunId string, opts *UpdateStepRunOpts) (*db.StepRunModel, *StepRunUpdateInfo, error)
 
 	// UpdateStepRunOverridesData updates the overrides data field in the input for a step run. This returns the input
@@ -92,6 +169,23 @@ type StepRunRepository interface {
 
 	UpdateStepRunInputSchema(tenantId, stepRunId string, schema []byte) ([]byte, error)
 
+	// RefreshStepRunTimeout sets the time at which an assigned or running step run times out. It returns
+	// ErrStepRunIsNotRunning if the step run is not assigned or running, or has already timed out.
+	RefreshStepRunTimeout(tenantId, stepRunId string, timeoutAt time.Time) (*dbsqlc.StepRun, error)
+
+	// UpdateStepRunProgress records the progress reported for an assigned or running step run. It returns
+	// ErrStepRunIsNotRunning if the step run is not assigned or running.
+	UpdateStepRunProgress(tenantId, stepRunId string, opts *UpdateStepRunProgressOpts) (*dbsqlc.StepRun, error)
+
+	// AckStepRunAssignment records that a worker received the step run assigned to it. It returns
+	// ErrStepRunIsNotAssigned if the step run is not assigned to or running on the worker.
+	AckStepRunAssignment(tenantId, stepRunId, workerId string) (*dbsqlc.StepRun, error)
+
+	// RequeueUnackedStepRun moves a step run which is assigned to a worker, but which the worker did not
+	// acknowledge, back to pending assignment. It returns false if the step run was acknowledged, started
+	// or reassigned in the meantime.
+	RequeueUnackedStepRun(tenantId, stepRunId, workerId string, requeueAfter time.Time) (bool, error)
+
 	GetStepRunById(tenantId, stepRunId string) (*db.StepRunModel, error)
 
 	// QueueStepRun is like UpdateStepRun, except that it will only update the step run if it is in
@@ -13,6 +13,11 @@ type CreateTenantOpts struct {
 	ID *string `validate:"omitempty,uuid"`
 }
 
+type UpdateTenantOpts struct {
+	// (optional) the default strategy for selecting a worker to assign a step run to
+	WorkerSelectionStrategy *string `validate:"omitnil,oneof=LEAST_LOADED ROUND_ROBIN RANDOM MOST_RECENT_HEARTBEAT"`
+}
+
 type CreateTenantMemberOpts struct {
 	Role   string `validate:"required,oneof=OWNER ADMIN MEMBER"`
 	UserId string `validate:"required,uuid"`
@@ -35,6 +40,9 @@ type TenantRepository interface {
 	// GetTenantBySlug returns the tenant with the given slug
 	GetTenantBySlug(slug string) (*db.TenantModel, error)
 
+	// UpdateTenant updates the tenant with the given id
+	UpdateTenant(tenantId string, opts *UpdateTenantOpts) (*db.TenantModel, error)
+
 	// CreateTenantMember creates a new member in the tenant
 	CreateTenantMember(tenantId string, opts *CreateTenantMemberOpts) (*db.TenantMemberModel, error)
 
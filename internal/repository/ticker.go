@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
@@ -21,6 +22,29 @@ type ListTickerOpts struct {
 	Active *bool
 }
 
+type StepRunTimeout struct {
+	TenantId  string
+	JobRunId  string
+	StepRunId string
+}
+
+type JobRunTimeout struct {
+	TenantId string
+	JobRunId string
+}
+
+type GetGroupKeyRunTimeout struct {
+	TenantId         string
+	WorkflowRunId    string
+	GetGroupKeyRunId string
+}
+
+type DueScheduledWorkflow struct {
+	TenantId            string
+	WorkflowVersionId   string
+	ScheduledWorkflowId string
+}
+
 type TickerRepository interface {
 	// CreateNewTicker creates a new ticker.
 	CreateNewTicker(opts *CreateTickerOpts) (*db.TickerModel, error)
@@ -36,26 +60,31 @@ type TickerRepository interface {
 	// Delete deletes a ticker.
 	Delete(tickerId string) error
 
-	// AddJobRun assigns a job run to a ticker.
-	AddJobRun(tickerId string, jobRun *db.JobRunModel) (*db.TickerModel, error)
-
-	// AddStepRun assigns a step run to a ticker.
-	AddStepRun(tickerId, stepRunId string) (*db.TickerModel, error)
-
-	// AddGetGroupKeyRun assigns a get group key run to a ticker.
-	AddGetGroupKeyRun(tickerId, getGroupKeyRunId string) (*db.TickerModel, error)
-
 	// AddCron assigns a cron to a ticker.
 	AddCron(tickerId string, cron *db.WorkflowTriggerCronRefModel) (*db.TickerModel, error)
 
 	// RemoveCron removes a cron from a ticker.
 	RemoveCron(tickerId string, cron *db.WorkflowTriggerCronRefModel) (*db.TickerModel, error)
 
-	// AddScheduledWorkflow assigns a scheduled workflow to a ticker.
-	AddScheduledWorkflow(tickerId string, schedule *db.WorkflowTriggerScheduledRefModel) (*db.TickerModel, error)
-
-	// RemoveScheduledWorkflow removes a scheduled workflow from a ticker.
-	RemoveScheduledWorkflow(tickerId string, schedule *db.WorkflowTriggerScheduledRefModel) (*db.TickerModel, error)
+	// PollStepRunTimeouts claims up to batchSize step runs whose timeout has passed, skipping step runs which are
+	// claimed by another ticker, and calls onTimeout with the ones which are still assigned or running. The timeouts
+	// are only cleared if onTimeout succeeds, so they are claimed again if the ticker dies while handling them. It
+	// returns the number of claimed step runs.
+	PollStepRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*StepRunTimeout) error) (int, error)
+
+	// PollJobRunTimeouts claims up to batchSize job runs whose timeout has passed and calls onTimeout with the ones
+	// which are not finished, in the same way as PollStepRunTimeouts.
+	PollJobRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*JobRunTimeout) error) (int, error)
+
+	// PollGetGroupKeyRunTimeouts claims up to batchSize get group key runs whose timeout has passed and calls
+	// onTimeout with the ones which are still assigned or running, in the same way as PollStepRunTimeouts.
+	PollGetGroupKeyRunTimeouts(ctx context.Context, batchSize int, onTimeout func(timeouts []*GetGroupKeyRunTimeout) error) (int, error)
+
+	// PollScheduledWorkflows claims up to batchSize scheduled workflows of the latest workflow versions whose trigger
+	// time has passed and which have not triggered a workflow run, and calls onDue with them. onDue should create
+	// the workflow runs: a scheduled workflow is claimed again until its workflow run exists. It returns the number
+	// of claimed scheduled workflows.
+	PollScheduledWorkflows(ctx context.Context, batchSize int, onDue func(schedules []*DueScheduledWorkflow) error) (int, error)
 
 	UpdateStaleTickers(onStale func(tickerId string, getValidTickerId func() string) error) error
 }
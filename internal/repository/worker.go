@@ -22,6 +22,9 @@ type CreateWorkerOpts struct {
 
 	// A list of actions this worker can run
 	Actions []string `validate:"dive,actionId"`
+
+	// The maximum number of runs for an action this worker can run at a time, keyed by action id
+	ActionSlots map[string]int `validate:"dive,keys,actionId,endkeys,gte=1"`
 }
 
 type UpdateWorkerOpts struct {
@@ -31,6 +34,9 @@ type UpdateWorkerOpts struct {
 	// The status of the worker
 	Status *db.WorkerStatus
 
+	// Whether the worker is assigned new step runs
+	SchedulingStatus *db.WorkerSchedulingStatus
+
 	// When the last worker heartbeat was
 	LastHeartbeatAt *time.Time
 
@@ -38,11 +44,33 @@ type UpdateWorkerOpts struct {
 	Actions []string `validate:"dive,actionId"`
 }
 
+func WorkerStatusPtr(status db.WorkerStatus) *db.WorkerStatus {
+	return &status
+}
+
 type WorkerWithStepCount struct {
 	Worker       *db.WorkerModel
 	StepRunCount int
 }
 
+// WorkerCandidate is a worker with a free slot which can be assigned a step run.
+type WorkerCandidate struct {
+	WorkerId string
+
+	// the number of step runs which are assigned to or running on the worker
+	ActiveStepRuns int
+
+	// the maximum number of step runs the worker can run at a time, if set
+	MaxRuns *int
+
+	LastHeartbeatAt time.Time
+}
+
+// SelectWorkerFunc picks the worker to assign a step run to with the step run's worker selection strategy, and
+// returns the index of the selected candidate. The candidates are never empty and are always ordered by worker id. It
+// returns false if the strategy is not supported, in which case the step run is not assigned.
+type SelectWorkerFunc func(strategy dbsqlc.WorkerSelectionStrategy, candidates []*WorkerCandidate) (int, bool)
+
 type ListWorkersOpts struct {
 	Action *string `validate:"omitempty,actionId"`
 
@@ -64,14 +92,15 @@ type WorkerRepository interface {
 	// UpdateWorker updates a worker for a given tenant.
 	UpdateWorker(tenantId, workerId string, opts *UpdateWorkerOpts) (*db.WorkerModel, error)
 
-	// DeleteWorker removes the worker from the database
+	// DeleteWorker removes the worker from the database. The step runs which are assigned to or running on the
+	// worker are moved back to pending assignment first.
 	DeleteWorker(tenantId, workerId string) error
 
 	// GetWorkerById returns a worker by its id.
 	GetWorkerById(workerId string) (*db.WorkerModel, error)
 
-	// AddStepRun assigns a step run to a worker.
-	AddStepRun(tenantId, workerId, stepRunId string) error
+	// CountActiveStepRuns returns the number of step runs which are assigned to or running on the worker.
+	CountActiveStepRuns(tenantId, workerId string) (int, error)
 
 	// AddGetGroupKeyRun assigns a get group key run to a worker.
 	AddGetGroupKeyRun(tenantId, workerId, getGroupKeyRunId string) error
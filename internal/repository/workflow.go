@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/digest"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 )
 
 type CreateWorkflowVersionOpts struct {
@@ -26,7 +28,7 @@ type CreateWorkflowVersionOpts struct {
 	EventTriggers []string
 
 	// (optional) cron triggers for the workflow
-	CronTriggers []string `validate:"dive,cron"`
+	CronTriggers []CreateWorkflowCronTriggerOpts `validate:"dive"`
 
 	// (optional) scheduled triggers for the workflow
 	ScheduledTriggers []time.Time
@@ -39,11 +41,38 @@ type CreateWorkflowVersionOpts struct {
 
 	// (optional) the amount of time for step runs to wait to be scheduled before timing out
 	ScheduleTimeout *string `validate:"omitempty,duration"`
+
+	// (optional) the strategy for selecting a worker to assign a step run to, defaults to the tenant's strategy
+	WorkerSelectionStrategy *string `validate:"omitnil,oneof=LEAST_LOADED ROUND_ROBIN RANDOM MOST_RECENT_HEARTBEAT"`
+}
+
+type CreateWorkflowCronTriggerOpts struct {
+	// (required) the cron expression
+	Cron string `validate:"required,cron"`
+
+	// (optional) the IANA time zone the cron expression is evaluated in, defaults to UTC
+	Timezone string `validate:"omitempty,cronTimezone"`
+
+	// (optional) the input for workflow runs triggered by the cron
+	Input []byte
+
+	// (optional) what to do with fires which were missed while the cron had no running ticker, default SKIP
+	MisfirePolicy *string `validate:"omitnil,oneof=SKIP RUN_ONCE RUN_ALL"`
+
+	// (optional) the maximum number of missed fires to run with the RUN_ALL misfire policy
+	MaxMisfires *int32 `validate:"omitnil,min=1"`
+
+	// (optional) the window every fire is delayed within, such as 15m, by an amount which is stable for the workflow
+	Jitter *string `validate:"omitnil,cronJitter"`
 }
 
 type CreateWorkflowConcurrencyOpts struct {
-	// (required) the action id for getting the concurrency group
-	Action string `validate:"required,actionId"`
+	// (optional) the action id for getting the concurrency group, required if Expression is not set
+	Action string `validate:"required_without=Expression,excluded_with=Expression,omitempty,actionId"`
+
+	// (optional) a key expression over the workflow run data, such as input.customer_id, which is evaluated
+	// by the engine to get the concurrency group, required if Action is not set
+	Expression *string `validate:"required_without=Action,excluded_with=Action,omitnil,keyExpression"`
 
 	// (optional) the maximum number of concurrent workflow runs, default 1
 	MaxRuns *int32
@@ -115,6 +144,17 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry max
 	Retries *int `validate:"omitempty,min=0"`
+
+	// (optional) the step concurrency limit
+	Concurrency *CreateWorkflowStepConcurrencyOpts `validate:"omitnil"`
+}
+
+type CreateWorkflowStepConcurrencyOpts struct {
+	// (required) the key expression which groups step runs, evaluated against the step run input
+	Key string `validate:"required,keyExpression"`
+
+	// (optional) the maximum number of concurrently assigned or running step runs per key, default 1
+	MaxRuns *int32 `validate:"omitnil,min=1"`
 }
 
 type ListWorkflowsOpts struct {
@@ -132,6 +172,9 @@ type ListWorkflowsRow struct {
 	*db.WorkflowModel
 
 	LatestRun *db.WorkflowRunModel
+
+	// the cron triggers of the latest version of the workflow
+	Crons []*db.WorkflowTriggerCronRefModel
 }
 
 type ListWorkflowsResult struct {
@@ -147,6 +190,94 @@ func (e *JobRunHasCycleError) Error() string {
 	return fmt.Sprintf("job %s has a cycle", e.JobName)
 }
 
+type PauseWorkflowOpts struct {
+	// (optional) whether events which trigger the workflow while it is paused are replayed when it is resumed,
+	// default false
+	QueueEvents bool
+}
+
+type ResumeWorkflowOpts struct {
+	// (optional) whether to replay the events which triggered the workflow while it was paused. Events are always
+	// replayed if the workflow was paused with QueueEvents.
+	ReplayEvents bool
+}
+
+type UpdateWorkflowRolloutOpts struct {
+	// (optional) the version runs use instead of the latest version, runs use the latest version if unset
+	PinnedVersionId *string `validate:"omitnil,uuid"`
+
+	// (optional) the version which is rolled out to a percentage of runs, required with CanaryPercentage
+	CanaryVersionId *string `validate:"omitnil,uuid,required_with=CanaryPercentage"`
+
+	// (optional) the percentage of runs which use the canary version, required with CanaryVersionId
+	CanaryPercentage *int `validate:"omitnil,min=1,max=99,required_with=CanaryVersionId"`
+}
+
+var ErrWorkflowVersionNotInWorkflow = fmt.Errorf("workflow version does not belong to the workflow")
+
+var ErrNoCanaryVersion = fmt.Errorf("workflow has no canary version")
+
+var ErrNoPreviousWorkflowVersion = fmt.Errorf("workflow has no previous version to roll back to")
+
+// SelectWorkflowVersionId returns the id of the version a new run of the workflow uses. The canary version is used
+// for its percentage of runs, and the pinned version for the rest. Without a pinned version, the rest use the
+// default version, which is the version that matched the trigger.
+func SelectWorkflowVersionId(workflow *db.WorkflowModel, defaultVersionId string) string {
+	if canaryVersionId, ok := workflow.CanaryVersionID(); ok {
+		if percentage, ok := workflow.CanaryPercentage(); ok && rand.Intn(100) < percentage { // nolint: gosec
+			return canaryVersionId
+		}
+	}
+
+	if pinnedVersionId, ok := workflow.PinnedVersionID(); ok {
+		return pinnedVersionId
+	}
+
+	return defaultVersionId
+}
+
+// GetWorkflowVersionForRun returns the version a new run of the workflow uses, where the default version is the
+// version that matched the trigger. See SelectWorkflowVersionId.
+func GetWorkflowVersionForRun(repo WorkflowRepository, workflow *db.WorkflowModel, defaultVersion *db.WorkflowVersionModel) (*db.WorkflowVersionModel, error) {
+	workflowVersionId := SelectWorkflowVersionId(workflow, defaultVersion.ID)
+
+	if workflowVersionId == defaultVersion.ID {
+		return defaultVersion, nil
+	}
+
+	return repo.GetWorkflowVersionById(workflow.TenantID, workflowVersionId)
+}
+
+type ListScheduledWorkflowsOpts struct {
+	// (optional) the workflow id
+	WorkflowId *string `validate:"omitempty,uuid"`
+
+	// (optional) whether to return only the schedules which have triggered a workflow run, or only the ones which
+	// have not
+	Triggered *bool
+
+	// (optional) number of schedules to skip
+	Offset *int
+
+	// (optional) number of schedules to return
+	Limit *int
+}
+
+type ListScheduledWorkflowsResult struct {
+	Rows  []*dbsqlc.ListScheduledWorkflowsRow
+	Count int
+}
+
+type UpdateScheduledWorkflowOpts struct {
+	// (optional) the time that the workflow should be triggered
+	TriggerAt *time.Time
+
+	// (optional) the input to the scheduled workflow
+	Input []byte
+}
+
+var ErrScheduledWorkflowTriggered = fmt.Errorf("scheduled workflow has already triggered a workflow run")
+
 type UpsertWorkflowDeploymentConfigOpts struct {
 	// (required) the github app installation id
 	GithubAppInstallationId string `validate:"required,uuid"`
@@ -176,9 +307,20 @@ type WorkflowRepository interface {
 	// CreateSchedules creates schedules for a given workflow version.
 	CreateSchedules(tenantId, workflowVersionId string, opts *CreateWorkflowSchedulesOpts) ([]*db.WorkflowTriggerScheduledRefModel, error)
 
-	// GetScheduledById returns a scheduled workflow by its id.
+	// GetScheduledById returns a scheduled workflow by its id. It will return db.ErrNotFound if the scheduled
+	// workflow does not exist.
 	GetScheduledById(tenantId, scheduleTriggerId string) (*db.WorkflowTriggerScheduledRefModel, error)
 
+	// ListScheduledWorkflows returns the scheduled workflows of a tenant, ordered by the time they are triggered.
+	ListScheduledWorkflows(tenantId string, opts *ListScheduledWorkflowsOpts) (*ListScheduledWorkflowsResult, error)
+
+	// UpdateScheduledWorkflow updates the trigger time or input of a scheduled workflow. It will return
+	// ErrScheduledWorkflowTriggered if the scheduled workflow has already triggered a workflow run.
+	UpdateScheduledWorkflow(tenantId, scheduleTriggerId string, opts *UpdateScheduledWorkflowOpts) (*db.WorkflowTriggerScheduledRefModel, error)
+
+	// DeleteScheduledWorkflow deletes a scheduled workflow. The workflow run it triggered, if any, is kept.
+	DeleteScheduledWorkflow(tenantId, scheduleTriggerId string) error
+
 	// GetWorkflowById returns a workflow by its name. It will return db.ErrNotFound if the workflow does not exist.
 	GetWorkflowById(workflowId string) (*db.WorkflowModel, error)
 
@@ -196,5 +338,38 @@ type WorkflowRepository interface {
 	// DeleteWorkflow deletes a workflow for a given tenant.
 	DeleteWorkflow(tenantId, workflowId string) (*db.WorkflowModel, error)
 
+	// PauseWorkflow pauses a workflow, so it does not start new runs until it is resumed.
+	PauseWorkflow(tenantId, workflowId string, opts *PauseWorkflowOpts) (*db.WorkflowModel, error)
+
+	// ResumeWorkflow resumes a paused workflow. The events which were held back during the pause are kept for
+	// replaying if they should be replayed, and deleted otherwise.
+	ResumeWorkflow(tenantId, workflowId string, opts *ResumeWorkflowOpts) (*db.WorkflowModel, error)
+
+	// HoldEvent records an event which triggered a workflow while it was paused.
+	HoldEvent(tenantId, workflowId, eventId string) error
+
+	// ListHeldEvents returns the events which were held back for a workflow, oldest first.
+	ListHeldEvents(tenantId, workflowId string) ([]db.WorkflowHeldEventModel, error)
+
+	// DeleteHeldEvent deletes an event which was held back for a workflow once it is replayed.
+	DeleteHeldEvent(tenantId, heldEventId string) error
+
+	// UpdateWorkflowRollout sets the pinned and canary versions of a workflow, replacing the previous ones. It will
+	// return ErrWorkflowVersionNotInWorkflow if a version belongs to a different workflow.
+	UpdateWorkflowRollout(tenantId, workflowId string, opts *UpdateWorkflowRolloutOpts) (*db.WorkflowModel, error)
+
+	// PromoteWorkflowCanary makes the canary version the version all runs use. It will return ErrNoCanaryVersion if
+	// the workflow has no canary version.
+	PromoteWorkflowCanary(tenantId, workflowId string) (*db.WorkflowModel, error)
+
+	// RollbackWorkflow stops the rollout of the canary version, or pins the workflow to the version before the one
+	// runs use if there is no canary version. It will return ErrNoPreviousWorkflowVersion if there is no version to
+	// roll back to.
+	RollbackWorkflow(tenantId, workflowId string) (*db.WorkflowModel, error)
+
+	// SkipCronFire records a cron fire which did not start a run because the workflow was paused, so it is not
+	// treated as a misfire.
+	SkipCronFire(cronParentId, cron, timezone string, fireAt time.Time) error
+
 	UpsertWorkflowDeploymentConfig(workflowId string, opts *UpsertWorkflowDeploymentConfigOpts) (*db.WorkflowDeploymentConfigModel, error)
 }
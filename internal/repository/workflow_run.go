@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
@@ -26,6 +27,10 @@ type CreateWorkflowRunOpts struct {
 	// (optional) the cron schedule that triggered the workflow run
 	Cron         *string `validate:"omitnil,cron,required_without=ManualTriggerInput,required_without=TriggeringEventId,required_without=ScheduledWorkflowId,excluded_with=ManualTriggerInput,excluded_with=TriggeringEventId,excluded_with=ScheduledWorkflowId"`
 	CronParentId *string `validate:"omitnil,uuid,required_without=ManualTriggerInput,required_without=TriggeringEventId,required_without=ScheduledWorkflowId,excluded_with=ManualTriggerInput,excluded_with=TriggeringEventId,excluded_with=ScheduledWorkflowId"`
+	CronTimezone *string `validate:"omitnil,required_with=Cron"`
+
+	// (optional) the time the cron was scheduled to fire, which is stored as the last fire time of the cron
+	CronFireAt *time.Time
 
 	// (optional) the scheduled trigger
 	ScheduledWorkflowId *string `validate:"omitnil,uuid,required_without=ManualTriggerInput,required_without=TriggeringEventId,required_without=Cron,excluded_with=ManualTriggerInput,excluded_with=TriggeringEventId,excluded_with=Cron"`
@@ -50,7 +55,7 @@ func GetCreateWorkflowRunOptsFromManual(workflowVersion *db.WorkflowVersionModel
 
 	jobRunData := input
 
-	if _, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
+	if hasGetGroupKeyAction(workflowVersion) {
 		opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
 			Input: jobRunData,
 		}
@@ -79,7 +84,7 @@ func GetCreateWorkflowRunOptsFromEvent(event *db.EventModel, workflowVersion *db
 	if data != nil {
 		jobRunData = []byte(json.RawMessage(*data))
 
-		if _, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
+		if hasGetGroupKeyAction(workflowVersion) {
 			opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
 				Input: jobRunData,
 			}
@@ -91,17 +96,25 @@ func GetCreateWorkflowRunOptsFromEvent(event *db.EventModel, workflowVersion *db
 	return opts, err
 }
 
-func GetCreateWorkflowRunOptsFromCron(cron, cronParentId string, workflowVersion *db.WorkflowVersionModel) (*CreateWorkflowRunOpts, error) {
+func GetCreateWorkflowRunOptsFromCron(cron, cronParentId, cronTimezone string, fireAt time.Time, input []byte, workflowVersion *db.WorkflowVersionModel) (*CreateWorkflowRunOpts, error) {
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:       StringPtr(getWorkflowRunDisplayName(workflowVersion)),
 		WorkflowVersionId: workflowVersion.ID,
 		Cron:              &cron,
 		CronParentId:      &cronParentId,
+		CronTimezone:      &cronTimezone,
+		CronFireAt:        &fireAt,
+	}
+
+	if input != nil && hasGetGroupKeyAction(workflowVersion) {
+		opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
+			Input: input,
+		}
 	}
 
 	var err error
 
-	opts.JobRuns, err = getJobsFromWorkflowVersion(workflowVersion, datautils.TriggeredByCron, nil)
+	opts.JobRuns, err = getJobsFromWorkflowVersion(workflowVersion, datautils.TriggeredByCron, input)
 
 	return opts, err
 }
@@ -121,7 +134,7 @@ func GetCreateWorkflowRunOptsFromSchedule(scheduledTrigger *db.WorkflowTriggerSc
 	if data != nil {
 		jobRunData = []byte(json.RawMessage(*data))
 
-		if _, hasConcurrency := workflowVersion.Concurrency(); hasConcurrency {
+		if hasGetGroupKeyAction(workflowVersion) {
 			opts.GetGroupKeyRun = &CreateGroupKeyRunOpts{
 				Input: jobRunData,
 			}
@@ -133,6 +146,20 @@ func GetCreateWorkflowRunOptsFromSchedule(scheduledTrigger *db.WorkflowTriggerSc
 	return opts, err
 }
 
+// hasGetGroupKeyAction returns true if the workflow version computes its concurrency group on a worker, in which
+// case a get group key run needs to be created alongside the workflow run.
+func hasGetGroupKeyAction(workflowVersion *db.WorkflowVersionModel) bool {
+	concurrency, hasConcurrency := workflowVersion.Concurrency()
+
+	if !hasConcurrency {
+		return false
+	}
+
+	_, hasAction := concurrency.GetConcurrencyGroupID()
+
+	return hasAction
+}
+
 func getJobsFromWorkflowVersion(workflowVersion *db.WorkflowVersionModel, triggeredBy datautils.TriggeredBy, input []byte) ([]CreateWorkflowJobRunOpts, error) {
 	resJobRunOpts := []CreateWorkflowJobRunOpts{}
 
@@ -251,6 +278,30 @@ type ListWorkflowRunRoundRobinsOpts struct {
 	Limit *int
 }
 
+type UpdateWorkflowRunGroupKeyOpts struct {
+	// (optional) the concurrency group key for the workflow run, required if Error is not set
+	GroupKey *string `validate:"required_without=Error,excluded_with=Error"`
+
+	// (optional) the error which occurred while computing the group key, required if GroupKey is not set
+	Error *string `validate:"required_without=GroupKey,excluded_with=GroupKey"`
+}
+
+type CreateWorkflowRunEventOpts struct {
+	// the type of resource which emitted the event
+	ResourceType dbsqlc.WorkflowRunEventResourceType `validate:"required,oneof=STEP_RUN WORKFLOW_RUN"`
+
+	// the id of the step run or workflow run which emitted the event
+	ResourceId string `validate:"required,uuid"`
+
+	// the type of event
+	EventType dbsqlc.WorkflowRunEventType `validate:"required,oneof=STARTED COMPLETED FAILED CANCELLED TIMED_OUT PROGRESS"`
+
+	// (optional) the event payload
+	Payload *string
+}
+
+var ErrWorkflowRunEventResourceNotFound = fmt.Errorf("workflow run for event resource not found")
+
 type WorkflowRunRepository interface {
 	// ListWorkflowRuns returns workflow runs for a given workflow version id.
 	ListWorkflowRuns(tenantId string, opts *ListWorkflowRunsOpts) (*ListWorkflowRunsResult, error)
@@ -263,7 +314,19 @@ type WorkflowRunRepository interface {
 	// GetWorkflowRunById returns a workflow run by id.
 	GetWorkflowRunById(tenantId, runId string) (*db.WorkflowRunModel, error)
 
+	// UpdateWorkflowRunGroupKey sets the concurrency group key of a workflow run which was computed by the engine,
+	// moving the workflow run to a queued state. If an error is set, the workflow run is failed instead.
+	UpdateWorkflowRunGroupKey(tenantId, workflowRunId string, opts *UpdateWorkflowRunGroupKeyOpts) (*dbsqlc.WorkflowRun, error)
+
 	CreateWorkflowRunPullRequest(tenantId, workflowRunId string, opts *CreateWorkflowRunPullRequestOpts) (*db.GithubPullRequestModel, error)
 
 	ListPullRequestsForWorkflowRun(tenantId, workflowRunId string, opts *ListPullRequestsForWorkflowRunOpts) ([]db.GithubPullRequestModel, error)
+
+	// CreateWorkflowRunEvent appends an event to the event log of the workflow run which the resource belongs to,
+	// assigning it the next sequence number of that workflow run.
+	CreateWorkflowRunEvent(tenantId string, opts *CreateWorkflowRunEventOpts) (*dbsqlc.WorkflowRunEvent, error)
+
+	// ListWorkflowRunEvents returns the events of a workflow run with a sequence number greater than afterSequence,
+	// in sequence order.
+	ListWorkflowRunEvents(tenantId, workflowRunId string, afterSequence int64) ([]*dbsqlc.WorkflowRunEvent, error)
 }
@@ -29,6 +29,13 @@ type WorkflowServiceClient interface {
 	GetWorkflowByName(ctx context.Context, in *GetWorkflowByNameRequest, opts ...grpc.CallOption) (*Workflow, error)
 	ListWorkflowsForEvent(ctx context.Context, in *ListWorkflowsForEventRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error)
 	DeleteWorkflow(ctx context.Context, in *DeleteWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	PauseWorkflow(ctx context.Context, in *PauseWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	ResumeWorkflow(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	ListScheduledWorkflows(ctx context.Context, in *ListScheduledWorkflowsRequest, opts ...grpc.CallOption) (*ListScheduledWorkflowsResponse, error)
+	GetScheduledWorkflow(ctx context.Context, in *GetScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error)
+	UpdateScheduledWorkflow(ctx context.Context, in *UpdateScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error)
+	DeleteScheduledWorkflow(ctx context.Context, in *DeleteScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error)
+	PreviewCron(ctx context.Context, in *PreviewCronRequest, opts ...grpc.CallOption) (*PreviewCronResponse, error)
 }
 
 type workflowServiceClient struct {
@@ -102,6 +109,69 @@ func (c *workflowServiceClient) DeleteWorkflow(ctx context.Context, in *DeleteWo
 	return out, nil
 }
 
+func (c *workflowServiceClient) PauseWorkflow(ctx context.Context, in *PauseWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, "/WorkflowService/PauseWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResumeWorkflow(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, "/WorkflowService/ResumeWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ListScheduledWorkflows(ctx context.Context, in *ListScheduledWorkflowsRequest, opts ...grpc.CallOption) (*ListScheduledWorkflowsResponse, error) {
+	out := new(ListScheduledWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/ListScheduledWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetScheduledWorkflow(ctx context.Context, in *GetScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error) {
+	out := new(ScheduledWorkflow)
+	err := c.cc.Invoke(ctx, "/WorkflowService/GetScheduledWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) UpdateScheduledWorkflow(ctx context.Context, in *UpdateScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error) {
+	out := new(ScheduledWorkflow)
+	err := c.cc.Invoke(ctx, "/WorkflowService/UpdateScheduledWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) DeleteScheduledWorkflow(ctx context.Context, in *DeleteScheduledWorkflowRequest, opts ...grpc.CallOption) (*ScheduledWorkflow, error) {
+	out := new(ScheduledWorkflow)
+	err := c.cc.Invoke(ctx, "/WorkflowService/DeleteScheduledWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) PreviewCron(ctx context.Context, in *PreviewCronRequest, opts ...grpc.CallOption) (*PreviewCronResponse, error) {
+	out := new(PreviewCronResponse)
+	err := c.cc.Invoke(ctx, "/WorkflowService/PreviewCron", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 // All implementations must embed UnimplementedWorkflowServiceServer
 // for forward compatibility
@@ -113,6 +183,13 @@ type WorkflowServiceServer interface {
 	GetWorkflowByName(context.Context, *GetWorkflowByNameRequest) (*Workflow, error)
 	ListWorkflowsForEvent(context.Context, *ListWorkflowsForEventRequest) (*ListWorkflowsResponse, error)
 	DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*Workflow, error)
+	PauseWorkflow(context.Context, *PauseWorkflowRequest) (*Workflow, error)
+	ResumeWorkflow(context.Context, *ResumeWorkflowRequest) (*Workflow, error)
+	ListScheduledWorkflows(context.Context, *ListScheduledWorkflowsRequest) (*ListScheduledWorkflowsResponse, error)
+	GetScheduledWorkflow(context.Context, *GetScheduledWorkflowRequest) (*ScheduledWorkflow, error)
+	UpdateScheduledWorkflow(context.Context, *UpdateScheduledWorkflowRequest) (*ScheduledWorkflow, error)
+	DeleteScheduledWorkflow(context.Context, *DeleteScheduledWorkflowRequest) (*ScheduledWorkflow, error)
+	PreviewCron(context.Context, *PreviewCronRequest) (*PreviewCronResponse, error)
 	mustEmbedUnimplementedWorkflowServiceServer()
 }
 
@@ -141,6 +218,27 @@ func (UnimplementedWorkflowServiceServer) ListWorkflowsForEvent(context.Context,
 func (UnimplementedWorkflowServiceServer) DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflow not implemented")
 }
+func (UnimplementedWorkflowServiceServer) PauseWorkflow(context.Context, *PauseWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ResumeWorkflow(context.Context, *ResumeWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ListScheduledWorkflows(context.Context, *ListScheduledWorkflowsRequest) (*ListScheduledWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledWorkflows not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetScheduledWorkflow(context.Context, *GetScheduledWorkflowRequest) (*ScheduledWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) UpdateScheduledWorkflow(context.Context, *UpdateScheduledWorkflowRequest) (*ScheduledWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) DeleteScheduledWorkflow(context.Context, *DeleteScheduledWorkflowRequest) (*ScheduledWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) PreviewCron(context.Context, *PreviewCronRequest) (*PreviewCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCron not implemented")
+}
 func (UnimplementedWorkflowServiceServer) mustEmbedUnimplementedWorkflowServiceServer() {}
 
 // UnsafeWorkflowServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +378,132 @@ func _WorkflowService_DeleteWorkflow_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_PauseWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).PauseWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/PauseWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).PauseWorkflow(ctx, req.(*PauseWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResumeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/ResumeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResumeWorkflow(ctx, req.(*ResumeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ListScheduledWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ListScheduledWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/ListScheduledWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ListScheduledWorkflows(ctx, req.(*ListScheduledWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetScheduledWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetScheduledWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/GetScheduledWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetScheduledWorkflow(ctx, req.(*GetScheduledWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_UpdateScheduledWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).UpdateScheduledWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/UpdateScheduledWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).UpdateScheduledWorkflow(ctx, req.(*UpdateScheduledWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_DeleteScheduledWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DeleteScheduledWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/DeleteScheduledWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DeleteScheduledWorkflow(ctx, req.(*DeleteScheduledWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_PreviewCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).PreviewCron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WorkflowService/PreviewCron",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).PreviewCron(ctx, req.(*PreviewCronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkflowService_ServiceDesc is the grpc.ServiceDesc for WorkflowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -315,6 +539,34 @@ var WorkflowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkflow",
 			Handler:    _WorkflowService_DeleteWorkflow_Handler,
 		},
+		{
+			MethodName: "PauseWorkflow",
+			Handler:    _WorkflowService_PauseWorkflow_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflow",
+			Handler:    _WorkflowService_ResumeWorkflow_Handler,
+		},
+		{
+			MethodName: "ListScheduledWorkflows",
+			Handler:    _WorkflowService_ListScheduledWorkflows_Handler,
+		},
+		{
+			MethodName: "GetScheduledWorkflow",
+			Handler:    _WorkflowService_GetScheduledWorkflow_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledWorkflow",
+			Handler:    _WorkflowService_UpdateScheduledWorkflow_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledWorkflow",
+			Handler:    _WorkflowService_DeleteScheduledWorkflow_Handler,
+		},
+		{
+			MethodName: "PreviewCron",
+			Handler:    _WorkflowService_PreviewCron_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workflows.proto",
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+)
+
+func (a *AdminServiceImpl) PreviewCron(ctx context.Context, req *contracts.PreviewCronRequest) (*contracts.PreviewCronResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if errs := cronutils.Validate(req.Cron, req.Timezone, req.GetJitter()); len(errs) > 0 {
+		violations := make([]string, len(errs))
+
+		for i, err := range errs {
+			violations[i] = fmt.Sprintf("%s: %v", err.Field, err)
+		}
+
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cron: %s", strings.Join(violations, "; "))
+	}
+
+	count := defaults.DefaultCronNextFireTimes
+
+	if req.Count != nil {
+		if *req.Count < 1 || *req.Count > defaults.MaxCronNextFireTimes {
+			return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", defaults.MaxCronNextFireTimes)
+		}
+
+		count = int(*req.Count)
+	}
+
+	var workflowId string
+
+	if req.WorkflowName != nil {
+		workflow, err := a.repo.Workflow().GetWorkflowByName(tenant.ID, *req.WorkflowName)
+
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return nil, status.Error(
+					codes.NotFound,
+					"workflow not found",
+				)
+			}
+
+			return nil, err
+		}
+
+		workflowId = workflow.ID
+	}
+
+	fireTimes, err := cronutils.Preview(workflowId, req.Cron, req.Timezone, req.GetJitter(), time.Now(), count)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &contracts.PreviewCronResponse{
+		NextFireTimes: make([]*timestamppb.Timestamp, len(fireTimes)),
+	}
+
+	for i, fireAt := range fireTimes {
+		res.NextFireTimes[i] = timestamppb.New(fireAt)
+	}
+
+	return res, nil
+}
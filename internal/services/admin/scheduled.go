@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+)
+
+func (a *AdminServiceImpl) ListScheduledWorkflows(ctx context.Context, req *contracts.ListScheduledWorkflowsRequest) (*contracts.ListScheduledWorkflowsResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	opts := &repository.ListScheduledWorkflowsOpts{
+		WorkflowId: req.WorkflowId,
+		Triggered:  req.Triggered,
+	}
+
+	if req.WorkflowId != nil {
+		if _, err := uuid.Parse(*req.WorkflowId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "workflow_id must be a uuid")
+		}
+	}
+
+	if req.Offset != nil {
+		offset := int(*req.Offset)
+		opts.Offset = &offset
+	}
+
+	if req.Limit != nil {
+		limit := int(*req.Limit)
+		opts.Limit = &limit
+	}
+
+	listResp, err := a.repo.Workflow().ListScheduledWorkflows(tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*contracts.ScheduledWorkflow, len(listResp.Rows))
+
+	for i := range listResp.Rows {
+		items[i] = toScheduledWorkflowFromSQLC(listResp.Rows[i])
+	}
+
+	return &contracts.ListScheduledWorkflowsResponse{
+		ScheduledWorkflows: items,
+		Count:              int32(listResp.Count),
+	}, nil
+}
+
+func (a *AdminServiceImpl) GetScheduledWorkflow(ctx context.Context, req *contracts.GetScheduledWorkflowRequest) (*contracts.ScheduledWorkflow, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	scheduled, err := a.repo.Workflow().GetScheduledById(tenant.ID, req.ScheduledWorkflowId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"scheduled workflow not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return toScheduledWorkflow(scheduled), nil
+}
+
+func (a *AdminServiceImpl) UpdateScheduledWorkflow(ctx context.Context, req *contracts.UpdateScheduledWorkflowRequest) (*contracts.ScheduledWorkflow, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	if _, err := uuid.Parse(req.ScheduledWorkflowId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_workflow_id must be a uuid")
+	}
+
+	opts := &repository.UpdateScheduledWorkflowOpts{}
+
+	if req.TriggerAt != nil {
+		triggerAt := req.TriggerAt.AsTime()
+
+		// schedules are only triggered if they come due after they were created, so they can't be moved to the past
+		if !triggerAt.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "trigger_at must be in the future")
+		}
+
+		opts.TriggerAt = &triggerAt
+	}
+
+	if req.Input != nil {
+		inputDataMap := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(*req.Input), &inputDataMap); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "input must be a JSON object: %v", err)
+		}
+
+		opts.Input = []byte(*req.Input)
+	}
+
+	scheduled, err := a.repo.Workflow().UpdateScheduledWorkflow(tenant.ID, req.ScheduledWorkflowId, opts)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"scheduled workflow not found",
+			)
+		}
+
+		if errors.Is(err, repository.ErrScheduledWorkflowTriggered) {
+			return nil, status.Error(
+				codes.FailedPrecondition,
+				err.Error(),
+			)
+		}
+
+		return nil, err
+	}
+
+	return toScheduledWorkflow(scheduled), nil
+}
+
+func (a *AdminServiceImpl) DeleteScheduledWorkflow(ctx context.Context, req *contracts.DeleteScheduledWorkflowRequest) (*contracts.ScheduledWorkflow, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	scheduled, err := a.repo.Workflow().GetScheduledById(tenant.ID, req.ScheduledWorkflowId)
+
+	if err == nil {
+		err = a.repo.Workflow().DeleteScheduledWorkflow(tenant.ID, req.ScheduledWorkflowId)
+	}
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"scheduled workflow not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return toScheduledWorkflow(scheduled), nil
+}
+
+func toScheduledWorkflow(scheduled *db.WorkflowTriggerScheduledRefModel) *contracts.ScheduledWorkflow {
+	workflowVersion := scheduled.Parent()
+
+	res := &contracts.ScheduledWorkflow{
+		Id:                scheduled.ID,
+		CreatedAt:         timestamppb.New(scheduled.CreatedAt),
+		UpdatedAt:         timestamppb.New(scheduled.UpdatedAt),
+		WorkflowId:        workflowVersion.WorkflowID,
+		WorkflowName:      workflowVersion.Workflow().Name,
+		WorkflowVersionId: workflowVersion.ID,
+		TriggerAt:         timestamppb.New(scheduled.TriggerAt),
+	}
+
+	if input, ok := scheduled.Input(); ok {
+		res.Input = string(input)
+	}
+
+	if triggered, ok := scheduled.Triggered(); ok && triggered != nil {
+		res.WorkflowRunId = &triggered.ParentID
+	}
+
+	return res
+}
+
+func toScheduledWorkflowFromSQLC(row *dbsqlc.ListScheduledWorkflowsRow) *contracts.ScheduledWorkflow {
+	scheduled := row.WorkflowTriggerScheduledRef
+
+	res := &contracts.ScheduledWorkflow{
+		Id:                sqlchelpers.UUIDToStr(scheduled.ID),
+		CreatedAt:         timestamppb.New(scheduled.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(scheduled.UpdatedAt.Time),
+		WorkflowId:        sqlchelpers.UUIDToStr(row.WorkflowId),
+		WorkflowName:      row.WorkflowName,
+		WorkflowVersionId: sqlchelpers.UUIDToStr(scheduled.ParentId),
+		TriggerAt:         timestamppb.New(scheduled.TriggerAt.Time),
+		Input:             string(scheduled.Input),
+	}
+
+	if row.WorkflowRunId.Valid {
+		workflowRunId := sqlchelpers.UUIDToStr(row.WorkflowRunId)
+		res.WorkflowRunId = &workflowRunId
+	}
+
+	return res
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -12,12 +13,15 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+	"github.com/hatchet-dev/hatchet/internal/workflowdiff"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
@@ -64,12 +68,27 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 		return nil, err
 	}
 
+	if workflow.IsPaused {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"workflow %s is paused",
+			workflow.Name,
+		)
+	}
+
 	workflowVersion := &workflow.Versions()[0]
 
 	if workflowVersion == nil {
 		return nil, fmt.Errorf("workflow with id %s has no versions", workflow.ID)
 	}
 
+	// the run uses the pinned or canary version of the workflow if it has one
+	workflowVersion, err = repository.GetWorkflowVersionForRun(a.repo.Workflow(), workflow, workflowVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version for run: %w", err)
+	}
+
 	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte(req.Input))
 
 	if err != nil {
@@ -218,59 +237,6 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 			}
 		}
 
-		if schedules := workflowVersion.Scheduled(); len(schedules) > 0 {
-			within := time.Now().UTC().Add(-6 * time.Second)
-
-			tickers, err := a.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-				LatestHeartbeatAt: &within,
-				Active:            repository.BoolPtr(true),
-			})
-
-			if err != nil {
-				return nil, err
-			}
-
-			if len(tickers) == 0 {
-				return nil, status.Error(
-					codes.FailedPrecondition,
-					"no tickers available",
-				)
-			}
-
-			numTickers := len(tickers)
-
-			for i, scheduledTrigger := range schedules {
-				scheduledTriggerCp := scheduledTrigger
-				ticker := tickers[i%numTickers]
-
-				_, err := a.repo.Ticker().AddScheduledWorkflow(
-					ticker.ID,
-					&scheduledTriggerCp,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-
-				task, err := workflowScheduleTask(&ticker, &scheduledTriggerCp, workflowVersion)
-
-				if err != nil {
-					return nil, err
-				}
-
-				// send to task queue
-				err = a.tq.AddTask(
-					ctx,
-					taskqueue.QueueTypeFromTickerID(ticker.ID),
-					task,
-				)
-
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
 		// cancel the old workflow version
 		if oldWorkflowVersion != nil {
 			oldTriggers, ok := oldWorkflowVersion.Triggers()
@@ -316,47 +282,21 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 					}
 				}
 			}
+		}
+	}
 
-			if schedules := oldWorkflowVersion.Scheduled(); len(schedules) > 0 {
-				for _, scheduleTrigger := range schedules {
-					scheduleTriggerCp := scheduleTrigger
-
-					if ticker, ok := scheduleTriggerCp.Ticker(); ok {
-						task, err := workflowCancelTask(ticker, &scheduleTriggerCp, workflowVersion)
+	resp := toWorkflowVersion(workflowVersion)
 
-						if err != nil {
-							return nil, err
-						}
+	// there is no previous version to diff against when the workflow was created
+	if req.IncludeDiff && oldWorkflowVersion != nil {
+		diff, err := workflowdiff.Compare(workflowVersion.Workflow(), oldWorkflowVersion, workflowVersion)
 
-						// only send to task queue if the trigger is in the future
-						if scheduleTriggerCp.TriggerAt.After(time.Now().UTC()) {
-							err = a.tq.AddTask(
-								ctx,
-								taskqueue.QueueTypeFromTickerID(ticker.ID),
-								task,
-							)
-
-							if err != nil {
-								return nil, err
-							}
-
-							// remove cron
-							_, err = a.repo.Ticker().RemoveScheduledWorkflow(
-								ticker.ID,
-								&scheduleTriggerCp,
-							)
-
-							if err != nil {
-								return nil, err
-							}
-						}
-					}
-				}
-			}
+		if err != nil {
+			return nil, fmt.Errorf("could not diff workflow versions: %w", err)
 		}
-	}
 
-	resp := toWorkflowVersion(workflowVersion)
+		resp.Diff = toWorkflowVersionDiff(diff)
+	}
 
 	return resp, nil
 }
@@ -402,7 +342,8 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		return nil, fmt.Errorf("could not convert schedule data to JSON: %w", err)
 	}
 
-	schedules, err := a.repo.Workflow().CreateSchedules(
+	// the schedules are polled by the tickers, so they only need to be stored
+	_, err = a.repo.Workflow().CreateSchedules(
 		tenant.ID,
 		workflowVersion.ID,
 		&repository.CreateWorkflowSchedulesOpts{
@@ -415,59 +356,6 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		return nil, err
 	}
 
-	if len(schedules) > 0 {
-		within := time.Now().UTC().Add(-6 * time.Second)
-
-		tickers, err := a.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-			LatestHeartbeatAt: &within,
-			Active:            repository.BoolPtr(true),
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-		if len(tickers) == 0 {
-			return nil, status.Error(
-				codes.FailedPrecondition,
-				"no tickers available",
-			)
-		}
-
-		numTickers := len(tickers)
-
-		for i, scheduledTrigger := range schedules {
-			scheduledTriggerCp := scheduledTrigger
-			ticker := tickers[i%numTickers]
-
-			_, err := a.repo.Ticker().AddScheduledWorkflow(
-				ticker.ID,
-				scheduledTriggerCp,
-			)
-
-			if err != nil {
-				return nil, err
-			}
-
-			task, err := workflowScheduleTask(&ticker, scheduledTriggerCp, workflowVersion)
-
-			if err != nil {
-				return nil, err
-			}
-
-			// send to task queue
-			err = a.tq.AddTask(
-				ctx,
-				taskqueue.QueueTypeFromTickerID(ticker.ID),
-				task,
-			)
-
-			if err != nil {
-				return nil, err
-			}
-		}
-	}
-
 	workflowVersion, err = a.repo.Workflow().GetWorkflowVersionById(
 		currWorkflow.TenantID,
 		workflowVersion.ID,
@@ -499,6 +387,67 @@ func (a *AdminServiceImpl) DeleteWorkflow(ctx context.Context, req *contracts.De
 	return resp, nil
 }
 
+func (a *AdminServiceImpl) PauseWorkflow(ctx context.Context, req *contracts.PauseWorkflowRequest) (*contracts.Workflow, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	workflow, err := a.repo.Workflow().PauseWorkflow(
+		tenant.ID,
+		req.WorkflowId,
+		&repository.PauseWorkflowOpts{
+			QueueEvents: req.QueueEvents,
+		},
+	)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"workflow not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return toWorkflow(workflow), nil
+}
+
+func (a *AdminServiceImpl) ResumeWorkflow(ctx context.Context, req *contracts.ResumeWorkflowRequest) (*contracts.Workflow, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	workflow, err := a.repo.Workflow().ResumeWorkflow(
+		tenant.ID,
+		req.WorkflowId,
+		&repository.ResumeWorkflowOpts{
+			ReplayEvents: req.ReplayEvents,
+		},
+	)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(
+				codes.NotFound,
+				"workflow not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	// replay the events which were held back during the pause
+	err = a.tq.AddTask(
+		ctx,
+		taskqueue.EVENT_PROCESSING_QUEUE,
+		tasktypes.WorkflowResumedToTask(workflow),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add workflow resumed task: %w", err)
+	}
+
+	return toWorkflow(workflow), nil
+}
+
 func (a *AdminServiceImpl) ListWorkflows(
 	ctx context.Context,
 	req *contracts.ListWorkflowsRequest,
@@ -583,6 +532,16 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 			if stepCp.UserData != "" {
 				steps[j].UserData = &stepCp.UserData
 			}
+
+			if stepCp.Concurrency != nil {
+				steps[j].Concurrency = &repository.CreateWorkflowStepConcurrencyOpts{
+					Key: stepCp.Concurrency.Key,
+				}
+
+				if stepCp.Concurrency.MaxRuns != 0 {
+					steps[j].Concurrency.MaxRuns = &stepCp.Concurrency.MaxRuns
+				}
+			}
 		}
 
 		jobs[i] = repository.CreateWorkflowJobOpts{
@@ -599,6 +558,47 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 		scheduledTriggers = append(scheduledTriggers, trigger.AsTime())
 	}
 
+	if err := validateCronTriggers(req.Opts); err != nil {
+		return nil, err
+	}
+
+	cronTriggers := make([]repository.CreateWorkflowCronTriggerOpts, 0, len(req.Opts.CronTriggers)+len(req.Opts.CronTriggerOpts))
+
+	for _, cron := range req.Opts.CronTriggers {
+		cronTriggers = append(cronTriggers, repository.CreateWorkflowCronTriggerOpts{
+			Cron: cron,
+		})
+	}
+
+	for _, trigger := range req.Opts.CronTriggerOpts {
+		cronTrigger := repository.CreateWorkflowCronTriggerOpts{
+			Cron:     trigger.Cron,
+			Timezone: trigger.Timezone,
+		}
+
+		if trigger.Input != "" {
+			if !json.Valid([]byte(trigger.Input)) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid input for cron trigger %s", trigger.Cron)
+			}
+
+			cronTrigger.Input = []byte(trigger.Input)
+		}
+
+		if trigger.MisfirePolicy != nil {
+			cronTrigger.MisfirePolicy = repository.StringPtr(trigger.MisfirePolicy.String())
+		}
+
+		if trigger.MaxMisfires != nil {
+			cronTrigger.MaxMisfires = trigger.MaxMisfires
+		}
+
+		if trigger.GetJitter() != "" {
+			cronTrigger.Jitter = trigger.Jitter
+		}
+
+		cronTriggers = append(cronTriggers, cronTrigger)
+	}
+
 	var concurrency *repository.CreateWorkflowConcurrencyOpts
 
 	if req.Opts.Concurrency != nil {
@@ -613,21 +613,32 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 			LimitStrategy: limitStrategy,
 		}
 
+		if req.Opts.Concurrency.Expression != "" {
+			concurrency.Expression = &req.Opts.Concurrency.Expression
+		}
+
 		if req.Opts.Concurrency.MaxRuns != 0 {
 			concurrency.MaxRuns = &req.Opts.Concurrency.MaxRuns
 		}
 	}
 
+	var workerSelectionStrategy *string
+
+	if req.Opts.WorkerSelectionStrategy != nil {
+		workerSelectionStrategy = repository.StringPtr(req.Opts.WorkerSelectionStrategy.String())
+	}
+
 	return &repository.CreateWorkflowVersionOpts{
-		Name:              req.Opts.Name,
-		Concurrency:       concurrency,
-		Description:       &req.Opts.Description,
-		Version:           &req.Opts.Version,
-		EventTriggers:     req.Opts.EventTriggers,
-		CronTriggers:      req.Opts.CronTriggers,
-		ScheduledTriggers: scheduledTriggers,
-		Jobs:              jobs,
-		ScheduleTimeout:   req.Opts.ScheduleTimeout,
+		Name:                    req.Opts.Name,
+		Concurrency:             concurrency,
+		Description:             &req.Opts.Description,
+		Version:                 &req.Opts.Version,
+		EventTriggers:           req.Opts.EventTriggers,
+		CronTriggers:            cronTriggers,
+		ScheduledTriggers:       scheduledTriggers,
+		Jobs:                    jobs,
+		ScheduleTimeout:         req.Opts.ScheduleTimeout,
+		WorkerSelectionStrategy: workerSelectionStrategy,
 	}, nil
 }
 
@@ -638,6 +649,7 @@ func toWorkflow(workflow *db.WorkflowModel) *contracts.Workflow {
 		UpdatedAt: timestamppb.New(workflow.UpdatedAt),
 		TenantId:  workflow.TenantID,
 		Name:      workflow.Name,
+		IsPaused:  workflow.IsPaused,
 	}
 
 	if description, ok := workflow.Description(); ok {
@@ -657,6 +669,32 @@ func toWorkflow(workflow *db.WorkflowModel) *contracts.Workflow {
 	return w
 }
 
+// validateCronTriggers returns an invalid argument error which lists every invalid field of the cron triggers, so
+// the cause is not lost in a generic validation error.
+func validateCronTriggers(opts *contracts.CreateWorkflowVersionOpts) error {
+	violations := []string{}
+
+	addViolations := func(path, cron string, errs []*cronutils.ValidationError) {
+		for _, err := range errs {
+			violations = append(violations, fmt.Sprintf("%s (%q) %s: %v", path, cron, err.Field, err))
+		}
+	}
+
+	for i, cron := range opts.CronTriggers {
+		addViolations(fmt.Sprintf("cron_triggers[%d]", i), cron, cronutils.Validate(cron, "", ""))
+	}
+
+	for i, trigger := range opts.CronTriggerOpts {
+		addViolations(fmt.Sprintf("cron_trigger_opts[%d]", i), trigger.Cron, cronutils.Validate(trigger.Cron, trigger.Timezone, trigger.GetJitter()))
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return status.Errorf(codes.InvalidArgument, "invalid cron triggers: %s", strings.Join(violations, "; "))
+}
+
 func toWorkflowVersion(workflowVersion *db.WorkflowVersionModel) *contracts.WorkflowVersion {
 	version := &contracts.WorkflowVersion{
 		Id:         workflowVersion.ID,
@@ -671,7 +709,7 @@ func toWorkflowVersion(workflowVersion *db.WorkflowVersionModel) *contracts.Work
 	}
 
 	if triggers, ok := workflowVersion.Triggers(); ok {
-		version.Triggers = toWorkflowVersionTriggers(triggers)
+		version.Triggers = toWorkflowVersionTriggers(workflowVersion.WorkflowID, triggers)
 	}
 
 	jobModels := workflowVersion.Jobs()
@@ -685,7 +723,29 @@ func toWorkflowVersion(workflowVersion *db.WorkflowVersionModel) *contracts.Work
 	return version
 }
 
-func toWorkflowVersionTriggers(triggers *db.WorkflowTriggersModel) *contracts.WorkflowTriggers {
+func toWorkflowVersionDiff(diff *workflowdiff.Diff) *contracts.WorkflowVersionDiff {
+	res := &contracts.WorkflowVersionDiff{
+		FromVersionId: diff.FromVersionId,
+		ToVersionId:   diff.ToVersionId,
+		Changes:       make([]*contracts.WorkflowVersionChange, len(diff.Changes)),
+		RawDiff:       diff.RawDiff,
+	}
+
+	for i, change := range diff.Changes {
+		res.Changes[i] = &contracts.WorkflowVersionChange{
+			Type: string(change.Type),
+			Kind: string(change.Kind),
+			Job:  change.Job,
+			Step: change.Step,
+			From: change.From,
+			To:   change.To,
+		}
+	}
+
+	return res
+}
+
+func toWorkflowVersionTriggers(workflowId string, triggers *db.WorkflowTriggersModel) *contracts.WorkflowTriggers {
 	t := &contracts.WorkflowTriggers{
 		Id:                triggers.ID,
 		CreatedAt:         timestamppb.New(triggers.CreatedAt),
@@ -713,6 +773,23 @@ func toWorkflowVersionTriggers(triggers *db.WorkflowTriggersModel) *contracts.Wo
 		cronTriggers[i] = &contracts.WorkflowTriggerCronRef{
 			ParentId: cronTriggerModel.ParentID,
 			Cron:     cronTriggerModel.Cron,
+			Timezone: cronTriggerModel.Timezone,
+		}
+
+		if input, ok := cronTriggerModel.Input(); ok {
+			cronTriggers[i].Input = string(input)
+		}
+
+		jitter, ok := cronTriggerModel.Jitter()
+
+		if ok {
+			cronTriggers[i].Jitter = &jitter
+		}
+
+		if schedule, err := cronutils.ParseForWorkflow(workflowId, cronTriggerModel.Cron, cronTriggerModel.Timezone, jitter); err == nil {
+			for _, fireAt := range schedule.NextN(time.Now(), defaults.DefaultCronNextFireTimes) {
+				cronTriggers[i].NextFireTimes = append(cronTriggers[i].NextFireTimes, timestamppb.New(fireAt))
+			}
 		}
 	}
 
@@ -784,6 +861,7 @@ func cronScheduleTask(ticker *db.TickerModel, cronTriggerRef *db.WorkflowTrigger
 	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleCronTaskPayload{
 		CronParentId:      cronTriggerRef.ParentID,
 		Cron:              cronTriggerRef.Cron,
+		Timezone:          cronTriggerRef.Timezone,
 		WorkflowVersionId: workflowVersion.ID,
 	})
 
@@ -802,6 +880,7 @@ func cronCancelTask(ticker *db.TickerModel, cronTriggerRef *db.WorkflowTriggerCr
 	payload, _ := datautils.ToJSONMap(tasktypes.CancelCronTaskPayload{
 		CronParentId:      cronTriggerRef.ParentID,
 		Cron:              cronTriggerRef.Cron,
+		Timezone:          cronTriggerRef.Timezone,
 		WorkflowVersionId: workflowVersion.ID,
 	})
 
@@ -815,39 +894,3 @@ func cronCancelTask(ticker *db.TickerModel, cronTriggerRef *db.WorkflowTriggerCr
 		Metadata: metadata,
 	}, nil
 }
-
-func workflowScheduleTask(ticker *db.TickerModel, workflowTriggerRef *db.WorkflowTriggerScheduledRefModel, workflowVersion *db.WorkflowVersionModel) (*taskqueue.Task, error) {
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleWorkflowTaskPayload{
-		ScheduledWorkflowId: workflowTriggerRef.ID,
-		TriggerAt:           workflowTriggerRef.TriggerAt.Format(time.RFC3339),
-		WorkflowVersionId:   workflowVersion.ID,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleWorkflowTaskMetadata{
-		TenantId: workflowVersion.Workflow().TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "schedule-workflow",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
-}
-
-func workflowCancelTask(ticker *db.TickerModel, workflowTriggerRef *db.WorkflowTriggerScheduledRefModel, workflowVersion *db.WorkflowVersionModel) (*taskqueue.Task, error) {
-	payload, _ := datautils.ToJSONMap(tasktypes.CancelWorkflowTaskPayload{
-		ScheduledWorkflowId: workflowTriggerRef.ID,
-		TriggerAt:           workflowTriggerRef.TriggerAt.Format(time.RFC3339),
-		WorkflowVersionId:   workflowVersion.ID,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.CancelWorkflowTaskMetadata{
-		TenantId: workflowVersion.Workflow().TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "cancel-workflow",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
-}
@@ -133,6 +133,17 @@ func (ec *EventsControllerImpl) Start() (func() error, error) {
 }
 
 func (ec *EventsControllerImpl) handleTask(ctx context.Context, task *taskqueue.Task) error {
+	switch task.ID {
+	case "event":
+		return ec.handleEvent(ctx, task)
+	case "workflow-resumed":
+		return ec.handleWorkflowResumed(ctx, task)
+	}
+
+	return fmt.Errorf("unknown task: %s", task.ID)
+}
+
+func (ec *EventsControllerImpl) handleEvent(ctx context.Context, task *taskqueue.Task) error {
 	payload := tasktypes.EventTaskPayload{}
 	metadata := tasktypes.EventTaskMetadata{}
 
@@ -178,25 +189,18 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, event *db.Even
 		workflowCp := workflow
 
 		g.Go(func() error {
-			// create a new workflow run in the database
-			createOpts, err := repository.GetCreateWorkflowRunOptsFromEvent(event, &workflowCp)
+			// paused workflows hold the event back until they are resumed
+			if workflowCp.Workflow().IsPaused {
+				err := ec.repo.Workflow().HoldEvent(tenantId, workflowCp.WorkflowID, event.ID)
 
-			if err != nil {
-				return fmt.Errorf("could not get create workflow run opts: %w", err)
-			}
-
-			workflowRun, err := ec.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
+				if err != nil {
+					return fmt.Errorf("could not hold event for paused workflow: %w", err)
+				}
 
-			if err != nil {
-				return fmt.Errorf("could not create workflow run: %w", err)
+				return nil
 			}
 
-			// send to workflow processing queue
-			return ec.tq.AddTask(
-				context.Background(),
-				taskqueue.WORKFLOW_PROCESSING_QUEUE,
-				tasktypes.WorkflowRunQueuedToTask(workflowRun),
-			)
+			return ec.runWorkflowForEvent(ctx, event, workflowCp.Workflow(), &workflowCp)
 		})
 	}
 
@@ -206,3 +210,104 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, event *db.Even
 
 	return nil
 }
+
+func (ec *EventsControllerImpl) handleWorkflowResumed(ctx context.Context, task *taskqueue.Task) error {
+	payload := tasktypes.WorkflowResumedTaskPayload{}
+	metadata := tasktypes.WorkflowResumedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode task metadata: %w", err)
+	}
+
+	workflow, err := ec.repo.Workflow().GetWorkflowById(payload.WorkflowId)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	// if the workflow was paused again, the held events are kept until it is resumed
+	if workflow.IsPaused {
+		return nil
+	}
+
+	heldEvents, err := ec.repo.Workflow().ListHeldEvents(metadata.TenantId, workflow.ID)
+
+	if err != nil {
+		return fmt.Errorf("could not list held events: %w", err)
+	}
+
+	if len(heldEvents) == 0 {
+		return nil
+	}
+
+	versions := workflow.Versions()
+
+	if len(versions) == 0 {
+		return fmt.Errorf("workflow %s has no versions", workflow.ID)
+	}
+
+	// held events are replayed against the latest version, which may no longer be triggered by them
+	workflowVersion := &versions[0]
+	eventKeys := map[string]bool{}
+
+	if triggers, ok := workflowVersion.Triggers(); ok {
+		for _, eventTrigger := range triggers.Events() {
+			eventKeys[eventTrigger.EventKey] = true
+		}
+	}
+
+	ec.l.Info().Msgf("replaying %d held events for workflow %s", len(heldEvents), workflow.ID)
+
+	for _, heldEvent := range heldEvents {
+		event := heldEvent.Event()
+
+		if eventKeys[event.Key] {
+			if err := ec.runWorkflowForEvent(ctx, event, workflow, workflowVersion); err != nil {
+				return err
+			}
+		}
+
+		if err := ec.repo.Workflow().DeleteHeldEvent(metadata.TenantId, heldEvent.ID); err != nil {
+			return fmt.Errorf("could not delete held event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ec *EventsControllerImpl) runWorkflowForEvent(ctx context.Context, event *db.EventModel, workflow *db.WorkflowModel, workflowVersion *db.WorkflowVersionModel) error {
+	// the run uses the pinned or canary version of the workflow if it has one
+	workflowVersion, err := repository.GetWorkflowVersionForRun(ec.repo.Workflow(), workflow, workflowVersion)
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow version for run: %w", err)
+	}
+
+	// create a new workflow run in the database
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromEvent(event, workflowVersion)
+
+	if err != nil {
+		return fmt.Errorf("could not get create workflow run opts: %w", err)
+	}
+
+	workflowRun, err := ec.repo.WorkflowRun().CreateNewWorkflowRun(ctx, event.TenantID, createOpts)
+
+	if err != nil {
+		return fmt.Errorf("could not create workflow run: %w", err)
+	}
+
+	// send to workflow processing queue
+	return ec.tq.AddTask(
+		context.Background(),
+		taskqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(workflowRun),
+	)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
@@ -18,8 +19,11 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/services/leader"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
@@ -39,8 +43,28 @@ type JobsControllerImpl struct {
 	dv   datautils.DataDecoderValidator
 	s    gocron.Scheduler
 	a    *hatcheterrors.Wrapped
+	le   *leader.Elector
+
+	// fq distributes step run scheduling work fairly across tenants
+	fq      *fairQueue
+	metrics *schedulingMetrics
+	ws      *workerSelectors
+
+	// events records the events of step runs once their updates are accepted
+	events *runevents.Recorder
 }
 
+const (
+	// the number of workers which schedule step runs, shared by all tenants
+	schedulingWorkers = 50
+
+	// the maximum number of step runs per tenant which can be queued for scheduling at once
+	maxQueuedStepRunsPerTenant = 1000
+
+	// the maximum number of step runs assigned to workers in a single pass
+	assignStepRunsBatchSize = 100
+)
+
 type JobsControllerOpt func(*JobsControllerOpts)
 
 type JobsControllerOpts struct {
@@ -49,6 +73,7 @@ type JobsControllerOpts struct {
 	repo    repository.Repository
 	dv      datautils.DataDecoderValidator
 	alerter hatcheterrors.Alerter
+	le      *leader.Elector
 }
 
 func defaultJobsControllerOpts() *JobsControllerOpts {
@@ -92,6 +117,14 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) JobsControllerO
 	}
 }
 
+// WithLeaderElector makes the requeue and reassign loops only run on the instance which leads them. Without an
+// elector, the loops run on every instance.
+func WithLeaderElector(le *leader.Elector) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.le = le
+	}
+}
+
 func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 	opts := defaultJobsControllerOpts()
 
@@ -119,14 +152,36 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 	a := hatcheterrors.NewWrapped(opts.alerter)
 	a.WithData(map[string]interface{}{"service": "jobs-controller"})
 
-	return &JobsControllerImpl{
-		tq:   opts.tq,
-		l:    opts.l,
-		repo: opts.repo,
-		dv:   opts.dv,
-		s:    s,
-		a:    a,
-	}, nil
+	metrics, err := newSchedulingMetrics()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduling metrics: %w", err)
+	}
+
+	jc := &JobsControllerImpl{
+		tq:      opts.tq,
+		l:       opts.l,
+		repo:    opts.repo,
+		dv:      opts.dv,
+		s:       s,
+		a:       a,
+		le:      opts.le,
+		metrics: metrics,
+		ws:      &workerSelectors{},
+		events:  runevents.NewRecorder(opts.repo.WorkflowRun(), opts.tq, opts.l),
+	}
+
+	jc.fq = newFairQueue(
+		maxQueuedStepRunsPerTenant,
+		func(tenantId string, wait time.Duration) {
+			jc.metrics.recordQueueWait(context.Background(), tenantId, wait)
+		},
+		func(tenantId string, err error) {
+			jc.l.Err(err).Str("tenant_id", tenantId).Msg("could not schedule step run")
+		},
+	)
+
+	return jc, nil
 }
 
 func (jc *JobsControllerImpl) Start() (func() error, error) {
@@ -144,7 +199,7 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 	_, err = jc.s.NewJob(
 		gocron.DurationJob(time.Second*5),
 		gocron.NewTask(
-			jc.runStepRunRequeue(ctx),
+			jc.le.Lead("jobs-controller:step-run-requeue", jc.runStepRunRequeue(ctx)),
 		),
 	)
 
@@ -156,7 +211,7 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 	_, err = jc.s.NewJob(
 		gocron.DurationJob(time.Second*5),
 		gocron.NewTask(
-			jc.runStepRunReassign(ctx),
+			jc.le.Lead("jobs-controller:step-run-reassign", jc.runStepRunReassign(ctx)),
 		),
 	)
 
@@ -165,6 +220,8 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run reassign: %w", err)
 	}
 
+	jc.fq.start(ctx, schedulingWorkers)
+
 	jc.s.Start()
 
 	go func() {
@@ -195,6 +252,8 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 			return fmt.Errorf("could not shutdown scheduler: %w", err)
 		}
 
+		jc.fq.close()
+
 		return nil
 	}
 
@@ -221,8 +280,8 @@ func (ec *JobsControllerImpl) handleTask(ctx context.Context, task *taskqueue.Ta
 		return ec.handleStepRunCancelled(ctx, task)
 	case "step-run-timed-out":
 		return ec.handleStepRunTimedOut(ctx, task)
-	case "ticker-removed":
-		return ec.handleTickerRemoved(ctx, task)
+	case "step-run-progress":
+		return ec.handleStepRunProgress(ctx, task)
 	}
 
 	return fmt.Errorf("unknown task: %s", task.ID)
@@ -284,35 +343,19 @@ func (ec *JobsControllerImpl) handleJobRunQueued(ctx context.Context, task *task
 		}
 	}
 
-	// send a task to schedule the job run's timeout
-	tickers, err := ec.getValidTickers()
+	// store the job run's timeout, which is polled by the tickers
+	timeoutAt, err := jobRunTimeoutAt(jobRun)
 
 	if err != nil {
-		return err
+		return fmt.Errorf("could not get job run timeout: %w", err)
 	}
 
-	ticker := &tickers[0]
-
-	ticker, err = ec.repo.Ticker().AddJobRun(ticker.ID, jobRun)
-
-	if err != nil {
-		return fmt.Errorf("could not add job run to ticker: %w", err)
-	}
-
-	scheduleTimeoutTask, err := scheduleJobRunTimeoutTask(ticker, jobRun)
-
-	if err != nil {
-		return fmt.Errorf("could not schedule job run timeout task: %w", err)
-	}
-
-	err = ec.tq.AddTask(
-		ctx,
-		taskqueue.QueueTypeFromTickerID(ticker.ID),
-		scheduleTimeoutTask,
-	)
+	_, err = ec.repo.JobRun().UpdateJobRun(metadata.TenantId, jobRun.ID, &repository.UpdateJobRunOpts{
+		TimeoutAt: &timeoutAt,
+	})
 
 	if err != nil {
-		return fmt.Errorf("could not add schedule job run timeout task to task queue: %w", err)
+		return fmt.Errorf("could not set job run timeout: %w", err)
 	}
 
 	return nil
@@ -404,21 +447,6 @@ func (ec *JobsControllerImpl) handleJobRunTimedOut(ctx context.Context, task *ta
 				return fmt.Errorf("could not add job assigned task to task queue: %w", err)
 			}
 		}
-
-		// cancel the ticker for the step run
-		stepRunTicker, ok := stepRun.Ticker()
-
-		if ok {
-			err = ec.tq.AddTask(
-				ctx,
-				taskqueue.QueueTypeFromTickerID(stepRunTicker.ID),
-				cancelStepRunTimeoutTask(stepRunTicker, stepRun),
-			)
-
-			if err != nil {
-				return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
-			}
-		}
 	}
 
 	return nil
@@ -460,6 +488,10 @@ func (ec *JobsControllerImpl) handleStepRunRetry(ctx context.Context, task *task
 	var inputBytes []byte
 	var retryCount = stepRun.RetryCount + 1
 
+	if payload.KeepRetryCount {
+		retryCount = stepRun.RetryCount
+	}
+
 	// update the input schema for the step run based on the new input
 	if payload.InputData != "" {
 		inputBytes = []byte(payload.InputData)
@@ -602,9 +634,11 @@ func (jc *JobsControllerImpl) runStepRunRequeue(ctx context.Context) func() {
 	}
 }
 
-// handleStepRunRequeue looks for any step runs that haven't been assigned that are past their requeue time
+// runStepRunRequeueTenant looks for any step runs that haven't been queued or have timed out waiting for
+// assignment and adds them to the fair queue, which schedules them alongside the step runs of other tenants. It
+// also requests an assignment pass for the tenant's step runs which are pending assignment.
 func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenantId string) error {
-	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-requeue")
+	_, span := telemetry.NewSpan(ctx, "handle-step-run-requeue")
 	defer span.End()
 
 	stepRuns, err := ec.repo.StepRun().ListStepRunsToRequeue(tenantId)
@@ -613,15 +647,13 @@ func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenan
 		return fmt.Errorf("could not list step runs: %w", err)
 	}
 
-	g := new(errgroup.Group)
+	ec.requestStepRunAssignment(tenantId)
 
 	for i := range stepRuns {
 		stepRunCp := stepRuns[i]
 
 		// wrap in func to get defer on the span to avoid leaking spans
-		g.Go(func() error {
-			var innerStepRun *db.StepRunModel
-
+		ec.fq.enqueue(tenantId, sqlchelpers.UUIDToStr(stepRunCp.ID), func(ctx context.Context) error {
 			ctx, span := telemetry.NewSpan(ctx, "handle-step-run-requeue-step-run")
 			defer span.End()
 
@@ -639,9 +671,7 @@ func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenan
 			isTimedOut := !scheduleTimeoutAt.IsZero() && scheduleTimeoutAt.Before(now)
 
 			if isTimedOut {
-				var updateInfo *repository.StepRunUpdateInfo
-
-				innerStepRun, updateInfo, err = ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
+				innerStepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
 					CancelledAt:     &now,
 					CancelledReason: repository.StringPtr("SCHEDULING_TIMED_OUT"),
 					Status:          repository.StepRunStatusPtr(db.StepRunStatusCancelled),
@@ -656,21 +686,15 @@ func (ec *JobsControllerImpl) runStepRunRequeueTenant(ctx context.Context, tenan
 				return nil
 			}
 
-			requeueAfter := time.Now().UTC().Add(time.Second * 5)
-
-			innerStepRun, _, err := ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
-				RequeueAfter: &requeueAfter,
-			})
-
-			if err != nil {
-				return fmt.Errorf("could not update step run %s: %w", stepRunId, err)
+			if stepRunCp.Status != dbsqlc.StepRunStatusPENDING {
+				return nil
 			}
 
-			return ec.scheduleStepRun(ctx, tenantId, innerStepRun.StepID, innerStepRun.ID)
+			return ec.queueStepRun(ctx, tenantId, sqlchelpers.UUIDToStr(stepRunCp.StepId), stepRunId)
 		})
 	}
 
-	return g.Wait()
+	return nil
 }
 
 func (jc *JobsControllerImpl) runStepRunReassign(ctx context.Context) func() {
@@ -706,7 +730,7 @@ func (jc *JobsControllerImpl) runStepRunReassign(ctx context.Context) func() {
 // runStepRunReassignTenant looks for step runs that have been assigned to a worker but have not started,
 // or have been running but the worker has become inactive.
 func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tenantId string) error {
-	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-reassign")
+	_, span := telemetry.NewSpan(ctx, "handle-step-run-reassign")
 	defer span.End()
 
 	stepRuns, err := ec.repo.StepRun().ListStepRunsToReassign(tenantId)
@@ -715,16 +739,12 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 		return fmt.Errorf("could not list step runs: %w", err)
 	}
 
-	g := new(errgroup.Group)
-
 	for i := range stepRuns {
 		stepRunCp := stepRuns[i]
 
 		// wrap in func to get defer on the span to avoid leaking spans
-		g.Go(func() error {
-			var innerStepRun *db.StepRunModel
-
-			ctx, span := telemetry.NewSpan(ctx, "handle-step-run-reassign-step-run")
+		ec.fq.enqueue(tenantId, sqlchelpers.UUIDToStr(stepRunCp.ID), func(ctx context.Context) error {
+			_, span := telemetry.NewSpan(ctx, "handle-step-run-reassign-step-run")
 			defer span.End()
 
 			stepRunId := sqlchelpers.UUIDToStr(stepRunCp.ID)
@@ -734,7 +754,7 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 			requeueAfter := time.Now().UTC().Add(time.Second * 5)
 
 			// update the step to a pending assignment state
-			innerStepRun, _, err := ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
+			_, _, err := ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
 				Status:       repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment),
 				RequeueAfter: &requeueAfter,
 			})
@@ -743,11 +763,13 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 				return fmt.Errorf("could not update step run %s: %w", stepRunId, err)
 			}
 
-			return ec.scheduleStepRun(ctx, tenantId, innerStepRun.StepID, innerStepRun.ID)
+			ec.requestStepRunAssignment(tenantId)
+
+			return nil
 		})
 	}
 
-	return g.Wait()
+	return nil
 }
 
 func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId, stepRunId string) error {
@@ -851,6 +873,30 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 		}
 	}
 
+	// if the step has a concurrency key expression, evaluate it against the step run input so the step's
+	// concurrency limit can be enforced during assignment
+	if keyExpr, ok := stepRun.Step().ConcurrencyKeyExpr(); ok && keyExpr != "" {
+		inputBytes := updateStepOpts.Input
+
+		if in, ok := stepRun.Input(); inputBytes == nil && ok {
+			inputBytes = in
+		}
+
+		inputMap, err := datautils.JSONBytesToMap(inputBytes)
+
+		if err != nil {
+			return ec.a.WrapErr(fmt.Errorf("could not convert step run input to map: %w", err), errData)
+		}
+
+		concurrencyKey, err := datautils.EvalKeyExpression(keyExpr, inputMap)
+
+		if err != nil {
+			return ec.a.WrapErr(ec.failStepRun(tenantId, stepRunId, err.Error()), errData)
+		}
+
+		updateStepOpts.ConcurrencyKey = &concurrencyKey
+	}
+
 	// begin transaction and make sure step run is in a pending status
 	// if the step run is no longer is a pending status, we should return with no error
 	updateStepOpts.Status = repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment)
@@ -867,112 +913,71 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 		return ec.a.WrapErr(fmt.Errorf("could not update step run: %w", err), errData)
 	}
 
-	return ec.a.WrapErr(ec.scheduleStepRun(ctx, tenantId, stepId, stepRunId), errData)
-}
+	ec.requestStepRunAssignment(tenantId)
 
-func (ec *JobsControllerImpl) scheduleStepRun(ctx context.Context, tenantId, stepId, stepRunId string) error {
-	ctx, span := telemetry.NewSpan(ctx, "schedule-step-run")
-	defer span.End()
-
-	stepRun, err := ec.repo.StepRun().GetStepRunById(tenantId, stepRunId)
-
-	if err != nil {
-		return fmt.Errorf("could not get step run: %w", err)
-	}
-
-	servertel.WithStepRunModel(span, stepRun)
+	return nil
+}
 
-	// Assign the step run to a worker.
-	//
-	// 1. Get a list of workers that can run this step. If there are no workers available, then simply return with
-	//    no additional transactions and this step run will be requeued.
-	// 2. Pick a worker to run the step and get the dispatcher currently connected to this worker.
-	// 3. Update the step run's designated worker.
-	//
-	// After creating the worker, send a task to the taskqueue, which will be picked up by the dispatcher.
-	after := time.Now().UTC().Add(-6 * time.Second)
-
-	workers, err := ec.repo.Worker().ListWorkers(tenantId, &repository.ListWorkersOpts{
-		Action:             &stepRun.Step().ActionID,
-		LastHeartbeatAfter: &after,
-		Assignable:         repository.BoolPtr(true),
+// requestStepRunAssignment requests an assignment pass for the tenant's step runs which are pending assignment.
+// Requests are coalesced, so at most one pass per tenant is queued at a time, and a request which arrives while a
+// pass is running triggers another pass once it finishes.
+func (ec *JobsControllerImpl) requestStepRunAssignment(tenantId string) {
+	ec.fq.enqueueOrRerun(tenantId, "assign-step-runs", func(ctx context.Context) error {
+		return ec.assignStepRuns(ctx, tenantId)
 	})
+}
 
-	if err != nil {
-		return fmt.Errorf("could not list workers for step: %w", err)
-	}
-
-	if len(workers) == 0 {
-		ec.l.Info().Msgf("no workers available for step %s; requeuing", stepId)
-		return nil
-	}
-
-	// pick the worker with the least jobs currently assigned (this heuristic can and should change)
-	selectedWorker := workers[0]
-
-	for _, worker := range workers {
-		if worker.RunningStepRuns < selectedWorker.RunningStepRuns {
-			selectedWorker = worker
-		}
-	}
-
-	selectedWorkerId := sqlchelpers.UUIDToStr(selectedWorker.Worker.ID)
-
-	telemetry.WithAttributes(span, servertel.WorkerId(selectedWorkerId))
-
-	// update the job run's designated worker
-	err = ec.repo.Worker().AddStepRun(tenantId, selectedWorkerId, stepRunId)
-
-	if err != nil {
-		return fmt.Errorf("could not add step run to worker: %w", err)
-	}
+// assignStepRuns assigns a batch of the tenant's pending step runs to workers with free slots. The assigned step
+// runs are sent to the dispatchers with a single task per dispatcher. Their timeouts are stored with the assignment
+// and polled by the tickers.
+func (ec *JobsControllerImpl) assignStepRuns(ctx context.Context, tenantId string) error {
+	ctx, span := telemetry.NewSpan(ctx, "assign-step-runs")
+	defer span.End()
 
-	// pick a ticker to use for timeout
-	tickers, err := ec.getValidTickers()
+	res, err := ec.repo.StepRun().AssignStepRuns(tenantId, &repository.AssignStepRunsOpts{
+		BatchSize:          assignStepRunsBatchSize,
+		LastHeartbeatAfter: time.Now().UTC().Add(-6 * time.Second),
+		SelectWorker:       ec.ws.selectWorkerForTenant(tenantId),
+	})
 
 	if err != nil {
-		return err
+		return fmt.Errorf("could not assign step runs: %w", err)
 	}
 
-	ticker := &tickers[0]
-
-	ticker, err = ec.repo.Ticker().AddStepRun(ticker.ID, stepRunId)
-
-	if err != nil {
-		return fmt.Errorf("could not add step run to ticker: %w", err)
+	if res.Claimed > len(res.Assignments) {
+		ec.l.Debug().Msgf("%d step runs could not be assigned for tenant %s; requeuing", res.Claimed-len(res.Assignments), tenantId)
 	}
 
-	scheduleTimeoutTask, err := scheduleStepRunTimeoutTask(ticker, stepRun)
-
-	if err != nil {
-		return fmt.Errorf("could not schedule step run timeout task: %w", err)
+	// if a whole batch was claimed there may be more step runs waiting, so request another pass. step runs which were
+	// claimed but not assigned, because the free worker slots or their concurrency key's slots ran out during the
+	// pass, are not claimed again until a slot frees up, so the next pass moves on to newer step runs. this goes to the back of the tenant's queue so other
+	// tenants are scheduled in between.
+	if res.Claimed == assignStepRunsBatchSize && len(res.Assignments) > 0 {
+		defer ec.requestStepRunAssignment(tenantId)
 	}
 
-	dispatcherId := sqlchelpers.UUIDToStr(selectedWorker.Worker.DispatcherId)
+	var result *multierror.Error
 
-	// send a task to the dispatcher
-	err = ec.tq.AddTask(
-		ctx,
-		taskqueue.QueueTypeFromDispatcherID(dispatcherId),
-		stepRunAssignedTask(tenantId, stepRunId, selectedWorkerId, dispatcherId),
-	)
+	assignmentsByDispatcher := map[string][]*repository.StepRunAssignment{}
 
-	if err != nil {
-		return fmt.Errorf("could not add job assigned task to task queue: %w", err)
+	for _, assignment := range res.Assignments {
+		assignmentsByDispatcher[assignment.DispatcherId] = append(assignmentsByDispatcher[assignment.DispatcherId], assignment)
 	}
 
-	// send a task to the ticker
-	err = ec.tq.AddTask(
-		ctx,
-		taskqueue.QueueTypeFromTickerID(ticker.ID),
-		scheduleTimeoutTask,
-	)
+	// send a task to each dispatcher
+	for dispatcherId, assignments := range assignmentsByDispatcher {
+		err = ec.tq.AddTask(
+			ctx,
+			taskqueue.QueueTypeFromDispatcherID(dispatcherId),
+			stepRunsAssignedTask(tenantId, dispatcherId, assignments),
+		)
 
-	if err != nil {
-		return fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("could not add step runs assigned task to task queue: %w", err))
+		}
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
 
 func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *taskqueue.Task) error {
@@ -1012,6 +1017,11 @@ func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *ta
 
 	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
 
+	// step runs which already finished are not started again
+	if stepRun.Status == db.StepRunStatusRunning {
+		ec.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeStepRun, stepRun.ID, runevents.EventTypeStarted, "")
+	}
+
 	return nil
 }
 
@@ -1057,14 +1067,24 @@ func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *t
 		FinishedAt: &finishedAt,
 		Status:     repository.StepRunStatusPtr(db.StepRunStatusSucceeded),
 		Output:     stepOutput,
+		Attempt:    stepRunAttempt(payload.WorkerId, payload.RetryCount),
 	})
 
+	// duplicate completions, and completions of an attempt which was retried or reassigned, are dropped so the
+	// next step runs are only queued once
+	if errors.Is(err, repository.ErrStepRunAttemptIsStale) {
+		ec.l.Warn().Msgf("dropping stale completion of step run %s from worker %s", payload.StepRunId, payload.WorkerId)
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
 	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
 
+	ec.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeStepRun, stepRun.ID, runevents.EventTypeCompleted, string(stepOutput))
+
 	servertel.WithStepRunModel(span, stepRun)
 
 	jobRun, err := ec.repo.JobRun().GetJobRunById(metadata.TenantId, stepRun.JobRunID)
@@ -1090,21 +1110,6 @@ func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *t
 		}
 	}
 
-	// cancel the timeout task
-	stepRunTicker, ok := stepRun.Ticker()
-
-	if ok {
-		err = ec.tq.AddTask(
-			ctx,
-			taskqueue.QueueTypeFromTickerID(stepRunTicker.ID),
-			cancelStepRunTimeoutTask(stepRunTicker, stepRun),
-		)
-
-		if err != nil {
-			return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
-		}
-	}
-
 	return nil
 }
 
@@ -1139,8 +1144,12 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *tas
 		return fmt.Errorf("could not get step run: %w", err)
 	}
 
-	// determine if step run should be retried or not
-	shouldRetry := stepRun.RetryCount < stepRun.Step().Retries
+	// determine if step run should be retried or not. step runs which failed because their worker shut down are
+	// retried without counting against the step's retries, up to a limit, so a step run which is cancelled by
+	// every worker it runs on eventually fails.
+	workerShutdown := payload.WorkerShutdown && stepRun.ShutdownRequeueCount < defaults.MaxShutdownRequeues
+
+	shouldRetry := workerShutdown || stepRun.RetryCount < stepRun.Step().Retries
 
 	status := db.StepRunStatusFailed
 
@@ -1148,11 +1157,26 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *tas
 		status = db.StepRunStatusPending
 	}
 
-	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(metadata.TenantId, payload.StepRunId, &repository.UpdateStepRunOpts{
+	updateOpts := &repository.UpdateStepRunOpts{
 		FinishedAt: &failedAt,
 		Error:      &payload.Error,
 		Status:     repository.StepRunStatusPtr(status),
-	})
+		Attempt:    stepRunAttempt(payload.WorkerId, payload.RetryCount),
+	}
+
+	if workerShutdown {
+		shutdownRequeueCount := stepRun.ShutdownRequeueCount + 1
+		updateOpts.ShutdownRequeueCount = &shutdownRequeueCount
+	}
+
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(metadata.TenantId, payload.StepRunId, updateOpts)
+
+	// duplicate failures, and failures of an attempt which was retried or reassigned, are dropped so the step
+	// run is only retried once
+	if errors.Is(err, repository.ErrStepRunAttemptIsStale) {
+		ec.l.Warn().Msgf("dropping stale failure of step run %s from worker %s", payload.StepRunId, payload.WorkerId)
+		return nil
+	}
 
 	if err != nil {
 		return fmt.Errorf("could not update step run: %w", err)
@@ -1160,35 +1184,88 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *tas
 
 	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
 
-	servertel.WithStepRunModel(span, stepRun)
+	ec.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeStepRun, stepRun.ID, runevents.EventTypeFailed, payload.Error)
 
-	// cancel the ticker for the step run
-	stepRunTicker, ok := stepRun.Ticker()
+	servertel.WithStepRunModel(span, stepRun)
 
-	if ok {
-		err = ec.tq.AddTask(
-			ctx,
-			taskqueue.QueueTypeFromTickerID(stepRunTicker.ID),
-			cancelStepRunTimeoutTask(stepRunTicker, stepRun),
-		)
+	if shouldRetry {
+		retryTask := tasktypes.StepRunRetryToTask(stepRun, nil)
 
-		if err != nil {
-			return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
+		if workerShutdown {
+			retryTask = tasktypes.StepRunRequeueToTask(stepRun)
 		}
-	}
 
-	if shouldRetry {
 		// send a task to the taskqueue
 		return ec.tq.AddTask(
 			ctx,
 			taskqueue.JOB_PROCESSING_QUEUE,
-			tasktypes.StepRunRetryToTask(stepRun, nil),
+			retryTask,
 		)
 	}
 
 	return nil
 }
 
+// stepRunAttempt returns the attempt which a step run event belongs to, or nil if the event was sent without a
+// worker.
+func stepRunAttempt(workerId string, retryCount *int) *repository.StepRunAttempt {
+	if workerId == "" {
+		return nil
+	}
+
+	return &repository.StepRunAttempt{
+		WorkerId:   workerId,
+		RetryCount: retryCount,
+	}
+}
+
+func (ec *JobsControllerImpl) handleStepRunProgress(ctx context.Context, task *taskqueue.Task) error {
+	_, span := telemetry.NewSpan(ctx, "handle-step-run-progress")
+	defer span.End()
+
+	payload := tasktypes.StepRunProgressTaskPayload{}
+	metadata := tasktypes.StepRunProgressTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run progress task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run progress task metadata: %w", err)
+	}
+
+	_, err = ec.repo.StepRun().UpdateStepRunProgress(metadata.TenantId, payload.StepRunId, &repository.UpdateStepRunProgressOpts{
+		Progress: payload.Progress,
+		Message:  payload.Message,
+	})
+
+	// progress which arrives after the step run has finished is dropped
+	if errors.Is(err, repository.ErrStepRunIsNotRunning) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not update step run progress: %w", err)
+	}
+
+	progressBytes, err := json.Marshal(map[string]interface{}{
+		"progress": payload.Progress,
+		"message":  payload.Message,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not marshal step run progress: %w", err)
+	}
+
+	ec.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeStepRun, payload.StepRunId, runevents.EventTypeProgress, string(progressBytes))
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) handleStepRunTimedOut(ctx context.Context, task *taskqueue.Task) error {
 	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-timed-out")
 	defer span.End()
@@ -1252,6 +1329,17 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 
 	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
 
+	// step runs which already finished are not cancelled
+	if stepRun.Status == db.StepRunStatusCancelled {
+		eventType := runevents.EventTypeCancelled
+
+		if reason == "TIMED_OUT" {
+			eventType = runevents.EventTypeTimedOut
+		}
+
+		ec.events.Record(ctx, tenantId, runevents.ResourceTypeStepRun, stepRun.ID, eventType, "")
+	}
+
 	servertel.WithStepRunModel(span, stepRun)
 
 	workerId, ok := stepRun.WorkerID()
@@ -1284,6 +1372,29 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 	return nil
 }
 
+// failStepRun marks a step run which could not be queued as failed, without retrying it.
+func (ec *JobsControllerImpl) failStepRun(tenantId, stepRunId, reason string) error {
+	now := time.Now().UTC()
+
+	stepRun, updateInfo, err := ec.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
+		FinishedAt: &now,
+		Error:      &reason,
+		Status:     repository.StepRunStatusPtr(db.StepRunStatusFailed),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	defer ec.handleStepRunUpdateInfo(stepRun, updateInfo)
+
+	if stepRun.Status == db.StepRunStatusFailed {
+		ec.events.Record(context.Background(), tenantId, runevents.ResourceTypeStepRun, stepRun.ID, runevents.EventTypeFailed, reason)
+	}
+
+	return nil
+}
+
 func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *db.StepRunModel, updateInfo *repository.StepRunUpdateInfo) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -1312,191 +1423,35 @@ func (ec *JobsControllerImpl) handleStepRunUpdateInfo(stepRun *db.StepRunModel,
 	}
 }
 
-func (ec *JobsControllerImpl) handleTickerRemoved(ctx context.Context, task *taskqueue.Task) error {
-	ctx, span := telemetry.NewSpan(ctx, "handle-ticker-removed")
-	defer span.End()
-
-	payload := tasktypes.RemoveTickerTaskPayload{}
-	metadata := tasktypes.RemoveTickerTaskMetadata{}
-
-	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
-
-	if err != nil {
-		return fmt.Errorf("could not decode ticker removed task payload: %w", err)
-	}
-
-	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
-
-	if err != nil {
-		return fmt.Errorf("could not decode ticker removed task metadata: %w", err)
-	}
-
-	ec.l.Debug().Msgf("handling ticker removed for ticker %s", payload.TickerId)
-
-	// reassign all step runs to a different ticker
-	tickers, err := ec.getValidTickers()
-
-	if err != nil {
-		return err
-	}
-
-	// reassign all step runs randomly to tickers
-	numTickers := len(tickers)
-
-	// get all step runs assigned to the ticker
-	stepRuns, err := ec.repo.StepRun().ListAllStepRuns(&repository.ListAllStepRunsOpts{
-		TickerId: repository.StringPtr(payload.TickerId),
-		Status:   repository.StepRunStatusPtr(db.StepRunStatusRunning),
-	})
-
-	if err != nil {
-		return fmt.Errorf("could not list step runs: %w", err)
-	}
-
-	for i, stepRun := range stepRuns {
-		stepRunCp := stepRun
-		ticker := tickers[i%numTickers]
-
-		_, err = ec.repo.Ticker().AddStepRun(ticker.ID, stepRun.ID)
-
-		if err != nil {
-			return fmt.Errorf("could not update step run: %w", err)
-		}
-
-		scheduleTimeoutTask, err := scheduleStepRunTimeoutTask(&ticker, &stepRunCp)
-
-		if err != nil {
-			return fmt.Errorf("could not schedule step run timeout task: %w", err)
-		}
-
-		// send a task to the ticker
-		err = ec.tq.AddTask(
-			ctx,
-			taskqueue.QueueTypeFromTickerID(ticker.ID),
-			scheduleTimeoutTask,
-		)
-
-		if err != nil {
-			return fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
-		}
-	}
-
-	// get all job runs assigned to the ticker
-	jobRuns, err := ec.repo.JobRun().ListAllJobRuns(&repository.ListAllJobRunsOpts{
-		TickerId: repository.StringPtr(payload.TickerId),
-		Status:   repository.JobRunStatusPtr(db.JobRunStatusRunning),
-	})
-
-	if err != nil {
-		return fmt.Errorf("could not list step runs: %w", err)
-	}
-
-	for i, jobRun := range jobRuns {
-		jobRunCp := jobRun
-		ticker := tickers[i%numTickers]
-
-		_, err = ec.repo.Ticker().AddJobRun(ticker.ID, &jobRunCp)
-
-		if err != nil {
-			return fmt.Errorf("could not update step run: %w", err)
-		}
-
-		scheduleTimeoutTask, err := scheduleJobRunTimeoutTask(&ticker, &jobRunCp)
-
-		if err != nil {
-			return fmt.Errorf("could not schedule step run timeout task: %w", err)
-		}
-
-		// send a task to the ticker
-		err = ec.tq.AddTask(
-			ctx,
-			taskqueue.QueueTypeFromTickerID(ticker.ID),
-			scheduleTimeoutTask,
-		)
-
-		if err != nil {
-			return fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
-		}
-	}
-
-	return nil
-}
-
-func (ec *JobsControllerImpl) getValidTickers() ([]db.TickerModel, error) {
-	within := time.Now().UTC().Add(-6 * time.Second)
-
-	tickers, err := ec.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-		LatestHeartbeatAt: &within,
-		Active:            repository.BoolPtr(true),
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("could not list tickers: %w", err)
+func stepRunsAssignedTask(tenantId, dispatcherId string, assignments []*repository.StepRunAssignment) *taskqueue.Task {
+	payload := tasktypes.StepRunsAssignedTaskPayload{
+		Assignments: make([]tasktypes.StepRunAssignedTaskPayload, 0, len(assignments)),
 	}
 
-	if len(tickers) == 0 {
-		return nil, fmt.Errorf("no tickers available")
+	for _, assignment := range assignments {
+		payload.Assignments = append(payload.Assignments, tasktypes.StepRunAssignedTaskPayload{
+			StepRunId: assignment.StepRunId,
+			WorkerId:  assignment.WorkerId,
+		})
 	}
 
-	return tickers, nil
-}
+	payloadMap, _ := datautils.ToJSONMap(payload)
 
-func stepRunAssignedTask(tenantId, stepRunId, workerId, dispatcherId string) *taskqueue.Task {
-	payload, _ := datautils.ToJSONMap(tasktypes.StepRunAssignedTaskPayload{
-		StepRunId: stepRunId,
-		WorkerId:  workerId,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunAssignedTaskMetadata{
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunsAssignedTaskMetadata{
 		TenantId:     tenantId,
 		DispatcherId: dispatcherId,
 	})
 
 	return &taskqueue.Task{
-		ID:       "step-run-assigned",
-		Payload:  payload,
+		ID:       "step-runs-assigned",
+		Payload:  payloadMap,
 		Metadata: metadata,
 	}
 }
 
-func scheduleStepRunTimeoutTask(ticker *db.TickerModel, stepRun *db.StepRunModel) (*taskqueue.Task, error) {
-	var durationStr string
-
-	if timeout, ok := stepRun.Step().Timeout(); ok {
-		durationStr = timeout
-	}
-
-	if durationStr == "" {
-		durationStr = defaults.DefaultStepRunTimeout
-	}
-
-	// get a duration
-	duration, err := time.ParseDuration(durationStr)
-
-	if err != nil {
-		return nil, fmt.Errorf("could not parse duration: %w", err)
-	}
-
-	timeoutAt := time.Now().UTC().Add(duration)
-
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleStepRunTimeoutTaskPayload{
-		StepRunId: stepRun.ID,
-		JobRunId:  stepRun.JobRunID,
-		TimeoutAt: timeoutAt.Format(time.RFC3339),
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleStepRunTimeoutTaskMetadata{
-		TenantId: stepRun.TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "schedule-step-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
-}
-
-func scheduleJobRunTimeoutTask(ticker *db.TickerModel, jobRun *db.JobRunModel) (*taskqueue.Task, error) {
+// jobRunTimeoutAt returns when the job run times out, which is the job's timeout from now or the default job run
+// timeout if the job does not set one.
+func jobRunTimeoutAt(jobRun *db.JobRunModel) (time.Time, error) {
 	var durationStr string
 
 	if timeout, ok := jobRun.Job().Timeout(); ok {
@@ -1511,41 +1466,10 @@ func scheduleJobRunTimeoutTask(ticker *db.TickerModel, jobRun *db.JobRunModel) (
 	duration, err := time.ParseDuration(durationStr)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not parse duration: %w", err)
+		return time.Time{}, fmt.Errorf("could not parse duration: %w", err)
 	}
 
-	timeoutAt := time.Now().UTC().Add(duration)
-
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleJobRunTimeoutTaskPayload{
-		JobRunId:  jobRun.ID,
-		TimeoutAt: timeoutAt.Format(time.RFC3339),
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleJobRunTimeoutTaskMetadata{
-		TenantId: jobRun.TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "schedule-job-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
-}
-
-func cancelStepRunTimeoutTask(ticker *db.TickerModel, stepRun *db.StepRunModel) *taskqueue.Task {
-	payload, _ := datautils.ToJSONMap(tasktypes.CancelStepRunTimeoutTaskPayload{
-		StepRunId: stepRun.ID,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.CancelStepRunTimeoutTaskMetadata{
-		TenantId: stepRun.TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "cancel-step-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-	}
+	return time.Now().UTC().Add(duration), nil
 }
 
 func stepRunCancelledTask(tenantId, stepRunId, workerId, dispatcherId, cancelledReason string) *taskqueue.Task {
@@ -0,0 +1,261 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// fairQueue distributes scheduling work across tenants. Each tenant has its own FIFO queue, and a fixed pool of
+// workers pulls items from the tenants in round robin order, so a tenant with a large backlog only ever gets one
+// slot per round and cannot delay scheduling for other tenants.
+type fairQueue struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	tenants map[string]*tenantQueue
+
+	// the round robin order of tenants which have pending items
+	order []string
+	pos   int
+
+	// the maximum number of pending and in-flight items per tenant
+	maxPerTenant int
+
+	// called when an item is picked up by a worker, with the time it spent in the queue
+	onDequeue func(tenantId string, wait time.Duration)
+
+	// called when an item returns an error
+	onError func(tenantId string, err error)
+
+	closed bool
+	wg     sync.WaitGroup
+}
+
+type tenantQueue struct {
+	items []*fairQueueItem
+
+	// keys of pending and in-flight items, used for deduplication
+	keys map[string]struct{}
+
+	// keys of in-flight items
+	inFlight map[string]struct{}
+
+	// in-flight items which should be queued again once they finish
+	rerun map[string]*fairQueueItem
+}
+
+type fairQueueItem struct {
+	tenantId   string
+	key        string
+	enqueuedAt time.Time
+	fn         func(ctx context.Context) error
+}
+
+func newFairQueue(maxPerTenant int, onDequeue func(tenantId string, wait time.Duration), onError func(tenantId string, err error)) *fairQueue {
+	q := &fairQueue{
+		tenants:      map[string]*tenantQueue{},
+		maxPerTenant: maxPerTenant,
+		onDequeue:    onDequeue,
+		onError:      onError,
+	}
+
+	q.cond = sync.NewCond(&q.mu)
+
+	return q
+}
+
+// enqueue adds an item to the tenant's queue. It returns false if an item with the same key is already pending or
+// in-flight for the tenant, or if the tenant's queue is full.
+func (q *fairQueue) enqueue(tenantId, key string, fn func(ctx context.Context) error) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	tq := q.getTenantQueue(tenantId)
+
+	if _, exists := tq.keys[key]; exists {
+		return false
+	}
+
+	if q.maxPerTenant > 0 && len(tq.keys) >= q.maxPerTenant {
+		return false
+	}
+
+	q.push(tq, &fairQueueItem{
+		tenantId:   tenantId,
+		key:        key,
+		enqueuedAt: time.Now(),
+		fn:         fn,
+	})
+
+	return true
+}
+
+// enqueueOrRerun is like enqueue, except that if an item with the same key is in-flight, the item is queued again
+// once the in-flight item finishes. This is used for work which should be coalesced but must not miss a request
+// which arrives while it is running.
+func (q *fairQueue) enqueueOrRerun(tenantId, key string, fn func(ctx context.Context) error) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	tq := q.getTenantQueue(tenantId)
+
+	item := &fairQueueItem{
+		tenantId: tenantId,
+		key:      key,
+		fn:       fn,
+	}
+
+	if _, inFlight := tq.inFlight[key]; inFlight {
+		tq.rerun[key] = item
+		return true
+	}
+
+	if _, exists := tq.keys[key]; exists {
+		return false
+	}
+
+	item.enqueuedAt = time.Now()
+
+	q.push(tq, item)
+
+	return true
+}
+
+func (q *fairQueue) getTenantQueue(tenantId string) *tenantQueue {
+	tq, ok := q.tenants[tenantId]
+
+	if !ok {
+		tq = &tenantQueue{
+			keys:     map[string]struct{}{},
+			inFlight: map[string]struct{}{},
+			rerun:    map[string]*fairQueueItem{},
+		}
+
+		q.tenants[tenantId] = tq
+	}
+
+	return tq
+}
+
+func (q *fairQueue) push(tq *tenantQueue, item *fairQueueItem) {
+	tq.keys[item.key] = struct{}{}
+
+	if len(tq.items) == 0 {
+		q.order = append(q.order, item.tenantId)
+	}
+
+	tq.items = append(tq.items, item)
+
+	q.cond.Signal()
+}
+
+// start starts the given number of workers. Workers exit when the queue is closed.
+func (q *fairQueue) start(ctx context.Context, workers int) {
+	for i := 0; i < workers; i++ {
+		q.wg.Add(1)
+
+		go func() {
+			defer q.wg.Done()
+
+			for {
+				item, ok := q.pop()
+
+				if !ok {
+					return
+				}
+
+				if q.onDequeue != nil {
+					q.onDequeue(item.tenantId, time.Since(item.enqueuedAt))
+				}
+
+				if err := item.fn(ctx); err != nil && q.onError != nil {
+					q.onError(item.tenantId, err)
+				}
+
+				q.done(item)
+			}
+		}()
+	}
+}
+
+// close stops accepting new items, drops any pending items and waits for in-flight items to finish.
+func (q *fairQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+// pop blocks until an item is available and returns the next item in round robin order across tenants.
+func (q *fairQueue) pop() (*fairQueueItem, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.order) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+
+	if q.closed {
+		return nil, false
+	}
+
+	if q.pos >= len(q.order) {
+		q.pos = 0
+	}
+
+	tenantId := q.order[q.pos]
+	tq := q.tenants[tenantId]
+
+	item := tq.items[0]
+	tq.items[0] = nil
+	tq.items = tq.items[1:]
+
+	tq.inFlight[item.key] = struct{}{}
+
+	if len(tq.items) == 0 {
+		// remove the tenant from the round robin order, the next tenant moves into the current position
+		q.order = append(q.order[:q.pos], q.order[q.pos+1:]...)
+	} else {
+		q.pos++
+	}
+
+	return item, true
+}
+
+func (q *fairQueue) done(item *fairQueueItem) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tq, ok := q.tenants[item.tenantId]
+
+	if !ok {
+		return
+	}
+
+	delete(tq.keys, item.key)
+	delete(tq.inFlight, item.key)
+
+	if rerun, ok := tq.rerun[item.key]; ok && !q.closed {
+		delete(tq.rerun, item.key)
+
+		rerun.enqueuedAt = time.Now()
+		q.push(tq, rerun)
+
+		return
+	}
+
+	if len(tq.keys) == 0 && len(tq.items) == 0 {
+		delete(q.tenants, item.tenantId)
+	}
+}
@@ -0,0 +1,171 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFairQueueNoisyTenantDoesNotStarve(t *testing.T) {
+	var mu sync.Mutex
+	processed := []string{}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	q := newFairQueue(0, nil, nil)
+
+	record := func(tenantId string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			processed = append(processed, tenantId)
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	// block the single worker so both tenants are enqueued before any scheduling happens
+	q.enqueue("noisy", "blocker", func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	})
+
+	q.start(context.Background(), 1)
+	defer q.close()
+
+	<-started
+
+	for i := 0; i < 10000; i++ {
+		q.enqueue("noisy", fmt.Sprintf("noisy-%d", i), record("noisy"))
+	}
+
+	for i := 0; i < 10; i++ {
+		q.enqueue("quiet", fmt.Sprintf("quiet-%d", i), record("quiet"))
+	}
+
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(processed) == 10010
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// the quiet tenant alternates with the noisy tenant, so all of its items are processed within the first 20
+	quietSeen := 0
+
+	for _, tenantId := range processed[:20] {
+		if tenantId == "quiet" {
+			quietSeen++
+		}
+	}
+
+	assert.Equal(t, 10, quietSeen)
+}
+
+func TestFairQueueDeduplicatesAndBoundsTenants(t *testing.T) {
+	q := newFairQueue(2, nil, nil)
+
+	noop := func(ctx context.Context) error { return nil }
+
+	assert.True(t, q.enqueue("tenant", "a", noop))
+	assert.False(t, q.enqueue("tenant", "a", noop), "duplicate keys should be rejected")
+	assert.True(t, q.enqueue("tenant", "b", noop))
+	assert.False(t, q.enqueue("tenant", "c", noop), "tenant queue should be full")
+	assert.True(t, q.enqueue("other", "c", noop), "other tenants should not be affected by a full queue")
+
+	q.close()
+
+	assert.False(t, q.enqueue("tenant", "d", noop), "closed queue should reject items")
+}
+
+func TestFairQueueRecordsWaitTime(t *testing.T) {
+	var mu sync.Mutex
+	waits := map[string]time.Duration{}
+
+	q := newFairQueue(0, func(tenantId string, wait time.Duration) {
+		mu.Lock()
+		waits[tenantId] = wait
+		mu.Unlock()
+	}, nil)
+
+	done := make(chan struct{})
+
+	q.enqueue("tenant", "a", func(ctx context.Context) error {
+		close(done)
+		return nil
+	})
+
+	time.Sleep(10 * time.Millisecond)
+
+	q.start(context.Background(), 1)
+	defer q.close()
+
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.GreaterOrEqual(t, waits["tenant"], 10*time.Millisecond)
+}
+
+func TestFairQueueRerunsInFlightItem(t *testing.T) {
+	var mu sync.Mutex
+	runs := 0
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	q := newFairQueue(0, nil, nil)
+
+	fn := func(ctx context.Context) error {
+		mu.Lock()
+		runs++
+		first := runs == 1
+		mu.Unlock()
+
+		if first {
+			close(started)
+			<-release
+		}
+
+		return nil
+	}
+
+	assert.True(t, q.enqueueOrRerun("tenant", "assign", fn))
+	assert.False(t, q.enqueueOrRerun("tenant", "assign", fn), "pending items should be coalesced")
+
+	q.start(context.Background(), 1)
+	defer q.close()
+
+	<-started
+
+	// requests which arrive while the item is in-flight are coalesced into a single rerun
+	assert.True(t, q.enqueueOrRerun("tenant", "assign", fn))
+	assert.True(t, q.enqueueOrRerun("tenant", "assign", fn))
+
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return runs == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 2, runs)
+}
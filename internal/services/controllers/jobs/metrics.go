@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type schedulingMetrics struct {
+	queueWait metric.Float64Histogram
+}
+
+func newSchedulingMetrics() (*schedulingMetrics, error) {
+	meter := otel.Meter("github.com/hatchet-dev/hatchet/internal/services/controllers/jobs")
+
+	queueWait, err := meter.Float64Histogram(
+		"hatchet.step_run.scheduling_queue_wait",
+		metric.WithDescription("The time a step run waits in the tenant scheduling queue before it is scheduled."),
+		metric.WithUnit("s"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedulingMetrics{
+		queueWait: queueWait,
+	}, nil
+}
+
+func (m *schedulingMetrics) recordQueueWait(ctx context.Context, tenantId string, wait time.Duration) {
+	m.queueWait.Record(ctx, wait.Seconds(), metric.WithAttributes(attribute.String("tenantId", tenantId)))
+}
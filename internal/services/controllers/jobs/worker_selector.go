@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+// WorkerSelector picks the worker to assign a step run to.
+type WorkerSelector interface {
+	// SelectWorker returns the index of the selected candidate. The candidates are never empty and are always
+	// ordered by worker id.
+	SelectWorker(candidates []*repository.WorkerCandidate) int
+}
+
+// leastLoadedSelector picks the worker with the most free slots, which spreads step runs across workers by their
+// capacity. Workers without a max runs have unlimited slots, so they are picked before workers with a max runs, and
+// the one with the fewest active step runs is picked among them.
+type leastLoadedSelector struct{}
+
+func (leastLoadedSelector) SelectWorker(candidates []*repository.WorkerCandidate) int {
+	selected := 0
+
+	for i, candidate := range candidates {
+		if freeSlots(candidate) > freeSlots(candidates[selected]) {
+			selected = i
+		}
+	}
+
+	return selected
+}
+
+// freeSlots returns the number of step runs which can still be assigned to the candidate.
+func freeSlots(candidate *repository.WorkerCandidate) int {
+	if candidate.MaxRuns == nil {
+		return math.MaxInt - candidate.ActiveStepRuns
+	}
+
+	return *candidate.MaxRuns - candidate.ActiveStepRuns
+}
+
+// roundRobinSelector picks each worker in turn.
+type roundRobinSelector struct {
+	mu   sync.Mutex
+	next int
+}
+
+func (s *roundRobinSelector) SelectWorker(candidates []*repository.WorkerCandidate) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	selected := s.next % len(candidates)
+	s.next = selected + 1
+
+	return selected
+}
+
+// randomSelector picks a random worker.
+type randomSelector struct{}
+
+func (randomSelector) SelectWorker(candidates []*repository.WorkerCandidate) int {
+	return rand.Intn(len(candidates)) // nolint: gosec
+}
+
+// mostRecentHeartbeatSelector picks the worker which sent a heartbeat most recently.
+type mostRecentHeartbeatSelector struct{}
+
+func (mostRecentHeartbeatSelector) SelectWorker(candidates []*repository.WorkerCandidate) int {
+	selected := 0
+
+	for i, candidate := range candidates {
+		if candidate.LastHeartbeatAt.After(candidates[selected].LastHeartbeatAt) {
+			selected = i
+		}
+	}
+
+	return selected
+}
+
+// workerSelectors keeps the worker selectors for each tenant. Selectors which keep state, such as round robin,
+// are kept across assignment passes so the state is shared by all of the tenant's passes.
+type workerSelectors struct {
+	roundRobin sync.Map
+}
+
+func (w *workerSelectors) forTenant(tenantId string) map[dbsqlc.WorkerSelectionStrategy]WorkerSelector {
+	roundRobin, _ := w.roundRobin.LoadOrStore(tenantId, &roundRobinSelector{})
+
+	return map[dbsqlc.WorkerSelectionStrategy]WorkerSelector{
+		dbsqlc.WorkerSelectionStrategyLEASTLOADED:         leastLoadedSelector{},
+		dbsqlc.WorkerSelectionStrategyROUNDROBIN:          roundRobin.(*roundRobinSelector),
+		dbsqlc.WorkerSelectionStrategyRANDOM:              randomSelector{},
+		dbsqlc.WorkerSelectionStrategyMOSTRECENTHEARTBEAT: mostRecentHeartbeatSelector{},
+	}
+}
+
+// selectWorkerForTenant selects workers with the tenant's selector for each step run's strategy.
+func (w *workerSelectors) selectWorkerForTenant(tenantId string) repository.SelectWorkerFunc {
+	selectors := w.forTenant(tenantId)
+
+	return func(strategy dbsqlc.WorkerSelectionStrategy, candidates []*repository.WorkerCandidate) (int, bool) {
+		selector, ok := selectors[strategy]
+
+		if !ok {
+			return 0, false
+		}
+
+		return selector.SelectWorker(candidates), true
+	}
+}
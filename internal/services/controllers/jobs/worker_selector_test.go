@@ -0,0 +1,103 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+)
+
+func newCandidates(activeStepRuns ...int) []*repository.WorkerCandidate {
+	now := time.Now()
+
+	candidates := make([]*repository.WorkerCandidate, 0, len(activeStepRuns))
+
+	for i, active := range activeStepRuns {
+		candidates = append(candidates, &repository.WorkerCandidate{
+			WorkerId:        string(rune('a' + i)),
+			ActiveStepRuns:  active,
+			LastHeartbeatAt: now.Add(-time.Duration(i) * time.Second),
+		})
+	}
+
+	return candidates
+}
+
+func TestLeastLoadedSelector(t *testing.T) {
+	assert.Equal(t, 2, leastLoadedSelector{}.SelectWorker(newCandidates(3, 2, 0, 1)))
+	assert.Equal(t, 0, leastLoadedSelector{}.SelectWorker(newCandidates(1, 1)), "ties should pick the first worker")
+}
+
+func TestLeastLoadedSelectorComparesFreeSlots(t *testing.T) {
+	maxRuns := func(n int) *int {
+		return &n
+	}
+
+	// the first worker has fewer active step runs, but the second has more free slots
+	candidates := newCandidates(2, 5)
+	candidates[0].MaxRuns = maxRuns(4)
+	candidates[1].MaxRuns = maxRuns(10)
+
+	assert.Equal(t, 1, leastLoadedSelector{}.SelectWorker(candidates))
+
+	// workers without a max runs have unlimited slots
+	candidates = newCandidates(2, 8)
+	candidates[0].MaxRuns = maxRuns(100)
+
+	assert.Equal(t, 1, leastLoadedSelector{}.SelectWorker(candidates))
+}
+
+func TestRoundRobinSelector(t *testing.T) {
+	s := &roundRobinSelector{}
+
+	selected := []int{}
+
+	for i := 0; i < 4; i++ {
+		selected = append(selected, s.SelectWorker(newCandidates(0, 0, 0)))
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 0}, selected)
+
+	// fewer candidates should not skip past the end of the list
+	assert.Equal(t, 1, s.SelectWorker(newCandidates(0, 0)))
+	assert.Equal(t, 0, s.SelectWorker(newCandidates(0, 0)))
+}
+
+func TestRandomSelector(t *testing.T) {
+	candidates := newCandidates(0, 0, 0)
+
+	for i := 0; i < 100; i++ {
+		selected := randomSelector{}.SelectWorker(candidates)
+
+		assert.GreaterOrEqual(t, selected, 0)
+		assert.Less(t, selected, len(candidates))
+	}
+}
+
+func TestMostRecentHeartbeatSelector(t *testing.T) {
+	candidates := newCandidates(0, 0, 0)
+	candidates[1].LastHeartbeatAt = time.Now().Add(time.Second)
+
+	assert.Equal(t, 1, mostRecentHeartbeatSelector{}.SelectWorker(candidates))
+}
+
+func TestWorkerSelectorsShareRoundRobinPerTenant(t *testing.T) {
+	ws := &workerSelectors{}
+
+	candidates := newCandidates(0, 0, 0)
+
+	selectRoundRobin := func(tenantId string) int {
+		selected, ok := ws.selectWorkerForTenant(tenantId)(dbsqlc.WorkerSelectionStrategyROUNDROBIN, candidates)
+
+		assert.True(t, ok)
+
+		return selected
+	}
+
+	assert.Equal(t, 0, selectRoundRobin("tenant-1"))
+	assert.Equal(t, 1, selectRoundRobin("tenant-1"))
+	assert.Equal(t, 0, selectRoundRobin("tenant-2"))
+}
@@ -7,12 +7,12 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
@@ -27,6 +27,9 @@ type WorkflowsControllerImpl struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	dv   datautils.DataDecoderValidator
+
+	// events records the events of workflow runs once they are queued or finished
+	events *runevents.Recorder
 }
 
 type WorkflowsControllerOpt func(*WorkflowsControllerOpts)
@@ -89,10 +92,11 @@ func New(fs ...WorkflowsControllerOpt) (*WorkflowsControllerImpl, error) {
 	opts.l = &newLogger
 
 	return &WorkflowsControllerImpl{
-		tq:   opts.tq,
-		l:    opts.l,
-		repo: opts.repo,
-		dv:   opts.dv,
+		tq:     opts.tq,
+		l:      opts.l,
+		repo:   opts.repo,
+		dv:     opts.dv,
+		events: runevents.NewRecorder(opts.repo.WorkflowRun(), opts.tq, opts.l),
 	}, nil
 }
 
@@ -221,49 +225,13 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyRunFinished(ctx context.Context
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	errGroup := new(errgroup.Group)
-
-	errGroup.Go(func() error {
-		workflowVersion, err := wc.repo.Workflow().GetWorkflowVersionById(metadata.TenantId, groupKeyRun.WorkflowRun().WorkflowVersionID)
-
-		if err != nil {
-			return fmt.Errorf("could not get workflow version: %w", err)
-		}
-
-		concurrency, _ := workflowVersion.Concurrency()
-
-		switch concurrency.LimitStrategy {
-		case db.ConcurrencyLimitStrategyCancelInProgress:
-			err = wc.queueByCancelInProgress(ctx, metadata.TenantId, payload.GroupKey, workflowVersion)
-		case db.ConcurrencyLimitStrategyGroupRoundRobin:
-			err = wc.queueByGroupRoundRobin(ctx, metadata.TenantId, workflowVersion)
-		default:
-			return fmt.Errorf("unimplemented concurrency limit strategy: %s", concurrency.LimitStrategy)
-		}
+	workflowVersion, err := wc.repo.Workflow().GetWorkflowVersionById(metadata.TenantId, groupKeyRun.WorkflowRun().WorkflowVersionID)
 
-		return err
-	})
-
-	// cancel the timeout task
-	errGroup.Go(func() error {
-		groupKeyRunTicker, ok := groupKeyRun.Ticker()
-
-		if ok {
-			err = wc.tq.AddTask(
-				ctx,
-				taskqueue.QueueTypeFromTickerID(groupKeyRunTicker.ID),
-				cancelGetGroupKeyRunTimeoutTask(groupKeyRunTicker, groupKeyRun),
-			)
-
-			if err != nil {
-				return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
-			}
-		}
-
-		return nil
-	})
+	if err != nil {
+		return fmt.Errorf("could not get workflow version: %w", err)
+	}
 
-	return errGroup.Wait()
+	return wc.queueByConcurrencyStrategy(ctx, metadata.TenantId, payload.GroupKey, workflowVersion)
 }
 
 func (wc *WorkflowsControllerImpl) handleGroupKeyRunFailed(ctx context.Context, task *taskqueue.Task) error {
@@ -291,7 +259,7 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyRunFailed(ctx context.Context,
 		return fmt.Errorf("could not parse started at: %w", err)
 	}
 
-	groupKeyRun, err := wc.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(metadata.TenantId, payload.GetGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
+	_, err = wc.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(metadata.TenantId, payload.GetGroupKeyRunId, &repository.UpdateGetGroupKeyRunOpts{
 		FinishedAt: &failedAt,
 		Error:      &payload.Error,
 		Status:     repository.StepRunStatusPtr(db.StepRunStatusFailed),
@@ -301,36 +269,5 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyRunFailed(ctx context.Context,
 		return fmt.Errorf("could not update step run: %w", err)
 	}
 
-	// cancel the ticker for the step run
-	getGroupKeyRunTicker, ok := groupKeyRun.Ticker()
-
-	if ok {
-		err = wc.tq.AddTask(
-			ctx,
-			taskqueue.QueueTypeFromTickerID(getGroupKeyRunTicker.ID),
-			cancelGetGroupKeyRunTimeoutTask(getGroupKeyRunTicker, groupKeyRun),
-		)
-
-		if err != nil {
-			return fmt.Errorf("could not add cancel step run timeout task to task queue: %w", err)
-		}
-	}
-
 	return nil
 }
-
-func cancelGetGroupKeyRunTimeoutTask(ticker *db.TickerModel, getGroupKeyRun *db.GetGroupKeyRunModel) *taskqueue.Task {
-	payload, _ := datautils.ToJSONMap(tasktypes.CancelGetGroupKeyRunTimeoutTaskPayload{
-		GetGroupKeyRunId: getGroupKeyRun.ID,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.CancelGetGroupKeyRunTimeoutTaskMetadata{
-		TenantId: getGroupKeyRun.TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "cancel-get-group-key-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-	}
-}
@@ -13,6 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
@@ -47,13 +48,27 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunQueued(ctx context.Context,
 
 	servertel.WithWorkflowRunModel(span, workflowRun)
 
+	wc.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeWorkflowRun, workflowRun.ID, runevents.EventTypeStarted, "")
+
 	wc.l.Info().Msgf("starting workflow run %s", workflowRun.ID)
 
 	// determine if we should start this workflow run or we need to limit its concurrency
 	// if the workflow has concurrency settings, then we need to check if we can start it
-	if _, hasConcurrency := workflowRun.WorkflowVersion().Concurrency(); hasConcurrency {
+	if concurrency, hasConcurrency := workflowRun.WorkflowVersion().Concurrency(); hasConcurrency {
 		wc.l.Info().Msgf("workflow %s has concurrency settings", workflowRun.ID)
 
+		// if the concurrency group is computed from an expression, we evaluate it here rather than sending
+		// a get group key action to a worker
+		if expr, ok := concurrency.ConcurrencyGroupExpression(); ok {
+			err = wc.queueByGroupKeyExpression(ctx, workflowRun, expr)
+
+			if err != nil {
+				return fmt.Errorf("could not queue workflow run by group key expression: %w", err)
+			}
+
+			return nil
+		}
+
 		groupKeyRun, ok := workflowRun.GetGroupKeyRun()
 
 		if !ok {
@@ -106,6 +121,14 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 
 	servertel.WithWorkflowRunModel(span, workflowRun)
 
+	eventType := runevents.EventTypeCompleted
+
+	if payload.Status == string(db.WorkflowRunStatusFailed) {
+		eventType = runevents.EventTypeFailed
+	}
+
+	wc.events.Record(ctx, metadata.TenantId, runevents.ResourceTypeWorkflowRun, workflowRun.ID, eventType, "")
+
 	wc.l.Info().Msgf("finishing workflow run %s", workflowRun.ID)
 
 	// if the workflow run has a concurrency group, then we need to queue any queued workflow runs
@@ -127,6 +150,83 @@ func (wc *WorkflowsControllerImpl) handleWorkflowRunFinished(ctx context.Context
 	return nil
 }
 
+func (wc *WorkflowsControllerImpl) queueByGroupKeyExpression(ctx context.Context, workflowRun *db.WorkflowRunModel, expr string) error {
+	ctx, span := telemetry.NewSpan(ctx, "queue-by-group-key-expression")
+	defer span.End()
+
+	tenantId := workflowRun.TenantID
+
+	opts := &repository.UpdateWorkflowRunGroupKeyOpts{}
+
+	groupKey, err := wc.evalGroupKeyExpression(workflowRun, expr)
+
+	if err != nil {
+		opts.Error = repository.StringPtr(err.Error())
+	} else {
+		opts.GroupKey = &groupKey
+	}
+
+	_, err = wc.repo.WorkflowRun().UpdateWorkflowRunGroupKey(tenantId, workflowRun.ID, opts)
+
+	if err != nil {
+		return fmt.Errorf("could not update workflow run group key: %w", err)
+	}
+
+	if opts.Error != nil {
+		wc.l.Warn().Msgf("could not compute group key for workflow run %s: %s", workflowRun.ID, *opts.Error)
+		return nil
+	}
+
+	return wc.queueByConcurrencyStrategy(ctx, tenantId, groupKey, workflowRun.WorkflowVersion())
+}
+
+// evalGroupKeyExpression evaluates a concurrency group key expression against the workflow run input, which is
+// read from the lookup data of the first job run.
+func (wc *WorkflowsControllerImpl) evalGroupKeyExpression(workflowRun *db.WorkflowRunModel, expr string) (string, error) {
+	jobRuns := workflowRun.JobRuns()
+
+	if len(jobRuns) == 0 {
+		return "", fmt.Errorf("workflow run has no job runs")
+	}
+
+	lookupData, err := wc.repo.JobRun().GetJobRunLookupData(workflowRun.TenantID, jobRuns[0].ID)
+
+	if err != nil {
+		return "", fmt.Errorf("could not get job run lookup data: %w", err)
+	}
+
+	lookupDataBytes, ok := lookupData.Data()
+
+	if !ok {
+		return "", fmt.Errorf("job run lookup data is empty")
+	}
+
+	data, err := datautils.JSONBytesToMap(lookupDataBytes)
+
+	if err != nil {
+		return "", fmt.Errorf("could not decode job run lookup data: %w", err)
+	}
+
+	return datautils.EvalKeyExpression(expr, data)
+}
+
+func (wc *WorkflowsControllerImpl) queueByConcurrencyStrategy(ctx context.Context, tenantId, groupKey string, workflowVersion *db.WorkflowVersionModel) error {
+	concurrency, hasConcurrency := workflowVersion.Concurrency()
+
+	if !hasConcurrency {
+		return nil
+	}
+
+	switch concurrency.LimitStrategy {
+	case db.ConcurrencyLimitStrategyCancelInProgress:
+		return wc.queueByCancelInProgress(ctx, tenantId, groupKey, workflowVersion)
+	case db.ConcurrencyLimitStrategyGroupRoundRobin:
+		return wc.queueByGroupRoundRobin(ctx, tenantId, workflowVersion)
+	default:
+		return fmt.Errorf("unimplemented concurrency limit strategy: %s", concurrency.LimitStrategy)
+	}
+}
+
 func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 	ctx context.Context,
 	getGroupKeyRun *db.GetGroupKeyRunModel,
@@ -201,25 +301,19 @@ func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 		return fmt.Errorf("could not add step run to worker: %w", err)
 	}
 
-	// pick a ticker to use for timeout
-	tickers, err := wc.getValidTickers()
+	// store the get group key run's timeout, which is polled by the tickers
+	timeoutAt, err := getGroupKeyRunTimeoutAt()
 
 	if err != nil {
-		return err
+		return fmt.Errorf("could not get get group key run timeout: %w", err)
 	}
 
-	ticker := &tickers[0]
-
-	ticker, err = wc.repo.Ticker().AddGetGroupKeyRun(ticker.ID, getGroupKeyRun.ID)
-
-	if err != nil {
-		return fmt.Errorf("could not add step run to ticker: %w", err)
-	}
-
-	scheduleTimeoutTask, err := scheduleGetGroupKeyRunTimeoutTask(ticker, getGroupKeyRun)
+	_, err = wc.repo.GetGroupKeyRun().UpdateGetGroupKeyRun(tenantId, getGroupKeyRun.ID, &repository.UpdateGetGroupKeyRunOpts{
+		TimeoutAt: &timeoutAt,
+	})
 
 	if err != nil {
-		return fmt.Errorf("could not schedule step run timeout task: %w", err)
+		return fmt.Errorf("could not set get group key run timeout: %w", err)
 	}
 
 	dispatcherId := sqlchelpers.UUIDToStr(selectedWorker.Worker.DispatcherId)
@@ -240,17 +334,6 @@ func (wc *WorkflowsControllerImpl) scheduleGetGroupAction(
 		return fmt.Errorf("could not add job assigned task to task queue: %w", err)
 	}
 
-	// send a task to the ticker
-	err = wc.tq.AddTask(
-		ctx,
-		taskqueue.QueueTypeFromTickerID(ticker.ID),
-		scheduleTimeoutTask,
-	)
-
-	if err != nil {
-		return fmt.Errorf("could not add schedule step run timeout task to task queue: %w", err)
-	}
-
 	return nil
 }
 
@@ -373,25 +456,6 @@ func (wc *WorkflowsControllerImpl) handleGroupKeyActionRequeue(ctx context.Conte
 	return nil
 }
 
-func (ec *WorkflowsControllerImpl) getValidTickers() ([]db.TickerModel, error) {
-	within := time.Now().UTC().Add(-6 * time.Second)
-
-	tickers, err := ec.repo.Ticker().ListTickers(&repository.ListTickerOpts{
-		LatestHeartbeatAt: &within,
-		Active:            repository.BoolPtr(true),
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("could not list tickers: %w", err)
-	}
-
-	if len(tickers) == 0 {
-		return nil, fmt.Errorf("no tickers available")
-	}
-
-	return tickers, nil
-}
-
 func (wc *WorkflowsControllerImpl) queueByCancelInProgress(ctx context.Context, tenantId, groupKey string, workflowVersion *db.WorkflowVersionModel) error {
 	ctx, span := telemetry.NewSpan(ctx, "queue-by-cancel-in-progress")
 	defer span.End()
@@ -601,31 +665,16 @@ func getStepRunNotifyCancelTask(tenantId, stepRunId, reason string) *taskqueue.T
 	}
 }
 
-func scheduleGetGroupKeyRunTimeoutTask(ticker *db.TickerModel, getGroupKeyRun *db.GetGroupKeyRunModel) (*taskqueue.Task, error) {
+// getGroupKeyRunTimeoutAt returns when a get group key run which is assigned now times out.
+func getGroupKeyRunTimeoutAt() (time.Time, error) {
 	durationStr := defaults.DefaultStepRunTimeout
 
 	// get a duration
 	duration, err := time.ParseDuration(durationStr)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not parse duration: %w", err)
+		return time.Time{}, fmt.Errorf("could not parse duration: %w", err)
 	}
 
-	timeoutAt := time.Now().UTC().Add(duration)
-
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleGetGroupKeyRunTimeoutTaskPayload{
-		GetGroupKeyRunId: getGroupKeyRun.ID,
-		WorkflowRunId:    getGroupKeyRun.WorkflowRunID,
-		TimeoutAt:        timeoutAt.Format(time.RFC3339),
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleGetGroupKeyRunTimeoutTaskMetadata{
-		TenantId: getGroupKeyRun.TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "schedule-get-group-key-run-timeout",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
+	return time.Now().UTC().Add(duration), nil
 }
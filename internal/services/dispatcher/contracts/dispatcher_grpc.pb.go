@@ -23,12 +23,27 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DispatcherClient interface {
 	Register(ctx context.Context, in *WorkerRegisterRequest, opts ...grpc.CallOption) (*WorkerRegisterResponse, error)
+	// Listen streams actions assigned to the worker. Actions are delivered at least once: a step run
+	// may be sent again after a reconnect or a reassignment, so workers should dedupe by step run id.
 	Listen(ctx context.Context, in *WorkerListenRequest, opts ...grpc.CallOption) (Dispatcher_ListenClient, error)
 	SubscribeToWorkflowEvents(ctx context.Context, in *SubscribeToWorkflowEventsRequest, opts ...grpc.CallOption) (Dispatcher_SubscribeToWorkflowEventsClient, error)
+	// SubscribeToTenantEvents streams the live events of all workflow runs of the tenant which match the
+	// filters of the request. The connection is not hung up when a workflow run finishes.
+	SubscribeToTenantEvents(ctx context.Context, in *SubscribeToTenantEventsRequest, opts ...grpc.CallOption) (Dispatcher_SubscribeToTenantEventsClient, error)
 	SendStepActionEvent(ctx context.Context, in *StepActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
 	SendGroupKeyActionEvent(ctx context.Context, in *GroupKeyActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
 	PutOverridesData(ctx context.Context, in *OverridesData, opts ...grpc.CallOption) (*OverridesDataResponse, error)
 	Unsubscribe(ctx context.Context, in *WorkerUnsubscribeRequest, opts ...grpc.CallOption) (*WorkerUnsubscribeResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
+	ReportProgress(ctx context.Context, in *StepRunProgress, opts ...grpc.CallOption) (*ActionEventResponse, error)
+	// AckAssignedAction acknowledges that the worker received a START_STEP_RUN action. If the
+	// step run is no longer assigned to the worker, FAILED_PRECONDITION is returned and the
+	// worker must not run the step.
+	AckAssignedAction(ctx context.Context, in *AssignedActionAck, opts ...grpc.CallOption) (*AssignedActionAckResponse, error)
+	// PutStreamEvent streams a chunk of a step run's partial output to subscribers of the step run's
+	// workflow run. Stream events are delivered in order per step run, and are not persisted.
+	PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error)
 }
 
 type dispatcherClient struct {
@@ -112,6 +127,38 @@ func (x *dispatcherSubscribeToWorkflowEventsClient) Recv() (*WorkflowEvent, erro
 	return m, nil
 }
 
+func (c *dispatcherClient) SubscribeToTenantEvents(ctx context.Context, in *SubscribeToTenantEventsRequest, opts ...grpc.CallOption) (Dispatcher_SubscribeToTenantEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Dispatcher_ServiceDesc.Streams[2], "/Dispatcher/SubscribeToTenantEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherSubscribeToTenantEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dispatcher_SubscribeToTenantEventsClient interface {
+	Recv() (*WorkflowEvent, error)
+	grpc.ClientStream
+}
+
+type dispatcherSubscribeToTenantEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherSubscribeToTenantEventsClient) Recv() (*WorkflowEvent, error) {
+	m := new(WorkflowEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dispatcherClient) SendStepActionEvent(ctx context.Context, in *StepActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error) {
 	out := new(ActionEventResponse)
 	err := c.cc.Invoke(ctx, "/Dispatcher/SendStepActionEvent", in, out, opts...)
@@ -148,17 +195,77 @@ func (c *dispatcherClient) Unsubscribe(ctx context.Context, in *WorkerUnsubscrib
 	return out, nil
 }
 
+func (c *dispatcherClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error) {
+	out := new(RefreshTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/RefreshTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ReportProgress(ctx context.Context, in *StepRunProgress, opts ...grpc.CallOption) (*ActionEventResponse, error) {
+	out := new(ActionEventResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/ReportProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) AckAssignedAction(ctx context.Context, in *AssignedActionAck, opts ...grpc.CallOption) (*AssignedActionAckResponse, error) {
+	out := new(AssignedActionAckResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/AckAssignedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error) {
+	out := new(PutStreamEventResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/PutStreamEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
 type DispatcherServer interface {
 	Register(context.Context, *WorkerRegisterRequest) (*WorkerRegisterResponse, error)
+	// Listen streams actions assigned to the worker. Actions are delivered at least once: a step run
+	// may be sent again after a reconnect or a reassignment, so workers should dedupe by step run id.
 	Listen(*WorkerListenRequest, Dispatcher_ListenServer) error
 	SubscribeToWorkflowEvents(*SubscribeToWorkflowEventsRequest, Dispatcher_SubscribeToWorkflowEventsServer) error
+	// SubscribeToTenantEvents streams the live events of all workflow runs of the tenant which match the
+	// filters of the request. The connection is not hung up when a workflow run finishes.
+	SubscribeToTenantEvents(*SubscribeToTenantEventsRequest, Dispatcher_SubscribeToTenantEventsServer) error
 	SendStepActionEvent(context.Context, *StepActionEvent) (*ActionEventResponse, error)
 	SendGroupKeyActionEvent(context.Context, *GroupKeyActionEvent) (*ActionEventResponse, error)
 	PutOverridesData(context.Context, *OverridesData) (*OverridesDataResponse, error)
 	Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
+	ReportProgress(context.Context, *StepRunProgress) (*ActionEventResponse, error)
+	// AckAssignedAction acknowledges that the worker received a START_STEP_RUN action. If the
+	// step run is no longer assigned to the worker, FAILED_PRECONDITION is returned and the
+	// worker must not run the step.
+	AckAssignedAction(context.Context, *AssignedActionAck) (*AssignedActionAckResponse, error)
+	// PutStreamEvent streams a chunk of a step run's partial output to subscribers of the step run's
+	// workflow run. Stream events are delivered in order per step run, and are not persisted.
+	PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error)
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -175,6 +282,9 @@ func (UnimplementedDispatcherServer) Listen(*WorkerListenRequest, Dispatcher_Lis
 func (UnimplementedDispatcherServer) SubscribeToWorkflowEvents(*SubscribeToWorkflowEventsRequest, Dispatcher_SubscribeToWorkflowEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToWorkflowEvents not implemented")
 }
+func (UnimplementedDispatcherServer) SubscribeToTenantEvents(*SubscribeToTenantEventsRequest, Dispatcher_SubscribeToTenantEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToTenantEvents not implemented")
+}
 func (UnimplementedDispatcherServer) SendStepActionEvent(context.Context, *StepActionEvent) (*ActionEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendStepActionEvent not implemented")
 }
@@ -187,6 +297,21 @@ func (UnimplementedDispatcherServer) PutOverridesData(context.Context, *Override
 func (UnimplementedDispatcherServer) Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
+func (UnimplementedDispatcherServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedDispatcherServer) RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTimeout not implemented")
+}
+func (UnimplementedDispatcherServer) ReportProgress(context.Context, *StepRunProgress) (*ActionEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProgress not implemented")
+}
+func (UnimplementedDispatcherServer) AckAssignedAction(context.Context, *AssignedActionAck) (*AssignedActionAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckAssignedAction not implemented")
+}
+func (UnimplementedDispatcherServer) PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutStreamEvent not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +385,27 @@ func (x *dispatcherSubscribeToWorkflowEventsServer) Send(m *WorkflowEvent) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Dispatcher_SubscribeToTenantEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeToTenantEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DispatcherServer).SubscribeToTenantEvents(m, &dispatcherSubscribeToTenantEventsServer{stream})
+}
+
+type Dispatcher_SubscribeToTenantEventsServer interface {
+	Send(*WorkflowEvent) error
+	grpc.ServerStream
+}
+
+type dispatcherSubscribeToTenantEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherSubscribeToTenantEventsServer) Send(m *WorkflowEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dispatcher_SendStepActionEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StepActionEvent)
 	if err := dec(in); err != nil {
@@ -332,6 +478,96 @@ func _Dispatcher_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_RefreshTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).RefreshTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/RefreshTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).RefreshTimeout(ctx, req.(*RefreshTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepRunProgress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/ReportProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).ReportProgress(ctx, req.(*StepRunProgress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_AckAssignedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignedActionAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).AckAssignedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/AckAssignedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).AckAssignedAction(ctx, req.(*AssignedActionAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_PutStreamEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStreamEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).PutStreamEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/PutStreamEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).PutStreamEvent(ctx, req.(*PutStreamEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -359,6 +595,26 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unsubscribe",
 			Handler:    _Dispatcher_Unsubscribe_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Dispatcher_Heartbeat_Handler,
+		},
+		{
+			MethodName: "RefreshTimeout",
+			Handler:    _Dispatcher_RefreshTimeout_Handler,
+		},
+		{
+			MethodName: "ReportProgress",
+			Handler:    _Dispatcher_ReportProgress_Handler,
+		},
+		{
+			MethodName: "AckAssignedAction",
+			Handler:    _Dispatcher_AckAssignedAction_Handler,
+		},
+		{
+			MethodName: "PutStreamEvent",
+			Handler:    _Dispatcher_PutStreamEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -371,6 +627,11 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Dispatcher_SubscribeToWorkflowEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeToTenantEvents",
+			Handler:       _Dispatcher_SubscribeToTenantEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dispatcher.proto",
 }
@@ -8,12 +8,16 @@ import (
 
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
@@ -35,6 +39,14 @@ type DispatcherImpl struct {
 	repo         repository.Repository
 	dispatcherId string
 	workers      sync.Map
+
+	// ackTimers stores the timer which reassigns an unacknowledged step run, keyed by tenant and step run
+	ackTimers sync.Map
+
+	// stepRunWorkflowRuns caches the workflow run of step runs which stream events, keyed by tenant and step run
+	stepRunWorkflowRuns *lru.Cache[string, string]
+
+	events *runevents.Resolver
 }
 
 type DispatcherOpt func(*DispatcherOpts)
@@ -111,14 +123,19 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 		return nil, fmt.Errorf("could not create scheduler for dispatcher: %w", err)
 	}
 
+	// this only returns an error if the size is less than 0
+	stepRunWorkflowRuns, _ := lru.New[string, string](2000) // nolint: errcheck
+
 	return &DispatcherImpl{
-		tq:           opts.tq,
-		l:            opts.l,
-		dv:           opts.dv,
-		repo:         opts.repo,
-		dispatcherId: opts.dispatcherId,
-		workers:      sync.Map{},
-		s:            s,
+		tq:                  opts.tq,
+		l:                   opts.l,
+		dv:                  opts.dv,
+		repo:                opts.repo,
+		dispatcherId:        opts.dispatcherId,
+		workers:             sync.Map{},
+		s:                   s,
+		stepRunWorkflowRuns: stepRunWorkflowRuns,
+		events:              runevents.NewResolver(opts.repo, opts.l),
 	}, nil
 }
 
@@ -222,6 +239,8 @@ func (d *DispatcherImpl) handleTask(ctx context.Context, task *taskqueue.Task) e
 		return d.handleGroupKeyActionAssignedTask(ctx, task)
 	case "step-run-assigned":
 		return d.handleStepRunAssignedTask(ctx, task)
+	case "step-runs-assigned":
+		return d.handleStepRunsAssignedTask(ctx, task)
 	case "step-run-cancelled":
 		return d.handleStepRunCancelled(ctx, task)
 	}
@@ -294,17 +313,71 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ta
 		return fmt.Errorf("could not decode dispatcher task metadata: %w", err)
 	}
 
+	return d.sendStepRunToWorker(ctx, metadata.TenantId, payload.WorkerId, payload.StepRunId)
+}
+
+// handleStepRunsAssignedTask sends a batch of step runs which were assigned to workers connected to this
+// dispatcher.
+func (d *DispatcherImpl) handleStepRunsAssignedTask(ctx context.Context, task *taskqueue.Task) error {
+	ctx, span := telemetry.NewSpan(ctx, "step-runs-assigned")
+	defer span.End()
+
+	payload := tasktypes.StepRunsAssignedTaskPayload{}
+	metadata := tasktypes.StepRunsAssignedTaskMetadata{}
+
+	err := d.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode dispatcher task payload: %w", err)
+	}
+
+	err = d.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode dispatcher task metadata: %w", err)
+	}
+
+	var errs error
+	var mu sync.Mutex
+
+	wg := sync.WaitGroup{}
+
+	for i := range payload.Assignments {
+		assignment := payload.Assignments[i]
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := d.sendStepRunToWorker(ctx, metadata.TenantId, assignment.WorkerId, assignment.StepRunId); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func (d *DispatcherImpl) sendStepRunToWorker(ctx context.Context, tenantId, workerId, stepRunId string) error {
+	ctx, span := telemetry.NewSpan(ctx, "send-step-run-to-worker")
+	defer span.End()
+
 	// get the worker for this task
-	w, err := d.GetWorker(payload.WorkerId)
+	w, err := d.GetWorker(workerId)
 
 	if err != nil {
 		return fmt.Errorf("could not get worker: %w", err)
 	}
 
-	telemetry.WithAttributes(span, servertel.WorkerId(payload.WorkerId))
+	telemetry.WithAttributes(span, servertel.WorkerId(workerId))
 
 	// load the step run from the database
-	stepRun, err := d.repo.StepRun().GetStepRunById(metadata.TenantId, payload.StepRunId)
+	stepRun, err := d.repo.StepRun().GetStepRunById(tenantId, stepRunId)
 
 	if err != nil {
 		return fmt.Errorf("could not get step run: %w", err)
@@ -312,15 +385,74 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ta
 
 	servertel.WithStepRunModel(span, stepRun)
 
-	err = w.StartStepRun(ctx, metadata.TenantId, stepRun)
+	err = w.StartStepRun(ctx, tenantId, stepRun)
 
 	if err != nil {
+		// the step run did not reach the worker, so it can be reassigned right away
+		if _, requeueErr := d.repo.StepRun().RequeueUnackedStepRun(tenantId, stepRunId, workerId, time.Now().UTC()); requeueErr != nil {
+			d.l.Error().Err(requeueErr).Msgf("could not requeue step run %s", stepRunId)
+		}
+
 		return fmt.Errorf("could not send step action to worker: %w", err)
 	}
 
+	// a successful send does not mean that the worker received the step run, since the stream may be
+	// half-closed. workers which acknowledge assignments have a short time to do so before the step run is
+	// reassigned.
+	if w.acks {
+		d.startAckTimer(tenantId, workerId, stepRunId)
+	}
+
 	return nil
 }
 
+// startAckTimer reassigns the step run if it is not acknowledged in time. A step run which is sent again replaces
+// the timer of its previous assignment, so a stale timer cannot reassign a later assignment of the step run.
+func (d *DispatcherImpl) startAckTimer(tenantId, workerId, stepRunId string) {
+	key := ackTimerKey(tenantId, stepRunId)
+
+	// the assignment is identified by its timer, which only requeues the step run while it is the current one
+	assignment := &ackTimer{}
+
+	assignment.timer = time.AfterFunc(defaults.AssignmentAckTimeout, func() {
+		if d.ackTimers.CompareAndDelete(key, assignment) {
+			d.requeueUnackedStepRun(tenantId, workerId, stepRunId)
+		}
+	})
+
+	if prev, ok := d.ackTimers.Swap(key, assignment); ok {
+		prev.(*ackTimer).timer.Stop()
+	}
+}
+
+// stopAckTimer stops the timer of an acknowledged step run.
+func (d *DispatcherImpl) stopAckTimer(tenantId, stepRunId string) {
+	if prev, ok := d.ackTimers.LoadAndDelete(ackTimerKey(tenantId, stepRunId)); ok {
+		prev.(*ackTimer).timer.Stop()
+	}
+}
+
+type ackTimer struct {
+	timer *time.Timer
+}
+
+func ackTimerKey(tenantId, stepRunId string) string {
+	return fmt.Sprintf("%s/%s", tenantId, stepRunId)
+}
+
+func (d *DispatcherImpl) requeueUnackedStepRun(tenantId, workerId, stepRunId string) {
+	requeued, err := d.repo.StepRun().RequeueUnackedStepRun(tenantId, stepRunId, workerId, time.Now().UTC())
+
+	if err != nil {
+		d.l.Error().Err(err).Msgf("could not requeue unacknowledged step run %s", stepRunId)
+		return
+	}
+
+	if requeued {
+		d.l.Warn().Msgf("reassigning step run %s which was not acknowledged by worker %s", stepRunId, workerId)
+	}
+}
+
 func (d *DispatcherImpl) handleStepRunCancelled(ctx context.Context, task *taskqueue.Task) error {
 	ctx, span := telemetry.NewSpan(ctx, "step-run-cancelled")
 	defer span.End()
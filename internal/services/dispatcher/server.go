@@ -2,17 +2,24 @@ package dispatcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
 	"sync"
 	"time"
 
 	"github.com/steebchen/prisma-client-go/runtime/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/runevents"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
@@ -39,6 +46,9 @@ type subscribedWorker struct {
 
 	// finished is used to signal closure of a client subscribing goroutine
 	finished chan<- bool
+
+	// acks is whether the worker acknowledges the step runs sent to it
+	acks bool
 }
 
 func (worker *subscribedWorker) StartStepRun(
@@ -85,6 +95,7 @@ func (worker *subscribedWorker) StartStepRun(
 		ActionPayload: string(inputBytes),
 		StepName:      stepName,
 		WorkflowRunId: stepRun.JobRun().WorkflowRunID,
+		RetryCount:    int32(stepRun.RetryCount),
 	})
 }
 
@@ -201,6 +212,14 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		opts.MaxRuns = &mr
 	}
 
+	if len(request.ActionSlots) > 0 {
+		opts.ActionSlots = make(map[string]int, len(request.ActionSlots))
+
+		for actionId, slots := range request.ActionSlots {
+			opts.ActionSlots[actionId] = int(slots)
+		}
+	}
+
 	// create a worker in the database
 	worker, err := s.repo.Worker().CreateNewWorker(tenant.ID, opts)
 
@@ -232,21 +251,31 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		return err
 	}
 
+	updateOpts := &repository.UpdateWorkerOpts{
+		Status: repository.WorkerStatusPtr(db.WorkerStatusActive),
+	}
+
 	// check the worker's dispatcher against the current dispatcher. if they don't match, then update the worker
 	if dispatcherId, ok := worker.DispatcherID(); !ok || dispatcherId != s.dispatcherId {
-		_, err = s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
-			DispatcherId: &s.dispatcherId,
-		})
+		updateOpts.DispatcherId = &s.dispatcherId
+	}
 
-		if err != nil {
-			s.l.Error().Err(err).Msgf("could not update worker %s dispatcher", request.WorkerId)
-			return err
-		}
+	// workers which send heartbeats are given a full heartbeat timeout to send their first heartbeat
+	if request.Heartbeats {
+		now := time.Now().UTC()
+		updateOpts.LastHeartbeatAt = &now
+	}
+
+	_, err = s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, updateOpts)
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not update worker %s", request.WorkerId)
+		return err
 	}
 
 	fin := make(chan bool)
 
-	s.workers.Store(request.WorkerId, subscribedWorker{stream: stream, finished: fin})
+	s.workers.Store(request.WorkerId, subscribedWorker{stream: stream, finished: fin, acks: request.Acks})
 
 	defer func() {
 		// non-blocking send
@@ -268,42 +297,50 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		}
 	}()
 
-	ctx := stream.Context()
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
 
-	// update the worker with a last heartbeat time every 5 seconds as long as the worker is connected
-	go func() {
-		timer := time.NewTicker(100 * time.Millisecond)
+	// closed when a worker which sends heartbeats has stopped sending them
+	timedOut := make(chan struct{})
 
-		// set the last heartbeat to 6 seconds ago so the first heartbeat is sent immediately
-		lastHeartbeat := time.Now().UTC().Add(-6 * time.Second)
-		defer timer.Stop()
+	if request.Heartbeats {
+		go s.checkWorkerHeartbeats(ctx, request.WorkerId, timedOut)
+	} else {
+		// update the worker with a last heartbeat time every 5 seconds as long as the worker is connected
+		go func() {
+			timer := time.NewTicker(100 * time.Millisecond)
 
-		for {
-			select {
-			case <-ctx.Done():
-				s.l.Debug().Msgf("worker id %s has disconnected", request.WorkerId)
-				return
-			case <-fin:
-				s.l.Debug().Msgf("closing stream for worker id: %s", request.WorkerId)
-				return
-			case <-timer.C:
-				if now := time.Now().UTC(); lastHeartbeat.Add(5 * time.Second).Before(now) {
-					s.l.Debug().Msgf("updating worker %s heartbeat", request.WorkerId)
+			// set the last heartbeat to 6 seconds ago so the first heartbeat is sent immediately
+			lastHeartbeat := time.Now().UTC().Add(-6 * time.Second)
+			defer timer.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					s.l.Debug().Msgf("worker id %s has disconnected", request.WorkerId)
+					return
+				case <-fin:
+					s.l.Debug().Msgf("closing stream for worker id: %s", request.WorkerId)
+					return
+				case <-timer.C:
+					if now := time.Now().UTC(); lastHeartbeat.Add(5 * time.Second).Before(now) {
+						s.l.Debug().Msgf("updating worker %s heartbeat", request.WorkerId)
 
-					_, err := s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
-						LastHeartbeatAt: &now,
-					})
+						_, err := s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
+							LastHeartbeatAt: &now,
+						})
 
-					if err != nil {
-						s.l.Error().Err(err).Msgf("could not update worker %s heartbeat", request.WorkerId)
-						return
-					}
+						if err != nil {
+							s.l.Error().Err(err).Msgf("could not update worker %s heartbeat", request.WorkerId)
+							return
+						}
 
-					lastHeartbeat = time.Now().UTC()
+						lastHeartbeat = time.Now().UTC()
+					}
 				}
 			}
-		}
-	}()
+		}()
+	}
 
 	// Keep the connection alive for sending messages
 	for {
@@ -311,6 +348,9 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 		case <-fin:
 			s.l.Debug().Msgf("closing stream for worker id: %s", request.WorkerId)
 			return nil
+		case <-timedOut:
+			s.l.Warn().Msgf("worker id %s has not sent a heartbeat in %s, closing stream", request.WorkerId, defaults.WorkerHeartbeatTimeout)
+			return status.Errorf(codes.DeadlineExceeded, "no heartbeat received in %s", defaults.WorkerHeartbeatTimeout)
 		case <-ctx.Done():
 			s.l.Debug().Msgf("worker id %s has disconnected", request.WorkerId)
 			return nil
@@ -318,6 +358,32 @@ func (s *DispatcherImpl) Listen(request *contracts.WorkerListenRequest, stream c
 	}
 }
 
+// checkWorkerHeartbeats closes timedOut when the worker's last heartbeat is older than the heartbeat timeout. The
+// last heartbeat is read from the database, as heartbeats may be sent to any dispatcher.
+func (s *DispatcherImpl) checkWorkerHeartbeats(ctx context.Context, workerId string, timedOut chan<- struct{}) {
+	ticker := time.NewTicker(defaults.WorkerHeartbeatTimeout / 6)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			worker, err := s.repo.Worker().GetWorkerById(workerId)
+
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not get worker %s", workerId)
+				continue
+			}
+
+			if lastHeartbeatAt, ok := worker.LastHeartbeatAt(); ok && time.Since(lastHeartbeatAt) > defaults.WorkerHeartbeatTimeout {
+				close(timedOut)
+				return
+			}
+		}
+	}
+}
+
 // SubscribeToWorkflowEvents registers workflow events with the dispatcher
 func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeToWorkflowEventsRequest, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
 	tenant := stream.Context().Value("tenant").(*db.TenantModel)
@@ -340,8 +406,54 @@ func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeT
 		return err
 	}
 
+	// events up to this id were replayed, so live events which were already sent are skipped
+	var lastReplayedId int64
+
+	if request.LastEventId != nil || request.FromBeginning {
+		var hangup bool
+
+		lastReplayedId, hangup, err = s.replayWorkflowEvents(tenant.ID, request, stream)
+
+		if err != nil || hangup {
+			if cleanupErr := cleanupQueue(); cleanupErr != nil {
+				s.l.Error().Err(cleanupErr).Msg("could not cleanup queue")
+			}
+
+			return err
+		}
+	}
+
 	wg := sync.WaitGroup{}
 
+	// the stream does not support concurrent sends
+	var sendMu sync.Mutex
+
+	sendTask := func(task *taskqueue.Task) {
+		e, err := s.tenantTaskToWorkflowEvent(task, request.WorkflowRunId)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not convert task to workflow event")
+			return
+		} else if e == nil {
+			return
+		} else if e.EventId != 0 && e.EventId <= lastReplayedId {
+			return
+		}
+
+		// send the task to the client
+		sendMu.Lock()
+		err = stream.Send(e)
+		sendMu.Unlock()
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not send workflow event to client")
+		}
+
+		if e.Hangup {
+			cancel()
+		}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -352,31 +464,39 @@ func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeT
 			wg.Wait()
 			return nil
 		case task := <-taskChan:
+			// stream events are sent in the order they are received, so chunks of a step run stay in order
+			if task.ID == "step-run-stream-event" {
+				sendTask(task)
+				continue
+			}
+
 			wg.Add(1)
 			go func(task *taskqueue.Task) {
 				defer wg.Done()
-				e, err := s.tenantTaskToWorkflowEvent(task, tenant.ID, request.WorkflowRunId)
+				sendTask(task)
+			}(task)
+		}
+	}
+}
 
-				if err != nil {
-					s.l.Error().Err(err).Msgf("could not convert task to workflow event")
-					return
-				} else if e == nil {
-					return
-				}
+func (s *DispatcherImpl) SubscribeToTenantEvents(request *contracts.SubscribeToTenantEventsRequest, stream contracts.Dispatcher_SubscribeToTenantEventsServer) error {
+	tenant := stream.Context().Value("tenant").(*db.TenantModel)
 
-				// send the task to the client
-				err = stream.Send(e)
+	filter, err := toTenantEventsFilter(request)
 
-				if err != nil {
-					s.l.Error().Err(err).Msgf("could not send workflow event to client")
-				}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
-				if e.Hangup {
-					cancel()
-				}
-			}(task)
+	s.l.Debug().Msgf("Received subscribe request for tenant: %s", tenant.ID)
+
+	return s.events.Subscribe(stream.Context(), s.tq, tenant.ID, filter, func(e *runevents.Event) error {
+		if err := stream.Send(toWorkflowEvent(e)); err != nil {
+			return fmt.Errorf("could not send workflow event to client: %w", err)
 		}
-	}
+
+		return nil
+	})
 }
 
 func (s *DispatcherImpl) SendStepActionEvent(ctx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
@@ -446,6 +566,39 @@ func (s *DispatcherImpl) PutOverridesData(ctx context.Context, request *contract
 func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.WorkerUnsubscribeRequest) (*contracts.WorkerUnsubscribeResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
+	// a draining worker keeps listening, so it receives cancellations and its heartbeats are recorded until its
+	// in-flight step runs have finished
+	if request.Drain {
+		worker, err := s.repo.Worker().GetWorkerById(request.WorkerId)
+
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "worker not found")
+			}
+
+			return nil, err
+		}
+
+		if worker.TenantID != tenant.ID {
+			return nil, status.Error(codes.NotFound, "worker not found")
+		}
+
+		draining := db.WorkerSchedulingStatusDraining
+
+		_, err = s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
+			SchedulingStatus: &draining,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &contracts.WorkerUnsubscribeResponse{
+			TenantId: tenant.ID,
+			WorkerId: request.WorkerId,
+		}, nil
+	}
+
 	// no matter what, remove the worker from the connection pool
 	defer s.workers.Delete(request.WorkerId)
 
@@ -461,6 +614,290 @@ func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.Wor
 	}, nil
 }
 
+// Heartbeat records a heartbeat sent by a worker and reconciles the step runs assigned to the worker against the
+// step runs which the worker reports as in flight.
+func (s *DispatcherImpl) Heartbeat(ctx context.Context, request *contracts.HeartbeatRequest) (*contracts.HeartbeatResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	s.l.Debug().Msgf("Received heartbeat from worker %s with %d step runs in flight", request.WorkerId, len(request.InFlightStepRunIds))
+
+	worker, err := s.repo.Worker().GetWorkerById(request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "worker not found")
+		}
+
+		return nil, err
+	}
+
+	if worker.TenantID != tenant.ID {
+		return nil, status.Error(codes.NotFound, "worker not found")
+	}
+
+	// a worker is only assigned step runs while it is listening, so heartbeats of a worker which is not listening
+	// are not recorded
+	if worker.Status != db.WorkerStatusActive {
+		return nil, status.Error(codes.FailedPrecondition, "worker is not listening")
+	}
+
+	now := time.Now().UTC()
+
+	_, err = s.repo.Worker().UpdateWorker(tenant.ID, request.WorkerId, &repository.UpdateWorkerOpts{
+		LastHeartbeatAt: &now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	lostStepRuns, err := s.repo.StepRun().ListLostStepRuns(tenant.ID, &repository.ListLostStepRunsOpts{
+		WorkerId:           request.WorkerId,
+		InFlightStepRunIds: request.InFlightStepRunIds,
+		UpdatedBefore:      now.Add(-defaults.LostStepRunGracePeriod),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &contracts.HeartbeatResponse{
+		TenantId:             tenant.ID,
+		WorkerId:             request.WorkerId,
+		ReconciledStepRunIds: make([]string, 0, len(lostStepRuns)),
+	}
+
+	for _, stepRun := range lostStepRuns {
+		stepRunId := sqlchelpers.UUIDToStr(stepRun.ID)
+
+		if err := s.reconcileLostStepRun(ctx, tenant.ID, request.WorkerId, stepRun); err != nil {
+			s.l.Error().Err(err).Msgf("could not reconcile step run %s lost by worker %s", stepRunId, request.WorkerId)
+			continue
+		}
+
+		res.ReconciledStepRunIds = append(res.ReconciledStepRunIds, stepRunId)
+	}
+
+	// a draining worker is not assigned new step runs, so it is drained once its step runs have finished
+	if worker.SchedulingStatus == db.WorkerSchedulingStatusDraining && len(request.InFlightStepRunIds) == 0 {
+		activeStepRuns, err := s.repo.Worker().CountActiveStepRuns(tenant.ID, request.WorkerId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Drained = activeStepRuns == 0
+	}
+
+	return res, nil
+}
+
+// reconcileLostStepRun reassigns a step run which never started on the worker, and fails a step run which was
+// running, so the step's retries apply.
+func (s *DispatcherImpl) reconcileLostStepRun(ctx context.Context, tenantId, workerId string, stepRun *dbsqlc.StepRun) error {
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.ID)
+
+	if stepRun.Status == dbsqlc.StepRunStatusASSIGNED {
+		s.l.Info().Msgf("reassigning step run %s which was not received by worker %s", stepRunId, workerId)
+
+		requeueAfter := time.Now().UTC().Add(time.Second * 5)
+
+		_, _, err := s.repo.StepRun().UpdateStepRun(tenantId, stepRunId, &repository.UpdateStepRunOpts{
+			Status:       repository.StepRunStatusPtr(db.StepRunStatusPendingAssignment),
+			RequeueAfter: &requeueAfter,
+		})
+
+		return err
+	}
+
+	s.l.Info().Msgf("failing step run %s which is no longer running on worker %s", stepRunId, workerId)
+
+	retryCount := int(stepRun.RetryCount)
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
+		StepRunId:  stepRunId,
+		FailedAt:   time.Now().UTC().Format(time.RFC3339),
+		Error:      fmt.Sprintf("step run was lost by worker %s", workerId),
+		WorkerId:   workerId,
+		RetryCount: &retryCount,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return s.tq.AddTask(ctx, taskqueue.JOB_PROCESSING_QUEUE, &taskqueue.Task{
+		ID:       "step-run-failed",
+		Payload:  payload,
+		Metadata: metadata,
+	})
+}
+
+// AckAssignedAction records that a worker received a step run assigned to it. Step runs which are no longer
+// assigned to the worker are rejected, so the worker does not run a step run which was reassigned.
+func (s *DispatcherImpl) AckAssignedAction(ctx context.Context, request *contracts.AssignedActionAck) (*contracts.AssignedActionAckResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	s.l.Debug().Msgf("Received ack for step run %s from worker %s", request.StepRunId, request.WorkerId)
+
+	_, err := s.repo.StepRun().AckStepRunAssignment(tenant.ID, request.StepRunId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrStepRunIsNotAssigned) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	s.stopAckTimer(tenant.ID, request.StepRunId)
+
+	return &contracts.AssignedActionAckResponse{
+		TenantId: tenant.ID,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+// PutStreamEvent publishes a chunk of a step run's partial output to subscribers of the step run's workflow run.
+// Stream events are only published to the tenant's subscribers, and are not persisted.
+func (s *DispatcherImpl) PutStreamEvent(ctx context.Context, request *contracts.PutStreamEventRequest) (*contracts.PutStreamEventResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	cacheKey := fmt.Sprintf("%s/%s", tenant.ID, request.StepRunId)
+
+	workflowRunId, ok := s.stepRunWorkflowRuns.Get(cacheKey)
+
+	if !ok {
+		stepRun, err := s.repo.StepRun().GetStepRunById(tenant.ID, request.StepRunId)
+
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return nil, err
+		}
+
+		if err != nil || stepRun.TenantID != tenant.ID {
+			return nil, status.Error(codes.NotFound, "step run not found")
+		}
+
+		workflowRunId = stepRun.JobRun().WorkflowRunID
+
+		s.stepRunWorkflowRuns.Add(cacheKey, workflowRunId)
+	}
+
+	createdAt := time.Now().UTC()
+
+	if request.CreatedAt != nil {
+		createdAt = request.CreatedAt.AsTime()
+	}
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunStreamEventTaskPayload{
+		StepRunId:     request.StepRunId,
+		WorkflowRunId: workflowRunId,
+		CreatedAt:     createdAt.Format(time.RFC3339Nano),
+		Message:       request.Message,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunStreamEventTaskMetadata{
+		TenantId: tenant.ID,
+	})
+
+	q, err := taskqueue.TenantEventConsumerQueue(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tq.AddTask(ctx, q, &taskqueue.Task{
+		ID:       "step-run-stream-event",
+		Payload:  payload,
+		Metadata: metadata,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.PutStreamEventResponse{}, nil
+}
+
+// RefreshTimeout moves the timeout of an assigned or running step run to the given duration from now. The request
+// must be sent by the worker the step run is assigned to.
+func (s *DispatcherImpl) RefreshTimeout(ctx context.Context, request *contracts.RefreshTimeoutRequest) (*contracts.RefreshTimeoutResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	s.l.Debug().Msgf("Received refresh timeout request for step run %s", request.StepRunId)
+
+	timeout, err := time.ParseDuration(request.Timeout)
+
+	if err != nil || timeout <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timeout %q: must be a positive duration", request.Timeout)
+	}
+
+	// only the attempt which is running the step run can refresh its timeout
+	if _, err := s.getStepRunOfAttempt(tenant.ID, request.StepRunId, request.WorkerId, request.RetryCount); err != nil {
+		return nil, err
+	}
+
+	stepRun, err := s.repo.StepRun().RefreshStepRunTimeout(tenant.ID, request.StepRunId, time.Now().UTC().Add(timeout))
+
+	if err != nil {
+		if errors.Is(err, repository.ErrStepRunIsNotRunning) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return &contracts.RefreshTimeoutResponse{
+		TimeoutAt: timestamppb.New(stepRun.TimeoutAt.Time),
+	}, nil
+}
+
+// ReportProgress records the progress of a step run reported by a worker, and streams it to subscribers of the
+// step run's workflow run.
+func (s *DispatcherImpl) ReportProgress(ctx context.Context, request *contracts.StepRunProgress) (*contracts.ActionEventResponse, error) {
+	tenant := ctx.Value("tenant").(*db.TenantModel)
+
+	s.l.Debug().Msgf("Received progress %d for step run %s", request.Progress, request.StepRunId)
+
+	if request.Progress < 0 || request.Progress > 100 {
+		return nil, status.Error(codes.InvalidArgument, "progress must be between 0 and 100")
+	}
+
+	// only the attempt which is running the step run can report its progress
+	if _, err := s.getStepRunOfAttempt(tenant.ID, request.StepRunId, request.WorkerId, request.RetryCount); err != nil {
+		return nil, err
+	}
+
+	reportedAt := request.EventTimestamp.AsTime()
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunProgressTaskPayload{
+		StepRunId:  request.StepRunId,
+		ReportedAt: reportedAt.Format(time.RFC3339),
+		Progress:   int(request.Progress),
+		Message:    request.Message,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunProgressTaskMetadata{
+		TenantId: tenant.ID,
+	})
+
+	// send the event to the jobs queue
+	err := s.tq.AddTask(ctx, taskqueue.JOB_PROCESSING_QUEUE, &taskqueue.Task{
+		ID:       "step-run-progress",
+		Payload:  payload,
+		Metadata: metadata,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.ActionEventResponse{
+		TenantId: tenant.ID,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
 func (s *DispatcherImpl) handleStepRunStarted(ctx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
@@ -499,12 +936,18 @@ func (s *DispatcherImpl) handleStepRunCompleted(ctx context.Context, request *co
 
 	s.l.Debug().Msgf("Received step completed event for step run %s", request.StepRunId)
 
+	if finished, err := s.checkStepRunAttempt(tenant.ID, request); err != nil || finished {
+		return s.finishedStepRunResponse(tenant.ID, request, err)
+	}
+
 	finishedAt := request.EventTimestamp.AsTime()
 
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
 		StepRunId:      request.StepRunId,
 		FinishedAt:     finishedAt.Format(time.RFC3339),
 		StepOutputData: request.EventPayload,
+		WorkerId:       request.WorkerId,
+		RetryCount:     eventRetryCount(request),
 	})
 
 	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskMetadata{
@@ -533,12 +976,37 @@ func (s *DispatcherImpl) handleStepRunFailed(ctx context.Context, request *contr
 
 	s.l.Debug().Msgf("Received step failed event for step run %s", request.StepRunId)
 
+	if finished, err := s.checkStepRunAttempt(tenant.ID, request); err != nil || finished {
+		return s.finishedStepRunResponse(tenant.ID, request, err)
+	}
+
 	failedAt := request.EventTimestamp.AsTime()
 
+	// a failure is only treated as a shutdown of the worker, which does not count as a retry, if the worker is
+	// actually draining
+	workerShutdown := false
+
+	if request.WorkerShutdown {
+		isDraining, err := s.isWorkerDraining(tenant.ID, request.WorkerId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		workerShutdown = isDraining
+
+		if !workerShutdown {
+			s.l.Warn().Msgf("worker %s reported a shutdown for step run %s, but is not draining", request.WorkerId, request.StepRunId)
+		}
+	}
+
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
-		StepRunId: request.StepRunId,
-		FailedAt:  failedAt.Format(time.RFC3339),
-		Error:     request.EventPayload,
+		StepRunId:      request.StepRunId,
+		FailedAt:       failedAt.Format(time.RFC3339),
+		Error:          request.EventPayload,
+		WorkerId:       request.WorkerId,
+		RetryCount:     eventRetryCount(request),
+		WorkerShutdown: workerShutdown,
 	})
 
 	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
@@ -562,6 +1030,95 @@ func (s *DispatcherImpl) handleStepRunFailed(ctx context.Context, request *contr
 	}, nil
 }
 
+// isWorkerDraining returns true if the worker belongs to the tenant and is draining.
+func (s *DispatcherImpl) isWorkerDraining(tenantId, workerId string) (bool, error) {
+	worker, err := s.repo.Worker().GetWorkerById(workerId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get worker: %w", err)
+	}
+
+	return worker.TenantID == tenantId && worker.SchedulingStatus == db.WorkerSchedulingStatusDraining, nil
+}
+
+// checkStepRunAttempt rejects completed and failed events which were sent by a worker the step run is not assigned
+// to, or by an earlier attempt of the step run. It returns true if the step run already finished, in which case the
+// event is a duplicate which is acknowledged but not processed again.
+func (s *DispatcherImpl) checkStepRunAttempt(tenantId string, request *contracts.StepActionEvent) (bool, error) {
+	stepRun, err := s.getStepRunOfAttempt(tenantId, request.StepRunId, request.WorkerId, request.RetryCount)
+
+	if err != nil {
+		return false, err
+	}
+
+	switch stepRun.Status {
+	case db.StepRunStatusSucceeded, db.StepRunStatusFailed, db.StepRunStatusCancelled:
+		return true, nil
+	case db.StepRunStatusAssigned, db.StepRunStatusRunning:
+		return false, nil
+	default:
+		return false, status.Errorf(codes.FailedPrecondition, "step run %s is not running", request.StepRunId)
+	}
+}
+
+// getStepRunOfAttempt returns the step run if it is assigned to the worker and, if the retry count is set, is still
+// on that attempt. Otherwise, the request was sent by another worker or by an earlier attempt, and is rejected.
+func (s *DispatcherImpl) getStepRunOfAttempt(tenantId, stepRunId, workerId string, retryCount *int32) (*db.StepRunModel, error) {
+	stepRun, err := s.repo.StepRun().GetStepRunById(tenantId, stepRunId)
+
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "step run not found")
+		}
+
+		return nil, err
+	}
+
+	if assignedWorkerId, ok := stepRun.WorkerID(); !ok || assignedWorkerId != workerId {
+		return nil, status.Errorf(codes.FailedPrecondition, "step run %s is not assigned to worker %s", stepRunId, workerId)
+	}
+
+	if retryCount != nil && int(*retryCount) != stepRun.RetryCount {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"attempt %d of step run %s is stale, the step run is on attempt %d",
+			*retryCount,
+			stepRunId,
+			stepRun.RetryCount,
+		)
+	}
+
+	return stepRun, nil
+}
+
+func (s *DispatcherImpl) finishedStepRunResponse(tenantId string, request *contracts.StepActionEvent, err error) (*contracts.ActionEventResponse, error) {
+	if err != nil {
+		s.l.Warn().Err(err).Msgf("rejecting %s event for step run %s", request.EventType, request.StepRunId)
+		return nil, err
+	}
+
+	s.l.Debug().Msgf("step run %s already finished, ignoring duplicate %s event", request.StepRunId, request.EventType)
+
+	return &contracts.ActionEventResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
+func eventRetryCount(request *contracts.StepActionEvent) *int {
+	if request.RetryCount == nil {
+		return nil
+	}
+
+	retryCount := int(*request.RetryCount)
+
+	return &retryCount
+}
+
 func (s *DispatcherImpl) handleGetGroupKeyRunStarted(ctx context.Context, request *contracts.GroupKeyActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := ctx.Value("tenant").(*db.TenantModel)
 
@@ -663,75 +1220,155 @@ func (s *DispatcherImpl) handleGetGroupKeyRunFailed(ctx context.Context, request
 	}, nil
 }
 
-func (s *DispatcherImpl) tenantTaskToWorkflowEvent(task *taskqueue.Task, tenantId, workflowRunId string) (*contracts.WorkflowEvent, error) {
-	workflowEvent := &contracts.WorkflowEvent{}
-
-	var stepRunId string
-
-	switch task.ID {
-	case "step-run-started":
-		stepRunId = task.Payload["step_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
-		workflowEvent.ResourceId = stepRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED
-	case "step-run-finished":
-		stepRunId = task.Payload["step_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
-		workflowEvent.ResourceId = stepRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED
-		workflowEvent.EventPayload = task.Payload["step_output_data"].(string)
-	case "step-run-failed":
-		stepRunId = task.Payload["step_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
-		workflowEvent.ResourceId = stepRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED
-		workflowEvent.EventPayload = task.Payload["error"].(string)
-	case "step-run-cancelled":
-		stepRunId = task.Payload["step_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
-		workflowEvent.ResourceId = stepRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED
-	case "step-run-timed-out":
-		stepRunId = task.Payload["step_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
-		workflowEvent.ResourceId = stepRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT
-	case "workflow-run-finished":
-		workflowRunId := task.Payload["workflow_run_id"].(string)
-		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN
-		workflowEvent.ResourceId = workflowRunId
-		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED
-		workflowEvent.Hangup = true
-	}
-
-	if workflowEvent.ResourceType == contracts.ResourceType_RESOURCE_TYPE_STEP_RUN {
-		// determine if this step run matches the workflow run id
-		stepRun, err := s.repo.StepRun().GetStepRunById(tenantId, stepRunId)
+// replayWorkflowEvents sends the persisted events of a workflow run after the cursor of the request. It returns
+// the id of the last replayed event, and whether the workflow run has finished so the subscription should hang up.
+func (s *DispatcherImpl) replayWorkflowEvents(tenantId string, request *contracts.SubscribeToWorkflowEventsRequest, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) (int64, bool, error) {
+	var lastEventId int64
 
-		if err != nil {
-			return nil, err
+	if request.LastEventId != nil {
+		lastEventId = *request.LastEventId
+	}
+
+	records, err := s.repo.WorkflowRun().ListWorkflowRunEvents(tenantId, request.WorkflowRunId, lastEventId)
+
+	if err != nil {
+		return 0, false, fmt.Errorf("could not list workflow run events: %w", err)
+	}
+
+	for _, record := range records {
+		e := toWorkflowEvent(runevents.FromRecord(record))
+
+		if err := stream.Send(e); err != nil {
+			return 0, false, err
 		}
 
-		if stepRun.JobRun().WorkflowRunID != workflowRunId {
-			// this is an expected error, so we don't return it
-			return nil, nil
+		lastEventId = record.Sequence
+
+		if e.Hangup {
+			return lastEventId, true, nil
 		}
+	}
 
-		// attempt to unquote the payload
-		unquoted, err := strconv.Unquote(workflowEvent.EventPayload)
+	// workflow runs which finished before their events were recorded don't have a final event, so the
+	// subscription is hung up if the workflow run is already finished
+	workflowRun, err := s.repo.WorkflowRun().GetWorkflowRunById(tenantId, request.WorkflowRunId)
 
-		if err != nil {
-			unquoted = workflowEvent.EventPayload
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return 0, false, err
+	}
+
+	if err != nil || workflowRun.TenantID != tenantId {
+		return 0, false, status.Errorf(codes.NotFound, "workflow run %s not found", request.WorkflowRunId)
+	}
+
+	if workflowRun.Status == db.WorkflowRunStatusSucceeded || workflowRun.Status == db.WorkflowRunStatusFailed {
+		e := &runevents.Event{
+			WorkflowRunId: request.WorkflowRunId,
+			ResourceType:  runevents.ResourceTypeWorkflowRun,
+			ResourceId:    request.WorkflowRunId,
+			EventType:     runevents.EventTypeCompleted,
+			Timestamp:     workflowRun.UpdatedAt,
+			Hangup:        true,
+		}
+
+		if workflowRun.Status == db.WorkflowRunStatusFailed {
+			e.EventType = runevents.EventTypeFailed
 		}
 
-		workflowEvent.EventPayload = unquoted
-	} else if workflowEvent.ResourceType == contracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN {
-		if workflowEvent.ResourceId != workflowRunId {
-			return nil, nil
+		return lastEventId, true, stream.Send(toWorkflowEvent(e))
+	}
+
+	return lastEventId, false, nil
+}
+
+func (s *DispatcherImpl) tenantTaskToWorkflowEvent(task *taskqueue.Task, workflowRunId string) (*contracts.WorkflowEvent, error) {
+	e, err := runevents.FromTask(task)
+
+	if err != nil || e == nil {
+		return nil, err
+	}
+
+	if e.WorkflowRunId != workflowRunId {
+		// this is an expected case, so we don't return an error
+		return nil, nil
+	}
+
+	return toWorkflowEvent(e), nil
+}
+
+var resourceTypes = map[runevents.ResourceType]contracts.ResourceType{
+	runevents.ResourceTypeStepRun:     contracts.ResourceType_RESOURCE_TYPE_STEP_RUN,
+	runevents.ResourceTypeWorkflowRun: contracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN,
+}
+
+var eventTypes = map[runevents.EventType]contracts.ResourceEventType{
+	runevents.EventTypeStarted:   contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED,
+	runevents.EventTypeCompleted: contracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED,
+	runevents.EventTypeFailed:    contracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED,
+	runevents.EventTypeCancelled: contracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED,
+	runevents.EventTypeTimedOut:  contracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT,
+	runevents.EventTypeProgress:  contracts.ResourceEventType_RESOURCE_EVENT_TYPE_PROGRESS,
+	runevents.EventTypeStream:    contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM,
+}
+
+var contractResourceTypes = map[contracts.ResourceType]runevents.ResourceType{
+	contracts.ResourceType_RESOURCE_TYPE_STEP_RUN:     runevents.ResourceTypeStepRun,
+	contracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN: runevents.ResourceTypeWorkflowRun,
+}
+
+var contractEventTypes = map[contracts.ResourceEventType]runevents.EventType{
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED:   runevents.EventTypeStarted,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED: runevents.EventTypeCompleted,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED:    runevents.EventTypeFailed,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED: runevents.EventTypeCancelled,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT: runevents.EventTypeTimedOut,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_PROGRESS:  runevents.EventTypeProgress,
+	contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM:    runevents.EventTypeStream,
+}
+
+func toWorkflowEvent(e *runevents.Event) *contracts.WorkflowEvent {
+	workflowEvent := &contracts.WorkflowEvent{
+		WorkflowRunId: e.WorkflowRunId,
+		ResourceType:  resourceTypes[e.ResourceType],
+		ResourceId:    e.ResourceId,
+		EventType:     eventTypes[e.EventType],
+		EventPayload:  e.Payload,
+		Hangup:        e.Hangup,
+		EventId:       e.EventId,
+	}
+
+	if !e.Timestamp.IsZero() {
+		workflowEvent.EventTimestamp = timestamppb.New(e.Timestamp)
+	}
+
+	return workflowEvent
+}
+
+// toTenantEventsFilter converts the filters of a tenant subscription request.
+func toTenantEventsFilter(request *contracts.SubscribeToTenantEventsRequest) (*runevents.Filter, error) {
+	filter := &runevents.Filter{
+		WorkflowIds: request.WorkflowIds,
+	}
+
+	for _, contractType := range request.EventTypes {
+		eventType, ok := contractEventTypes[contractType]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %s", contractType)
+		}
+
+		filter.EventTypes = append(filter.EventTypes, eventType)
+	}
+
+	for _, contractType := range request.ResourceTypes {
+		resourceType, ok := contractResourceTypes[contractType]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown resource type %s", contractType)
 		}
 
-		workflowEvent.Hangup = true
+		filter.ResourceTypes = append(filter.ResourceTypes, resourceType)
 	}
 
-	return workflowEvent, nil
+	return filter, nil
 }
@@ -2,6 +2,7 @@ package health
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/leader"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 )
 
@@ -17,12 +19,14 @@ type Health struct {
 
 	repository repository.Repository
 	queue      taskqueue.TaskQueue
+	elector    *leader.Elector
 }
 
-func New(prisma repository.Repository, queue taskqueue.TaskQueue) *Health {
+func New(prisma repository.Repository, queue taskqueue.TaskQueue, elector *leader.Elector) *Health {
 	return &Health{
 		repository: prisma,
 		queue:      queue,
+		elector:    elector,
 	}
 }
 
@@ -46,6 +50,20 @@ func (h *Health) Start() (func() error, error) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	// leaders shows which of the singleton loops running on this instance it leads
+	mux.HandleFunc("/leaders", func(w http.ResponseWriter, r *http.Request) {
+		if h.elector == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(h.elector.Status()); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
 	server := &http.Server{
 		Addr:         ":8733",
 		Handler:      mux,
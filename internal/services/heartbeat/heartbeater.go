@@ -10,6 +10,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/leader"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 )
 
@@ -22,6 +23,7 @@ type HeartbeaterImpl struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	s    gocron.Scheduler
+	le   *leader.Elector
 }
 
 type HeartbeaterOpt func(*HeartbeaterOpts)
@@ -30,6 +32,7 @@ type HeartbeaterOpts struct {
 	tq   taskqueue.TaskQueue
 	l    *zerolog.Logger
 	repo repository.Repository
+	le   *leader.Elector
 }
 
 func defaultHeartbeaterOpts() *HeartbeaterOpts {
@@ -57,6 +60,14 @@ func WithLogger(l *zerolog.Logger) HeartbeaterOpt {
 	}
 }
 
+// WithLeaderElector makes the stale ticker removal only run on the instance which leads it. Without an elector, it
+// runs on every heartbeater.
+func WithLeaderElector(le *leader.Elector) HeartbeaterOpt {
+	return func(opts *HeartbeaterOpts) {
+		opts.le = le
+	}
+}
+
 func New(fs ...HeartbeaterOpt) (*HeartbeaterImpl, error) {
 	opts := defaultHeartbeaterOpts()
 
@@ -86,6 +97,7 @@ func New(fs ...HeartbeaterOpt) (*HeartbeaterImpl, error) {
 		l:    opts.l,
 		repo: opts.repo,
 		s:    s,
+		le:   opts.le,
 	}, nil
 }
 
@@ -95,7 +107,7 @@ func (t *HeartbeaterImpl) Start() (func() error, error) {
 	_, err := t.s.NewJob(
 		gocron.DurationJob(time.Second*5),
 		gocron.NewTask(
-			t.removeStaleTickers(),
+			t.le.Lead("heartbeater:remove-stale-tickers", t.removeStaleTickers()),
 		),
 	)
 
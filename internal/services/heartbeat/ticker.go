@@ -3,7 +3,6 @@ package heartbeat
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
@@ -24,32 +23,14 @@ func (t *HeartbeaterImpl) removeStaleTickers() func() {
 				return fmt.Errorf("could not get ticker %s: %w", tickerId, err)
 			}
 
-			// reschedule crons
+			// reschedule crons. timeouts and scheduled workflows are stored in the database and polled by all
+			// tickers, so they do not need to be reassigned.
 			err = t.rescheduleCrons(ticker, getValidTickerId())
 
 			if err != nil {
 				return fmt.Errorf("could not reschedule crons for ticker %s: %w", ticker.ID, err)
 			}
 
-			// reschedule schedules
-			err = t.rescheduleWorkflows(ticker, getValidTickerId())
-
-			if err != nil {
-				return fmt.Errorf("could not reschedule workflows for ticker %s: %w", ticker.ID, err)
-			}
-
-			// send a task to the job processing queue that the ticker is removed
-			err = t.tq.AddTask(
-				context.Background(),
-				taskqueue.JOB_PROCESSING_QUEUE,
-				tickerRemoved(ticker.ID),
-			)
-
-			if err != nil {
-				t.l.Err(err).Msg("could not add ticker removed task")
-				return err
-			}
-
 			return nil
 		}
 
@@ -61,20 +42,6 @@ func (t *HeartbeaterImpl) removeStaleTickers() func() {
 	}
 }
 
-func tickerRemoved(tickerId string) *taskqueue.Task {
-	payload, _ := datautils.ToJSONMap(tasktypes.RemoveTickerTaskPayload{
-		TickerId: tickerId,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.RemoveTickerTaskMetadata{})
-
-	return &taskqueue.Task{
-		ID:       "ticker-removed",
-		Payload:  payload,
-		Metadata: metadata,
-	}
-}
-
 func (t *HeartbeaterImpl) rescheduleCrons(ticker *db.TickerModel, validTickerId string) error {
 	for _, cronTrigger := range ticker.Crons() {
 		cronTriggerCp := cronTrigger
@@ -97,7 +64,7 @@ func (t *HeartbeaterImpl) rescheduleCrons(ticker *db.TickerModel, validTickerId
 		// send to task queue
 		err = t.tq.AddTask(
 			context.TODO(),
-			taskqueue.QueueTypeFromTickerID(ticker.ID),
+			taskqueue.QueueTypeFromTickerID(validTickerId),
 			task,
 		)
 
@@ -109,48 +76,11 @@ func (t *HeartbeaterImpl) rescheduleCrons(ticker *db.TickerModel, validTickerId
 	return nil
 }
 
-func (t *HeartbeaterImpl) rescheduleWorkflows(ticker *db.TickerModel, validTickerId string) error {
-	for _, schedule := range ticker.Scheduled() {
-		scheduleCp := schedule
-
-		// only send to task queue if the trigger is in the future
-		if scheduleCp.TriggerAt.After(time.Now().UTC()) {
-
-			_, err := t.repo.Ticker().AddScheduledWorkflow(
-				validTickerId,
-				&scheduleCp,
-			)
-
-			if err != nil {
-				return err
-			}
-
-			task, err := workflowScheduleTask(validTickerId, &scheduleCp, scheduleCp.Parent())
-
-			if err != nil {
-				return err
-			}
-
-			// send to task queue
-			err = t.tq.AddTask(
-				context.TODO(),
-				taskqueue.QueueTypeFromTickerID(ticker.ID),
-				task,
-			)
-
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
 func cronScheduleTask(tickerId string, cronTriggerRef *db.WorkflowTriggerCronRefModel, workflowVersion *db.WorkflowVersionModel) (*taskqueue.Task, error) {
 	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleCronTaskPayload{
 		CronParentId:      cronTriggerRef.ParentID,
 		Cron:              cronTriggerRef.Cron,
+		Timezone:          cronTriggerRef.Timezone,
 		WorkflowVersionId: workflowVersion.ID,
 	})
 
@@ -164,21 +94,3 @@ func cronScheduleTask(tickerId string, cronTriggerRef *db.WorkflowTriggerCronRef
 		Metadata: metadata,
 	}, nil
 }
-
-func workflowScheduleTask(tickerId string, workflowTriggerRef *db.WorkflowTriggerScheduledRefModel, workflowVersion *db.WorkflowVersionModel) (*taskqueue.Task, error) {
-	payload, _ := datautils.ToJSONMap(tasktypes.ScheduleWorkflowTaskPayload{
-		ScheduledWorkflowId: workflowTriggerRef.ID,
-		TriggerAt:           workflowTriggerRef.TriggerAt.Format(time.RFC3339),
-		WorkflowVersionId:   workflowVersion.ID,
-	})
-
-	metadata, _ := datautils.ToJSONMap(tasktypes.ScheduleWorkflowTaskMetadata{
-		TenantId: workflowVersion.Workflow().TenantID,
-	})
-
-	return &taskqueue.Task{
-		ID:       "schedule-workflow",
-		Payload:  payload,
-		Metadata: metadata,
-	}, nil
-}
@@ -0,0 +1,228 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/logger"
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// campaignTimeout bounds the database calls which are made when campaigning for a loop.
+const campaignTimeout = 5 * time.Second
+
+// Elector elects a single leader across engine instances for each loop which should only run on one instance,
+// using Postgres advisory locks. Each loop is elected separately, so the loops can be spread across instances.
+type Elector struct {
+	repo repository.LeaderRepository
+	l    *zerolog.Logger
+
+	instanceId string
+
+	mu       sync.Mutex
+	leases   map[string]repository.LeaderLease
+	loops    map[string]bool
+	resigned bool
+}
+
+type ElectorOpt func(*ElectorOpts)
+
+type ElectorOpts struct {
+	repo       repository.LeaderRepository
+	l          *zerolog.Logger
+	instanceId string
+}
+
+func defaultElectorOpts() *ElectorOpts {
+	logger := logger.NewDefaultLogger("leader")
+	return &ElectorOpts{
+		l:          &logger,
+		instanceId: uuid.New().String(),
+	}
+}
+
+func WithRepository(r repository.LeaderRepository) ElectorOpt {
+	return func(opts *ElectorOpts) {
+		opts.repo = r
+	}
+}
+
+func WithLogger(l *zerolog.Logger) ElectorOpt {
+	return func(opts *ElectorOpts) {
+		opts.l = l
+	}
+}
+
+func WithInstanceId(instanceId string) ElectorOpt {
+	return func(opts *ElectorOpts) {
+		opts.instanceId = instanceId
+	}
+}
+
+func New(fs ...ElectorOpt) (*Elector, error) {
+	opts := defaultElectorOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	newLogger := opts.l.With().Str("service", "leader").Str("instance_id", opts.instanceId).Logger()
+	opts.l = &newLogger
+
+	return &Elector{
+		repo:       opts.repo,
+		l:          opts.l,
+		instanceId: opts.instanceId,
+		leases:     map[string]repository.LeaderLease{},
+		loops:      map[string]bool{},
+	}, nil
+}
+
+// Lead returns a function which only runs fn while this instance leads the loop with the given name. It is meant
+// to wrap the task of a scheduled job: each run campaigns for the loop if this instance does not lead it, so another
+// instance takes over on its next run when the leader resigns or dies. If the elector is nil, fn always runs.
+func (e *Elector) Lead(name string, fn func()) func() {
+	if e == nil {
+		return fn
+	}
+
+	e.mu.Lock()
+	e.loops[name] = true
+	e.mu.Unlock()
+
+	return func() {
+		if e.campaign(name) {
+			fn()
+		}
+	}
+}
+
+// campaign returns true if this instance leads the loop, acquiring the lock for the loop if it is free. The database
+// calls are made without holding e.mu, which is only taken to read and update the leases.
+func (e *Elector) campaign(name string) bool {
+	e.mu.Lock()
+	lease, ok := e.leases[name]
+	resigned := e.resigned
+	e.mu.Unlock()
+
+	if resigned {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), campaignTimeout)
+	defer cancel()
+
+	if ok {
+		if lease.Alive(ctx) {
+			return true
+		}
+
+		e.l.Warn().Msgf("lost leadership of %s", name)
+
+		e.mu.Lock()
+		if e.leases[name] == lease {
+			delete(e.leases, name)
+		}
+		e.mu.Unlock()
+
+		if err := lease.Release(ctx); err != nil {
+			e.l.Err(err).Msgf("could not release lost lease of %s", name)
+		}
+	}
+
+	lease, err := e.repo.TryAcquire(ctx, name)
+
+	if err != nil {
+		e.l.Err(err).Msgf("could not campaign for %s", name)
+		return false
+	}
+
+	if lease == nil {
+		return false
+	}
+
+	e.mu.Lock()
+	_, leading := e.leases[name]
+	resigned = e.resigned
+
+	if !resigned && !leading {
+		e.leases[name] = lease
+	}
+	e.mu.Unlock()
+
+	// this instance resigned or another campaign for the loop won while the lock was being acquired, so the new
+	// lease is not needed
+	if resigned || leading {
+		if err := lease.Release(ctx); err != nil {
+			e.l.Err(err).Msgf("could not release unneeded lease of %s", name)
+		}
+
+		return !resigned
+	}
+
+	e.l.Info().Msgf("elected leader of %s", name)
+
+	return true
+}
+
+// Resign releases all loops which this instance leads so other instances take them over, and stops this instance
+// from campaigning. It should be called on shutdown, after the services which use the elector have stopped.
+func (e *Elector) Resign() error {
+	e.mu.Lock()
+	e.resigned = true
+	leases := e.leases
+	e.leases = map[string]repository.LeaderLease{}
+	e.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), campaignTimeout)
+	defer cancel()
+
+	var result *multierror.Error
+
+	for name, lease := range leases {
+		if err := lease.Release(ctx); err != nil {
+			result = multierror.Append(result, fmt.Errorf("could not release lease of %s: %w", name, err))
+			continue
+		}
+
+		e.l.Info().Msgf("resigned leadership of %s", name)
+	}
+
+	return result.ErrorOrNil()
+}
+
+type Status struct {
+	// InstanceId is the id of this instance.
+	InstanceId string `json:"instance_id"`
+
+	// Loops is whether this instance leads each loop which runs on it.
+	Loops map[string]bool `json:"loops"`
+}
+
+// Status returns which of the loops running on this instance it leads.
+func (e *Elector) Status() *Status {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	status := &Status{
+		InstanceId: e.instanceId,
+		Loops:      make(map[string]bool, len(e.loops)),
+	}
+
+	for name := range e.loops {
+		_, leading := e.leases[name]
+		status.Loops[name] = leading
+	}
+
+	return status
+}
@@ -0,0 +1,201 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+)
+
+// fakeLocks is an in-memory version of the advisory locks which are shared by the instances.
+type fakeLocks struct {
+	mu     sync.Mutex
+	leases map[string]*fakeLease
+}
+
+func newFakeLocks() *fakeLocks {
+	return &fakeLocks{
+		leases: map[string]*fakeLease{},
+	}
+}
+
+func (f *fakeLocks) TryAcquire(ctx context.Context, name string) (repository.LeaderLease, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if lease, ok := f.leases[name]; ok && lease.alive {
+		return nil, nil
+	}
+
+	lease := &fakeLease{
+		locks: f,
+		name:  name,
+		alive: true,
+	}
+
+	f.leases[name] = lease
+
+	return lease, nil
+}
+
+type fakeLease struct {
+	locks *fakeLocks
+	name  string
+	alive bool
+}
+
+func (l *fakeLease) Alive(ctx context.Context) bool {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	return l.alive
+}
+
+func (l *fakeLease) Release(ctx context.Context) error {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	l.alive = false
+
+	return nil
+}
+
+// lose simulates losing the connection which holds the lock.
+func (l *fakeLease) lose() {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	l.alive = false
+}
+
+func newTestElector(t *testing.T, locks *fakeLocks, instanceId string) *Elector {
+	t.Helper()
+
+	e, err := New(
+		WithRepository(locks),
+		WithInstanceId(instanceId),
+	)
+
+	require.NoError(t, err)
+
+	return e
+}
+
+func TestLeadRunsOnOneInstance(t *testing.T) {
+	locks := newFakeLocks()
+	a := newTestElector(t, locks, "a")
+	b := newTestElector(t, locks, "b")
+
+	runs := map[string]int{}
+
+	runA := a.Lead("loop", func() { runs["a"]++ })
+	runB := b.Lead("loop", func() { runs["b"]++ })
+
+	for i := 0; i < 3; i++ {
+		runA()
+		runB()
+	}
+
+	assert.Equal(t, map[string]int{"a": 3}, runs)
+	assert.Equal(t, map[string]bool{"loop": true}, a.Status().Loops)
+	assert.Equal(t, map[string]bool{"loop": false}, b.Status().Loops)
+
+	// a hands over on shutdown and stops campaigning
+	require.NoError(t, a.Resign())
+
+	runA()
+	runB()
+
+	assert.Equal(t, map[string]int{"a": 3, "b": 1}, runs)
+	assert.Equal(t, map[string]bool{"loop": true}, b.Status().Loops)
+}
+
+func TestLeadTakesOverLostLease(t *testing.T) {
+	locks := newFakeLocks()
+	a := newTestElector(t, locks, "a")
+	b := newTestElector(t, locks, "b")
+
+	runs := map[string]int{}
+
+	runA := a.Lead("loop", func() { runs["a"]++ })
+	runB := b.Lead("loop", func() { runs["b"]++ })
+
+	runA()
+
+	// a's connection is lost, which releases the lock
+	locks.leases["loop"].lose()
+
+	runB()
+	runA()
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, runs)
+	assert.Equal(t, map[string]bool{"loop": false}, a.Status().Loops)
+}
+
+func TestLeadElectsLoopsSeparately(t *testing.T) {
+	locks := newFakeLocks()
+	a := newTestElector(t, locks, "a")
+	b := newTestElector(t, locks, "b")
+
+	runs := map[string]int{}
+
+	a.Lead("first", func() { runs["a:first"]++ })()
+	b.Lead("second", func() { runs["b:second"]++ })()
+	b.Lead("first", func() { runs["b:first"]++ })()
+
+	assert.Equal(t, map[string]int{"a:first": 1, "b:second": 1}, runs)
+}
+
+func TestLeadWithoutElector(t *testing.T) {
+	var e *Elector
+
+	runs := 0
+
+	e.Lead("loop", func() { runs++ })()
+
+	assert.Equal(t, 1, runs)
+}
+
+// resigningLocks resigns the elector while it acquires a lock, as a shutdown which races a campaign would.
+type resigningLocks struct {
+	*fakeLocks
+	e *Elector
+}
+
+func (r *resigningLocks) TryAcquire(ctx context.Context, name string) (repository.LeaderLease, error) {
+	lease, err := r.fakeLocks.TryAcquire(ctx, name)
+
+	if err == nil {
+		err = r.e.Resign()
+	}
+
+	return lease, err
+}
+
+func TestLeadReleasesLeaseAcquiredWhileResigning(t *testing.T) {
+	locks := newFakeLocks()
+	resigning := &resigningLocks{fakeLocks: locks}
+
+	a, err := New(
+		WithRepository(resigning),
+		WithInstanceId("a"),
+	)
+
+	require.NoError(t, err)
+
+	resigning.e = a
+
+	b := newTestElector(t, locks, "b")
+
+	runs := map[string]int{}
+
+	a.Lead("loop", func() { runs["a"]++ })()
+	b.Lead("loop", func() { runs["b"]++ })()
+
+	assert.Equal(t, map[string]int{"b": 1}, runs)
+	assert.Equal(t, map[string]bool{"loop": false}, a.Status().Loops)
+}
@@ -0,0 +1,11 @@
+package defaults
+
+// DefaultCronMaxMisfires is the number of missed fires which are run with the RUN_ALL misfire policy if the cron
+// does not set a maximum.
+const DefaultCronMaxMisfires = 10
+
+// DefaultCronNextFireTimes is the number of upcoming fire times which are returned for a cron.
+const DefaultCronNextFireTimes = 5
+
+// MaxCronNextFireTimes is the largest number of upcoming fire times which can be previewed for a cron.
+const MaxCronNextFireTimes = 100
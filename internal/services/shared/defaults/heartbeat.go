@@ -1,6 +1,27 @@
 package defaults
 
+import "time"
+
 const (
 	HeartbeatInterval      = "5s"
 	StaleHeartbeatInterval = "15s"
 )
+
+const (
+	// WorkerHeartbeatTimeout is the time after which a worker which sends its own heartbeats is marked inactive if
+	// the dispatcher did not receive a heartbeat.
+	WorkerHeartbeatTimeout = 30 * time.Second
+
+	// LostStepRunGracePeriod is the time after the last update of a step run before it is reconciled when a
+	// worker does not report it as in flight, so step runs which are still being sent to or finished by the
+	// worker are not reconciled.
+	LostStepRunGracePeriod = 30 * time.Second
+
+	// AssignmentAckTimeout is the time a worker which acknowledges assignments has to acknowledge a step run
+	// before the step run is reassigned.
+	AssignmentAckTimeout = 5 * time.Second
+)
+
+// MaxShutdownRequeues is the number of times a step run is requeued without counting against the step's retries
+// because its worker shut down. Later failures on shutdown count as retries.
+const MaxShutdownRequeues = 5
@@ -0,0 +1,148 @@
+package runevents
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+)
+
+// Filter selects the events of a tenant subscription. Empty fields match all events, except that stream events
+// are only matched if EventTypeStream is one of the event types.
+type Filter struct {
+	// (optional) the workflows whose runs to match
+	WorkflowIds []string
+
+	// (optional) the event types to match
+	EventTypes []EventType
+
+	// (optional) the resource types to match
+	ResourceTypes []ResourceType
+}
+
+// Resolver matches the workflow run events which are published to the subscribers of a tenant against filters.
+type Resolver struct {
+	repo repository.Repository
+	l    *zerolog.Logger
+
+	// workflowRunWorkflows caches the workflow of workflow runs, keyed by tenant and workflow run
+	workflowRunWorkflows *lru.Cache[string, string]
+}
+
+func NewResolver(repo repository.Repository, l *zerolog.Logger) *Resolver {
+	workflowRunWorkflows, _ := lru.New[string, string](2000) // nolint: errcheck
+
+	return &Resolver{
+		repo:                 repo,
+		l:                    l,
+		workflowRunWorkflows: workflowRunWorkflows,
+	}
+}
+
+// WorkflowId returns the workflow of a workflow run.
+func (r *Resolver) WorkflowId(tenantId, workflowRunId string) (string, error) {
+	cacheKey := fmt.Sprintf("%s/%s", tenantId, workflowRunId)
+
+	if workflowId, ok := r.workflowRunWorkflows.Get(cacheKey); ok {
+		return workflowId, nil
+	}
+
+	workflowRun, err := r.repo.WorkflowRun().GetWorkflowRunById(tenantId, workflowRunId)
+
+	if err != nil {
+		return "", fmt.Errorf("could not get workflow run %s: %w", workflowRunId, err)
+	}
+
+	if workflowRun.TenantID != tenantId {
+		return "", fmt.Errorf("workflow run %s does not belong to tenant %s", workflowRunId, tenantId)
+	}
+
+	workflowId := workflowRun.WorkflowVersion().WorkflowID
+
+	r.workflowRunWorkflows.Add(cacheKey, workflowId)
+
+	return workflowId, nil
+}
+
+// Matches returns true if the event matches the filter.
+func (r *Resolver) Matches(tenantId string, filter *Filter, e *Event) (bool, error) {
+	if len(filter.EventTypes) == 0 && e.EventType == EventTypeStream {
+		return false, nil
+	}
+
+	if len(filter.EventTypes) > 0 && !slices.Contains(filter.EventTypes, e.EventType) {
+		return false, nil
+	}
+
+	if len(filter.ResourceTypes) > 0 && !slices.Contains(filter.ResourceTypes, e.ResourceType) {
+		return false, nil
+	}
+
+	if len(filter.WorkflowIds) > 0 {
+		workflowId, err := r.WorkflowId(tenantId, e.WorkflowRunId)
+
+		if err != nil {
+			return false, err
+		}
+
+		return slices.Contains(filter.WorkflowIds, workflowId), nil
+	}
+
+	return true, nil
+}
+
+// Subscribe calls send for each event of the tenant which matches the filter, in the order the events are
+// received, until the context is cancelled or send returns an error.
+func (r *Resolver) Subscribe(ctx context.Context, tq taskqueue.TaskQueue, tenantId string, filter *Filter, send func(e *Event) error) error {
+	q, err := taskqueue.TenantEventConsumerQueue(tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	cleanupQueue, taskChan, err := tq.Subscribe(q)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := cleanupQueue(); err != nil {
+			r.l.Error().Err(err).Msg("could not cleanup queue")
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case task := <-taskChan:
+			e, err := FromTask(task)
+
+			if err != nil {
+				r.l.Error().Err(err).Msgf("could not convert task to workflow run event")
+				continue
+			} else if e == nil {
+				continue
+			}
+
+			matches, err := r.Matches(tenantId, filter, e)
+
+			if err != nil {
+				r.l.Error().Err(err).Msgf("could not filter workflow run event")
+				continue
+			} else if !matches {
+				continue
+			}
+
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
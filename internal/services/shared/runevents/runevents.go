@@ -0,0 +1,203 @@
+package runevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+)
+
+// eventTaskId is the id of the tasks which publish recorded workflow run events to the subscribers of a tenant.
+const eventTaskId = "workflow-run-event"
+
+type ResourceType string
+
+const (
+	ResourceTypeStepRun     ResourceType = "STEP_RUN"
+	ResourceTypeWorkflowRun ResourceType = "WORKFLOW_RUN"
+)
+
+type EventType string
+
+const (
+	EventTypeStarted   EventType = "STARTED"
+	EventTypeCompleted EventType = "COMPLETED"
+	EventTypeFailed    EventType = "FAILED"
+	EventTypeCancelled EventType = "CANCELLED"
+	EventTypeTimedOut  EventType = "TIMED_OUT"
+	EventTypeProgress  EventType = "PROGRESS"
+
+	// EventTypeStream is a chunk of a step run's partial output. Stream events are not recorded.
+	EventTypeStream EventType = "STREAM"
+)
+
+// Event is an event of a workflow run which is published to the subscribers of a tenant.
+type Event struct {
+	// WorkflowRunId is the workflow run of the event.
+	WorkflowRunId string
+
+	// EventId is the sequence of the event within its workflow run, or 0 for stream events, which are not recorded.
+	EventId int64
+
+	ResourceType ResourceType
+	ResourceId   string
+	EventType    EventType
+
+	// Payload is the step output, the error or the progress of a step run. For stream events, this is the
+	// base64-encoded chunk.
+	Payload string
+
+	// Timestamp is the time of the event, if known.
+	Timestamp time.Time
+
+	// Hangup is true if this is the last event of the workflow run.
+	Hangup bool
+}
+
+// FromTask returns the workflow run event for a task which is published to tenant subscribers, or nil if the task
+// is not a workflow run event. Tasks of step run and workflow run updates are published to tenant subscribers as
+// well, but they are only events once they were accepted and recorded, see Recorder.
+func FromTask(task *taskqueue.Task) (*Event, error) {
+	switch task.ID {
+	case eventTaskId:
+		e := &Event{
+			WorkflowRunId: stringFromPayload(task, "workflow_run_id"),
+			ResourceType:  ResourceType(stringFromPayload(task, "resource_type")),
+			ResourceId:    stringFromPayload(task, "resource_id"),
+			EventType:     EventType(stringFromPayload(task, "event_type")),
+			Payload:       stringFromPayload(task, "payload"),
+		}
+
+		// the event id is decoded as a float when the task is deserialized
+		switch id := task.Payload["event_id"].(type) {
+		case float64:
+			e.EventId = int64(id)
+		case int64:
+			e.EventId = id
+		}
+
+		e.Timestamp, _ = time.Parse(time.RFC3339Nano, stringFromPayload(task, "timestamp"))
+		e.Hangup = isHangup(e)
+
+		return e, nil
+	case "step-run-stream-event":
+		e := &Event{
+			ResourceType: ResourceTypeStepRun,
+			EventType:    EventTypeStream,
+		}
+
+		// stream events carry their workflow run, and the message is base64-encoded when the task is serialized
+		e.WorkflowRunId = stringFromPayload(task, "workflow_run_id")
+		e.ResourceId = stringFromPayload(task, "step_run_id")
+		e.Payload = stringFromPayload(task, "message")
+
+		if createdAt := stringFromPayload(task, "created_at"); createdAt != "" {
+			e.Timestamp, _ = time.Parse(time.RFC3339Nano, createdAt)
+		}
+
+		return e, nil
+	default:
+		return nil, nil
+	}
+}
+
+// ToTask returns the task which publishes a recorded event to the subscribers of a tenant.
+func ToTask(tenantId string, e *Event) *taskqueue.Task {
+	return &taskqueue.Task{
+		ID: eventTaskId,
+		Payload: map[string]interface{}{
+			"workflow_run_id": e.WorkflowRunId,
+			"event_id":        e.EventId,
+			"resource_type":   string(e.ResourceType),
+			"resource_id":     e.ResourceId,
+			"event_type":      string(e.EventType),
+			"payload":         e.Payload,
+			"timestamp":       e.Timestamp.Format(time.RFC3339Nano),
+		},
+		Metadata: map[string]interface{}{
+			"tenant_id": tenantId,
+		},
+	}
+}
+
+func stringFromPayload(task *taskqueue.Task, key string) string {
+	v, _ := task.Payload[key].(string)
+	return v
+}
+
+// isHangup returns true if the event is the last event of its workflow run.
+func isHangup(e *Event) bool {
+	return e.ResourceType == ResourceTypeWorkflowRun && e.EventType != EventTypeStarted
+}
+
+// FromRecord returns the event for an event which was recorded in the event log of a workflow run.
+func FromRecord(record *dbsqlc.WorkflowRunEvent) *Event {
+	e := &Event{
+		WorkflowRunId: sqlchelpers.UUIDToStr(record.WorkflowRunId),
+		EventId:       record.Sequence,
+		ResourceType:  ResourceType(record.ResourceType),
+		ResourceId:    sqlchelpers.UUIDToStr(record.ResourceId),
+		EventType:     EventType(record.EventType),
+		Payload:       record.Payload.String,
+		Timestamp:     record.CreatedAt.Time,
+	}
+
+	e.Hangup = isHangup(e)
+
+	return e
+}
+
+// Recorder appends workflow run events to the event log of their workflow run and publishes them to the subscribers
+// of the tenant. Events are recorded once the update which they belong to was accepted, so updates which are dropped,
+// such as stale completions, are not recorded.
+type Recorder struct {
+	repo repository.WorkflowRunRepository
+	tq   taskqueue.TaskQueue
+	l    *zerolog.Logger
+}
+
+func NewRecorder(repo repository.WorkflowRunRepository, tq taskqueue.TaskQueue, l *zerolog.Logger) *Recorder {
+	return &Recorder{
+		repo: repo,
+		tq:   tq,
+		l:    l,
+	}
+}
+
+// Record records an event of a step run or workflow run. The update which the event belongs to was already
+// accepted, so errors are logged rather than returned.
+func (r *Recorder) Record(ctx context.Context, tenantId string, resourceType ResourceType, resourceId string, eventType EventType, payload string) {
+	opts := &repository.CreateWorkflowRunEventOpts{
+		ResourceType: dbsqlc.WorkflowRunEventResourceType(resourceType),
+		ResourceId:   resourceId,
+		EventType:    dbsqlc.WorkflowRunEventType(eventType),
+	}
+
+	if payload != "" {
+		opts.Payload = &payload
+	}
+
+	record, err := r.repo.CreateWorkflowRunEvent(tenantId, opts)
+
+	if err != nil {
+		r.l.Error().Err(err).Msgf("could not record %s event of %s %s", eventType, resourceType, resourceId)
+		return
+	}
+
+	q, err := taskqueue.TenantEventConsumerQueue(tenantId)
+
+	if err != nil {
+		r.l.Error().Err(err).Msgf("could not get event queue of tenant %s", tenantId)
+		return
+	}
+
+	// the event was recorded, so subscribers which miss it can still replay it
+	if err := r.tq.AddTask(ctx, q, ToTask(tenantId, FromRecord(record))); err != nil {
+		r.l.Error().Err(err).Msgf("could not publish %s event of %s %s", eventType, resourceType, resourceId)
+	}
+}
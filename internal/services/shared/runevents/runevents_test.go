@@ -0,0 +1,160 @@
+package runevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/taskqueue"
+)
+
+func TestFromTask(t *testing.T) {
+	timestamp := time.Date(2024, 3, 12, 4, 23, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		task     *taskqueue.Task
+		expected *Event
+	}{
+		{
+			name: "recorded step run completed",
+			task: ToTask("tenant", &Event{
+				WorkflowRunId: "workflow-run",
+				EventId:       3,
+				ResourceType:  ResourceTypeStepRun,
+				ResourceId:    "step-run",
+				EventType:     EventTypeCompleted,
+				Payload:       `{"ok":true}`,
+				Timestamp:     timestamp,
+			}),
+			expected: &Event{
+				WorkflowRunId: "workflow-run",
+				EventId:       3,
+				ResourceType:  ResourceTypeStepRun,
+				ResourceId:    "step-run",
+				EventType:     EventTypeCompleted,
+				Payload:       `{"ok":true}`,
+				Timestamp:     timestamp,
+			},
+		},
+		{
+			name: "recorded workflow run failed",
+			task: ToTask("tenant", &Event{
+				WorkflowRunId: "workflow-run",
+				EventId:       5,
+				ResourceType:  ResourceTypeWorkflowRun,
+				ResourceId:    "workflow-run",
+				EventType:     EventTypeFailed,
+				Timestamp:     timestamp,
+			}),
+			expected: &Event{
+				WorkflowRunId: "workflow-run",
+				EventId:       5,
+				ResourceType:  ResourceTypeWorkflowRun,
+				ResourceId:    "workflow-run",
+				EventType:     EventTypeFailed,
+				Timestamp:     timestamp,
+				Hangup:        true,
+			},
+		},
+		{
+			name: "event id decoded as a float",
+			task: &taskqueue.Task{
+				ID: eventTaskId,
+				Payload: map[string]interface{}{
+					"workflow_run_id": "workflow-run",
+					"event_id":        float64(7),
+					"resource_type":   "WORKFLOW_RUN",
+					"resource_id":     "workflow-run",
+					"event_type":      "STARTED",
+					"timestamp":       timestamp.Format(time.RFC3339Nano),
+				},
+				Metadata: map[string]interface{}{"tenant_id": "tenant"},
+			},
+			expected: &Event{
+				WorkflowRunId: "workflow-run",
+				EventId:       7,
+				ResourceType:  ResourceTypeWorkflowRun,
+				ResourceId:    "workflow-run",
+				EventType:     EventTypeStarted,
+				Timestamp:     timestamp,
+			},
+		},
+		{
+			name: "step run stream event",
+			task: &taskqueue.Task{
+				ID: "step-run-stream-event",
+				Payload: map[string]interface{}{
+					"workflow_run_id": "workflow-run",
+					"step_run_id":     "step-run",
+					"message":         "Y2h1bms=",
+					"created_at":      timestamp.Format(time.RFC3339Nano),
+				},
+				Metadata: map[string]interface{}{"tenant_id": "tenant"},
+			},
+			expected: &Event{
+				WorkflowRunId: "workflow-run",
+				ResourceType:  ResourceTypeStepRun,
+				ResourceId:    "step-run",
+				EventType:     EventTypeStream,
+				Payload:       "Y2h1bms=",
+				Timestamp:     timestamp,
+			},
+		},
+		{
+			// step run updates are only events once the jobs controller has accepted and recorded them
+			name: "step run update which was not recorded",
+			task: &taskqueue.Task{
+				ID:       "step-run-finished",
+				Payload:  map[string]interface{}{"step_run_id": "step-run", "step_output_data": `"{\"ok\":true}"`},
+				Metadata: map[string]interface{}{"tenant_id": "tenant"},
+			},
+		},
+		{
+			name: "not a workflow run event",
+			task: &taskqueue.Task{
+				ID:       "step-run-assigned",
+				Payload:  map[string]interface{}{"step_run_id": "step-run"},
+				Metadata: map[string]interface{}{"tenant_id": "tenant"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := FromTask(tt.task)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, e)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	r := &Resolver{}
+
+	started := &Event{ResourceType: ResourceTypeWorkflowRun, EventType: EventTypeStarted}
+	stream := &Event{ResourceType: ResourceTypeStepRun, EventType: EventTypeStream}
+
+	tests := []struct {
+		name     string
+		filter   *Filter
+		event    *Event
+		expected bool
+	}{
+		{"empty filter", &Filter{}, started, true},
+		{"empty filter skips stream events", &Filter{}, stream, false},
+		{"stream events are matched if requested", &Filter{EventTypes: []EventType{EventTypeStream}}, stream, true},
+		{"event type", &Filter{EventTypes: []EventType{EventTypeCompleted, EventTypeFailed}}, started, false},
+		{"resource type", &Filter{ResourceTypes: []ResourceType{ResourceTypeStepRun}}, started, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := r.Matches("tenant", tt.filter, tt.event)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+}
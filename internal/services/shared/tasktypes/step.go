@@ -31,6 +31,15 @@ type StepRunAssignedTaskMetadata struct {
 	DispatcherId string `json:"dispatcher_id" validate:"required,uuid"`
 }
 
+type StepRunsAssignedTaskPayload struct {
+	Assignments []StepRunAssignedTaskPayload `json:"assignments" validate:"required,min=1,dive"`
+}
+
+type StepRunsAssignedTaskMetadata struct {
+	TenantId     string `json:"tenant_id" validate:"required,uuid"`
+	DispatcherId string `json:"dispatcher_id" validate:"required,uuid"`
+}
+
 type StepRunCancelledTaskPayload struct {
 	StepRunId       string `json:"step_run_id" validate:"required,uuid"`
 	WorkerId        string `json:"worker_id" validate:"required,uuid"`
@@ -72,6 +81,10 @@ type StepRunFinishedTaskPayload struct {
 	StepRunId      string `json:"step_run_id" validate:"required,uuid"`
 	FinishedAt     string `json:"finished_at" validate:"required"`
 	StepOutputData string `json:"step_output_data"`
+
+	// (optional) the attempt which finished the step run
+	WorkerId   string `json:"worker_id,omitempty" validate:"omitempty,uuid"`
+	RetryCount *int   `json:"retry_count,omitempty"`
 }
 
 type StepRunFinishedTaskMetadata struct {
@@ -82,12 +95,41 @@ type StepRunFailedTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	FailedAt  string `json:"failed_at" validate:"required"`
 	Error     string `json:"error" validate:"required"`
+
+	// (optional) the attempt which failed
+	WorkerId   string `json:"worker_id,omitempty" validate:"omitempty,uuid"`
+	RetryCount *int   `json:"retry_count,omitempty"`
+
+	// (optional) whether the step run failed because its worker shut down
+	WorkerShutdown bool `json:"worker_shutdown,omitempty"`
 }
 
 type StepRunFailedTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
 
+type StepRunProgressTaskPayload struct {
+	StepRunId  string  `json:"step_run_id" validate:"required,uuid"`
+	ReportedAt string  `json:"reported_at" validate:"required"`
+	Progress   int     `json:"progress" validate:"min=0,max=100"`
+	Message    *string `json:"message,omitempty"`
+}
+
+type StepRunProgressTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+type StepRunStreamEventTaskPayload struct {
+	StepRunId     string `json:"step_run_id" validate:"required,uuid"`
+	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
+	CreatedAt     string `json:"created_at" validate:"required"`
+	Message       []byte `json:"message"`
+}
+
+type StepRunStreamEventTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
 type StepRunTimedOutTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	JobRunId  string `json:"job_run_id" validate:"required,uuid"`
@@ -103,6 +145,9 @@ type StepRunRetryTaskPayload struct {
 
 	// optional - if not provided, the step run will be retried with the same input
 	InputData string `json:"input_data,omitempty"`
+
+	// optional - if set, the retry does not count against the step's retries
+	KeepRetryCount bool `json:"keep_retry_count,omitempty"`
 }
 
 type StepRunRetryTaskMetadata struct {
@@ -126,10 +171,21 @@ func TenantToStepRunRequeueTask(tenant db.TenantModel) *taskqueue.Task {
 }
 
 func StepRunRetryToTask(stepRun *db.StepRunModel, inputData []byte) *taskqueue.Task {
+	return stepRunRetryToTask(stepRun, inputData, false)
+}
+
+// StepRunRequeueToTask retries a step run without counting the retry against the step's retries, such as when the
+// step run's worker shut down before it finished.
+func StepRunRequeueToTask(stepRun *db.StepRunModel) *taskqueue.Task {
+	return stepRunRetryToTask(stepRun, nil, true)
+}
+
+func stepRunRetryToTask(stepRun *db.StepRunModel, inputData []byte, keepRetryCount bool) *taskqueue.Task {
 	payload, _ := datautils.ToJSONMap(StepRunRetryTaskPayload{
-		JobRunId:  stepRun.JobRunID,
-		StepRunId: stepRun.ID,
-		InputData: string(inputData),
+		JobRunId:       stepRun.JobRunID,
+		StepRunId:      stepRun.ID,
+		InputData:      string(inputData),
+		KeepRetryCount: keepRetryCount,
 	})
 
 	metadata, _ := datautils.ToJSONMap(StepRunRetryTaskMetadata{
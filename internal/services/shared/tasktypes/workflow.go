@@ -15,6 +15,32 @@ type WorkflowRunQueuedTaskMetadata struct {
 	WorkflowVersionId string `json:"workflow_version_id" validate:"required,uuid"`
 }
 
+type WorkflowResumedTaskPayload struct {
+	WorkflowId string `json:"workflow_id" validate:"required,uuid"`
+}
+
+type WorkflowResumedTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// WorkflowResumedToTask returns a task for the events controller which replays the events held back while the
+// workflow was paused.
+func WorkflowResumedToTask(workflow *db.WorkflowModel) *taskqueue.Task {
+	payload, _ := datautils.ToJSONMap(WorkflowResumedTaskPayload{
+		WorkflowId: workflow.ID,
+	})
+
+	metadata, _ := datautils.ToJSONMap(WorkflowResumedTaskMetadata{
+		TenantId: workflow.TenantID,
+	})
+
+	return &taskqueue.Task{
+		ID:       "workflow-resumed",
+		Payload:  payload,
+		Metadata: metadata,
+	}
+}
+
 type WorkflowRunFinishedTask struct {
 	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
 	Status        string `json:"status" validate:"required"`
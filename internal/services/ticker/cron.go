@@ -2,13 +2,14 @@ package ticker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/go-co-op/gocron/v2"
-
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
 	"github.com/hatchet-dev/hatchet/internal/repository"
 	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 )
@@ -37,39 +38,162 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, task *taskqueue.Tas
 		return fmt.Errorf("could not get workflow version: %w", err)
 	}
 
-	// create a new scheduler
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	cronRef, err := getCronRef(workflowVersion, payload.CronParentId, payload.Cron, payload.Timezone)
 
 	if err != nil {
-		return fmt.Errorf("could not create scheduler: %w", err)
+		return err
 	}
 
-	// schedule the cron
-	_, err = t.s.NewJob(
-		gocron.CronJob(payload.Cron, false),
-		gocron.NewTask(
-			t.runCronWorkflow(ctx, metadata.TenantId, &payload, workflowVersion),
-		),
-	)
+	jitter, _ := cronRef.Jitter()
+
+	// jittered crons fire at a point of the jitter window which is stable for the workflow, so crons which share an
+	// expression do not all fire at once
+	schedule, err := cronutils.ParseForWorkflow(workflowVersion.WorkflowID, cronRef.Cron, cronRef.Timezone, jitter)
 
 	if err != nil {
-		return fmt.Errorf("could not schedule cron: %w", err)
+		return fmt.Errorf("could not parse cron: %w", err)
+	}
+
+	var input []byte
+
+	if cronInput, ok := cronRef.Input(); ok {
+		input = []byte(json.RawMessage(cronInput))
+	}
+
+	cronCtx, cancel := context.WithCancel(ctx)
+
+	// store the cancel function in the cron map, replacing a cron which was scheduled before
+	if prev, ok := t.crons.Swap(getCronKey(payload.WorkflowVersionId, payload.Cron, payload.Timezone), cancel); ok {
+		prev.(context.CancelFunc)()
 	}
 
-	// store the schedule in the cron map
-	t.crons.Store(getCronKey(payload.WorkflowVersionId, payload.Cron), s)
+	// apply the misfire policy to the fires which were missed since the cron last fired, for example when the
+	// ticker which ran the cron died
+	missed := getMissedFireTimes(schedule, cronRef, time.Now())
 
-	s.Start()
+	if len(missed) > 0 {
+		t.l.Info().Msgf("running %d missed fires of cron %s for workflow version %s", len(missed), payload.Cron, payload.WorkflowVersionId)
+	}
+
+	go t.runCron(
+		cronCtx,
+		schedule,
+		missed,
+		t.runCronWorkflow(ctx, metadata.TenantId, &payload, input, workflowVersion),
+	)
 
 	return nil
 }
 
-func (t *TickerImpl) runCronWorkflow(ctx context.Context, tenantId string, payload *tasktypes.ScheduleCronTaskPayload, workflowVersion *db.WorkflowVersionModel) func() {
-	return func() {
+// runCron runs the missed fires, then runs the workflow at each fire time of the schedule until the context is
+// canceled. The fire times are computed from the wall clock of the cron's time zone, so the schedule is kept across
+// daylight saving time changes.
+func (t *TickerImpl) runCron(ctx context.Context, schedule *cronutils.Schedule, missed []time.Time, run func(fireAt time.Time)) {
+	for _, fireAt := range missed {
+		if ctx.Err() != nil {
+			return
+		}
+
+		run(fireAt)
+	}
+
+	next := schedule.Next(time.Now())
+
+	for !next.IsZero() {
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			run(next)
+		}
+
+		// never compute the next fire time from before the last one, so a cron does not fire twice if the clock
+		// is behind the timer
+		after := time.Now()
+
+		if after.Before(next) {
+			after = next
+		}
+
+		next = schedule.Next(after)
+	}
+
+	t.l.Warn().Msg("cron schedule has no more fire times")
+}
+
+// getMissedFireTimes returns the fires of the cron between its last fire and now which should be run according to its
+// misfire policy, in the order they should have fired.
+func getMissedFireTimes(schedule *cronutils.Schedule, cronRef *db.WorkflowTriggerCronRefModel, now time.Time) []time.Time {
+	lastFiredAt, ok := cronRef.LastFiredAt()
+
+	if !ok {
+		return nil
+	}
+
+	var limit int
+
+	switch cronRef.MisfirePolicy {
+	case db.CronMisfirePolicyRunOnce:
+		limit = 1
+	case db.CronMisfirePolicyRunAll:
+		limit = defaults.DefaultCronMaxMisfires
+
+		if maxMisfires, ok := cronRef.MaxMisfires(); ok {
+			limit = maxMisfires
+		}
+	default:
+		return nil
+	}
+
+	// walk back from now, so the most recent missed fires are run
+	missed := []time.Time{}
+
+	for fireAt := schedule.Prev(now); len(missed) < limit && fireAt.After(lastFiredAt); fireAt = schedule.Prev(fireAt) {
+		missed = append(missed, fireAt)
+	}
+
+	for i, j := 0, len(missed)-1; i < j; i, j = i+1, j-1 {
+		missed[i], missed[j] = missed[j], missed[i]
+	}
+
+	return missed
+}
+
+func (t *TickerImpl) runCronWorkflow(ctx context.Context, tenantId string, payload *tasktypes.ScheduleCronTaskPayload, input []byte, workflowVersion *db.WorkflowVersionModel) func(fireAt time.Time) {
+	return func(fireAt time.Time) {
 		t.l.Debug().Msgf("ticker: running workflow %s", payload.WorkflowVersionId)
 
+		workflow, err := t.repo.Workflow().GetWorkflowById(workflowVersion.WorkflowID)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not get workflow")
+			return
+		}
+
+		// paused workflows skip the fire, and it is recorded so the fire is not replayed as a misfire
+		if workflow.IsPaused {
+			t.l.Debug().Msgf("ticker: skipping cron for paused workflow %s", workflow.ID)
+
+			if err := t.repo.Workflow().SkipCronFire(payload.CronParentId, payload.Cron, payload.Timezone, fireAt); err != nil {
+				t.l.Err(err).Msg("could not record skipped cron fire")
+			}
+
+			return
+		}
+
+		// the run uses the pinned or canary version of the workflow if it has one
+		runWorkflowVersion, err := repository.GetWorkflowVersionForRun(t.repo.Workflow(), workflow, workflowVersion)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not get workflow version for run")
+			return
+		}
+
 		// create a new workflow run in the database
-		createOpts, err := repository.GetCreateWorkflowRunOptsFromCron(payload.Cron, payload.CronParentId, workflowVersion)
+		createOpts, err := repository.GetCreateWorkflowRunOptsFromCron(payload.Cron, payload.CronParentId, payload.Timezone, fireAt, input, runWorkflowVersion)
 
 		if err != nil {
 			t.l.Err(err).Msg("could not get create workflow run opts")
@@ -117,25 +241,35 @@ func (t *TickerImpl) handleCancelCron(ctx context.Context, task *taskqueue.Task)
 		return fmt.Errorf("could not decode ticker task metadata: %w", err)
 	}
 
-	// get the scheduler
-	schedulerVal, ok := t.crons.Load(getCronKey(payload.WorkflowVersionId, payload.Cron))
+	cancel, ok := t.crons.LoadAndDelete(getCronKey(payload.WorkflowVersionId, payload.Cron, payload.Timezone))
 
 	if !ok {
 		return fmt.Errorf("could not find cron %s with schedule %s", payload.WorkflowVersionId, payload.Cron)
 	}
 
-	defer t.crons.Delete(getCronKey(payload.WorkflowVersionId, payload.Cron))
+	// cancel the cron
+	cancel.(context.CancelFunc)()
 
-	scheduler := schedulerVal.(gocron.Scheduler)
+	return nil
+}
 
-	// cancel the cron
-	if err := scheduler.Shutdown(); err != nil {
-		return fmt.Errorf("could not cancel cron: %w", err)
+func getCronRef(workflowVersion *db.WorkflowVersionModel, cronParentId, cron, timezone string) (*db.WorkflowTriggerCronRefModel, error) {
+	triggers, ok := workflowVersion.Triggers()
+
+	if !ok {
+		return nil, fmt.Errorf("workflow version %s has no triggers", workflowVersion.ID)
 	}
 
-	return nil
+	for _, cronRef := range triggers.Crons() {
+		if cronRef.ParentID == cronParentId && cronRef.Cron == cron && cronRef.Timezone == timezone {
+			cronRefCp := cronRef
+			return &cronRefCp, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find cron %s in time zone %s for workflow version %s", cron, timezone, workflowVersion.ID)
 }
 
-func getCronKey(workflowVersionId, schedule string) string {
-	return fmt.Sprintf("%s-%s", workflowVersionId, schedule)
+func getCronKey(workflowVersionId, schedule, timezone string) string {
+	return fmt.Sprintf("%s-%s-%s", workflowVersionId, schedule, timezone)
 }
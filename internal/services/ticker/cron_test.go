@@ -0,0 +1,126 @@
+package ticker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+)
+
+func TestGetMissedFireTimes(t *testing.T) {
+	schedule, err := cronutils.Parse("0 * * * *", "UTC")
+	require.NoError(t, err)
+
+	lastFiredAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+	maxMisfires := 2
+
+	hours := func(hs ...int) []time.Time {
+		res := []time.Time{}
+
+		for _, h := range hs {
+			res = append(res, time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC))
+		}
+
+		return res
+	}
+
+	tests := []struct {
+		name        string
+		policy      db.CronMisfirePolicy
+		maxMisfires *int
+		lastFiredAt *time.Time
+		expected    []time.Time
+	}{
+		{
+			name:        "skip",
+			policy:      db.CronMisfirePolicySkip,
+			lastFiredAt: &lastFiredAt,
+			expected:    nil,
+		},
+		{
+			name:        "run once runs the last missed fire",
+			policy:      db.CronMisfirePolicyRunOnce,
+			lastFiredAt: &lastFiredAt,
+			expected:    hours(12),
+		},
+		{
+			name:        "run all",
+			policy:      db.CronMisfirePolicyRunAll,
+			lastFiredAt: &lastFiredAt,
+			expected:    hours(10, 11, 12),
+		},
+		{
+			name:        "run all up to the maximum",
+			policy:      db.CronMisfirePolicyRunAll,
+			maxMisfires: &maxMisfires,
+			lastFiredAt: &lastFiredAt,
+			expected:    hours(11, 12),
+		},
+		{
+			name:     "never fired",
+			policy:   db.CronMisfirePolicyRunAll,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronRef := &db.WorkflowTriggerCronRefModel{
+				InnerWorkflowTriggerCronRef: db.InnerWorkflowTriggerCronRef{
+					Cron:          "0 * * * *",
+					MisfirePolicy: tt.policy,
+					MaxMisfires:   tt.maxMisfires,
+					LastFiredAt:   tt.lastFiredAt,
+				},
+			}
+
+			missed := getMissedFireTimes(schedule, cronRef, now)
+
+			if tt.expected == nil {
+				assert.Empty(t, missed)
+				return
+			}
+
+			assert.Equal(t, tt.expected, missed)
+		})
+	}
+}
+
+func TestGetCronRefMatchesTimezone(t *testing.T) {
+	cronRef := func(timezone string) db.WorkflowTriggerCronRefModel {
+		return db.WorkflowTriggerCronRefModel{
+			InnerWorkflowTriggerCronRef: db.InnerWorkflowTriggerCronRef{
+				ParentID: "parent",
+				Cron:     "0 9 * * *",
+				Timezone: timezone,
+			},
+		}
+	}
+
+	workflowVersion := &db.WorkflowVersionModel{
+		InnerWorkflowVersion: db.InnerWorkflowVersion{
+			ID: "version",
+		},
+		RelationsWorkflowVersion: db.RelationsWorkflowVersion{
+			Triggers: &db.WorkflowTriggersModel{
+				RelationsWorkflowTriggers: db.RelationsWorkflowTriggers{
+					Crons: []db.WorkflowTriggerCronRefModel{cronRef("UTC"), cronRef("America/New_York")},
+				},
+			},
+		},
+	}
+
+	ref, err := getCronRef(workflowVersion, "parent", "0 9 * * *", "America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", ref.Timezone)
+
+	_, err = getCronRef(workflowVersion, "parent", "0 9 * * *", "Europe/Berlin")
+	assert.Error(t, err)
+
+	assert.NotEqual(t, getCronKey("version", "0 9 * * *", "UTC"), getCronKey("version", "0 9 * * *", "America/New_York"))
+}
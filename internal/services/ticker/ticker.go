@@ -13,6 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/logger"
 	"github.com/hatchet-dev/hatchet/internal/repository"
+	"github.com/hatchet-dev/hatchet/internal/services/leader"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/taskqueue"
 )
@@ -26,22 +27,22 @@ type TickerImpl struct {
 	l    *zerolog.Logger
 	repo repository.Repository
 	s    gocron.Scheduler
+	le   *leader.Elector
 
-	crons              sync.Map
-	scheduledWorkflows sync.Map
-	jobRuns            sync.Map
-	stepRuns           sync.Map
-	getGroupKeyRuns    sync.Map
+	crons sync.Map
 
 	dv datautils.DataDecoderValidator
 
 	tickerId string
 }
 
-type timeoutCtx struct {
-	ctx    context.Context
-	cancel context.CancelFunc
-}
+const (
+	// pollInterval is how often the tickers poll for timeouts and scheduled workflows which are due.
+	pollInterval = time.Second
+
+	// pollBatchSize is the number of timers which are claimed by a single poll.
+	pollBatchSize = 100
+)
 
 type TickerOpt func(*TickerOpts)
 
@@ -50,6 +51,7 @@ type TickerOpts struct {
 	l        *zerolog.Logger
 	repo     repository.Repository
 	tickerId string
+	le       *leader.Elector
 
 	dv datautils.DataDecoderValidator
 }
@@ -81,6 +83,14 @@ func WithLogger(l *zerolog.Logger) TickerOpt {
 	}
 }
 
+// WithLeaderElector makes the get group key run requeue loop only run on the instance which leads it. Without an
+// elector, the loop runs on every ticker.
+func WithLeaderElector(le *leader.Elector) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.le = le
+	}
+}
+
 func New(fs ...TickerOpt) (*TickerImpl, error) {
 	opts := defaultTickerOpts()
 
@@ -110,6 +120,7 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		l:        opts.l,
 		repo:     opts.repo,
 		s:        s,
+		le:       opts.le,
 		dv:       opts.dv,
 		tickerId: opts.tickerId,
 	}, nil
@@ -141,7 +152,7 @@ func (t *TickerImpl) Start() (func() error, error) {
 	_, err = t.s.NewJob(
 		gocron.DurationJob(time.Second*5),
 		gocron.NewTask(
-			t.runGetGroupKeyRunRequeue(ctx),
+			t.le.Lead("ticker:get-group-key-run-requeue", t.runGetGroupKeyRunRequeue(ctx)),
 		),
 	)
 
@@ -157,6 +168,35 @@ func (t *TickerImpl) Start() (func() error, error) {
 		),
 	)
 
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule heartbeat update: %w", err)
+	}
+
+	// timeouts and scheduled workflows are stored in the database and polled by every ticker, so they are not lost
+	// when a ticker dies
+	pollers := map[string]func(ctx context.Context) (int, error){
+		"step run timeouts":          t.pollStepRunTimeouts,
+		"job run timeouts":           t.pollJobRunTimeouts,
+		"get group key run timeouts": t.pollGetGroupKeyRunTimeouts,
+		"scheduled workflows":        t.pollScheduledWorkflows,
+	}
+
+	for name, poll := range pollers {
+		_, err = t.s.NewJob(
+			gocron.DurationJob(pollInterval),
+			gocron.NewTask(
+				t.runPoll(ctx, name, poll),
+			),
+			gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not schedule polling %s: %w", name, err)
+		}
+	}
+
 	t.s.Start()
 
 	wg := sync.WaitGroup{}
@@ -186,6 +226,10 @@ func (t *TickerImpl) Start() (func() error, error) {
 
 		wg.Wait()
 
+		if err := t.s.Shutdown(); err != nil {
+			return fmt.Errorf("could not shutdown scheduler: %w", err)
+		}
+
 		// delete the ticker
 		err = t.repo.Ticker().Delete(t.tickerId)
 
@@ -194,22 +238,6 @@ func (t *TickerImpl) Start() (func() error, error) {
 			return err
 		}
 
-		// add the task after the ticker is deleted
-		err = t.tq.AddTask(
-			ctx,
-			taskqueue.JOB_PROCESSING_QUEUE,
-			tickerRemoved(t.tickerId),
-		)
-
-		if err != nil {
-			t.l.Err(err).Msg("could not add ticker removed task")
-			return err
-		}
-
-		if err := t.s.Shutdown(); err != nil {
-			return fmt.Errorf("could not shutdown scheduler: %w", err)
-		}
-
 		return nil
 	}
 
@@ -218,18 +246,6 @@ func (t *TickerImpl) Start() (func() error, error) {
 
 func (t *TickerImpl) handleTask(ctx context.Context, task *taskqueue.Task) error {
 	switch task.ID {
-	case "schedule-step-run-timeout":
-		return t.handleScheduleStepRunTimeout(ctx, task)
-	case "cancel-step-run-timeout":
-		return t.handleCancelStepRunTimeout(ctx, task)
-	case "schedule-get-group-key-run-timeout":
-		return t.handleScheduleGetGroupKeyRunTimeout(ctx, task)
-	case "cancel-get-group-key-run-timeout":
-		return t.handleCancelGetGroupKeyRunTimeout(ctx, task)
-	case "schedule-job-run-timeout":
-		return t.handleScheduleJobRunTimeout(ctx, task)
-	case "cancel-job-run-timeout":
-		return t.handleCancelJobRunTimeout(ctx, task)
 	// case "schedule-step-requeue":
 	// 	return t.handleScheduleStepRunRequeue(ctx, task)
 	// case "cancel-step-requeue":
@@ -238,10 +254,6 @@ func (t *TickerImpl) handleTask(ctx context.Context, task *taskqueue.Task) error
 		return t.handleScheduleCron(ctx, task)
 	case "cancel-cron":
 		return t.handleCancelCron(ctx, task)
-	case "schedule-workflow":
-		return t.handleScheduleWorkflow(ctx, task)
-	case "cancel-workflow":
-		return t.handleCancelWorkflow(ctx, task)
 	}
 
 	return fmt.Errorf("unknown task: %s", task.ID)
@@ -292,16 +304,23 @@ func (t *TickerImpl) runUpdateHeartbeat(ctx context.Context) func() {
 	}
 }
 
-func tickerRemoved(tickerId string) *taskqueue.Task {
-	payload, _ := datautils.ToJSONMap(tasktypes.RemoveTickerTaskPayload{
-		TickerId: tickerId,
-	})
+// runPoll returns a function which polls until fewer than pollBatchSize timers are claimed, so a backlog of due
+// timers is handled in a single run.
+func (t *TickerImpl) runPoll(ctx context.Context, name string, poll func(ctx context.Context) (int, error)) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: polling %s", name)
 
-	metadata, _ := datautils.ToJSONMap(tasktypes.RemoveTickerTaskMetadata{})
+		for {
+			claimed, err := poll(ctx)
 
-	return &taskqueue.Task{
-		ID:       "ticker-removed",
-		Payload:  payload,
-		Metadata: metadata,
+			if err != nil {
+				t.l.Err(err).Msgf("could not poll %s", name)
+				return
+			}
+
+			if claimed < pollBatchSize {
+				return
+			}
+		}
 	}
 }
@@ -30,6 +30,21 @@ type taskWithQueue struct {
 	q taskqueue.Queue
 }
 
+// exchangeTask is a task which is published to a fanout exchange with the session it was received in.
+type exchangeTask struct {
+	*taskWithQueue
+
+	pub session
+}
+
+const (
+	// the number of tasks which can wait to be published to a single fanout exchange before its tasks are dropped
+	exchangeTaskBuffer = 1000
+
+	// the time without tasks after which the publisher of a fanout exchange is stopped
+	exchangePublisherIdleTimeout = time.Minute
+)
+
 // TaskQueueImpl implements TaskQueue interface using AMQP.
 type TaskQueueImpl struct {
 	ctx      context.Context
@@ -229,6 +244,11 @@ func (t *TaskQueueImpl) startPublishing() func() error {
 	}
 
 	go func() {
+		// each fanout exchange has its own publisher, so a slow exchange does not block other tasks. Publishers
+		// which are idle ask to be stopped, and are stopped by closing their tasks if no tasks were added since.
+		exchangeTasks := map[string]chan *exchangeTask{}
+		idleExchanges := make(chan string)
+
 		for session := range t.sessions {
 			pub := <-session
 
@@ -236,7 +256,34 @@ func (t *TaskQueueImpl) startPublishing() func() error {
 				select {
 				case <-ctx.Done():
 					return
+				case exchange := <-idleExchanges:
+					if tasks, ok := exchangeTasks[exchange]; ok && len(tasks) == 0 {
+						delete(exchangeTasks, exchange)
+						close(tasks)
+					}
 				case task := <-t.tasks:
+					// tasks for a fanout queue are only published to its exchange, in the order they were added, so
+					// subscribers receive them in order
+					if exchange := task.q.FanoutExchangeKey(); exchange != "" {
+						tasks, ok := exchangeTasks[exchange]
+
+						if !ok {
+							tasks = make(chan *exchangeTask, exchangeTaskBuffer)
+							exchangeTasks[exchange] = tasks
+
+							go t.publishExchangeTasks(ctx, exchange, tasks, idleExchanges)
+						}
+
+						// the tasks are dropped rather than blocking the tasks of other queues and exchanges
+						select {
+						case tasks <- &exchangeTask{taskWithQueue: task, pub: pub}:
+						default:
+							t.l.Error().Msgf("dropping task %s: %d tasks are waiting to be published to exchange %s", task.ID, exchangeTaskBuffer, exchange)
+						}
+
+						continue
+					}
+
 					go func(task *taskWithQueue) {
 						body, err := json.Marshal(task)
 
@@ -295,6 +342,75 @@ func (t *TaskQueueImpl) startPublishing() func() error {
 	return cleanup
 }
 
+// publishExchangeTasks publishes the tasks of a fanout exchange one at a time, in the order they were added. Once no
+// tasks were added for exchangePublisherIdleTimeout, it sends the exchange to idle, and returns when the tasks are
+// closed.
+func (t *TaskQueueImpl) publishExchangeTasks(ctx context.Context, exchange string, tasks <-chan *exchangeTask, idle chan<- string) {
+	timer := time.NewTimer(exchangePublisherIdleTimeout)
+	defer timer.Stop()
+
+	// nil until the publisher is idle, so the exchange is only sent once per idle period
+	var idleRequests chan<- string
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task, ok := <-tasks:
+			if !ok {
+				return
+			}
+
+			idleRequests = nil
+
+			t.publishToExchange(task.pub, exchange, task.taskWithQueue)
+
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+
+			timer.Reset(exchangePublisherIdleTimeout)
+		case <-timer.C:
+			idleRequests = idle
+		case idleRequests <- exchange:
+			idleRequests = nil
+		}
+	}
+}
+
+func (t *TaskQueueImpl) publishToExchange(pub session, exchange string, task *taskWithQueue) {
+	body, err := json.Marshal(task)
+
+	if err != nil {
+		t.l.Error().Msgf("error marshaling task queue: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// determine if the exchange exists
+	if _, ok := t.tenantIdCache.Get(exchange); !ok {
+		if err := t.RegisterTenant(ctx, exchange); err != nil {
+			t.l.Error().Msgf("error registering tenant exchange: %v", err)
+			return
+		}
+	}
+
+	t.l.Debug().Msgf("publishing task %s to exchange %s", task.ID, exchange)
+
+	err = pub.PublishWithContext(ctx, exchange, "", false, false, amqp.Publishing{
+		Body: body,
+	})
+
+	if err != nil {
+		t.l.Error().Msgf("error publishing task to exchange: %v", err)
+	}
+}
+
 func (t *TaskQueueImpl) subscribe(subId string, q taskqueue.Queue, sessions chan chan session, messages chan *taskWithQueue, tasks chan<- *taskqueue.Task) func() error {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -322,28 +438,38 @@ func (t *TaskQueueImpl) subscribe(subId string, q taskqueue.Queue, sessions chan
 				return
 			}
 
+			handleDelivery := func(msg amqp.Delivery) {
+				defer wg.Done()
+				task := &taskWithQueue{}
+
+				if err := json.Unmarshal(msg.Body, task); err != nil {
+					t.l.Error().Msgf("error unmarshaling message: %v", err)
+					return
+				}
+
+				t.l.Debug().Msgf("(session: %d) got task: %v", sessionCount, task.ID)
+
+				tasks <- task.Task
+
+				if err := sub.Ack(msg.DeliveryTag, false); err != nil {
+					t.l.Error().Msgf("error acknowledging message: %v", err)
+					return
+				}
+			}
+
 			for {
 				select {
 				case msg := <-deliveries:
 					wg.Add(1)
-					go func(msg amqp.Delivery) {
-						defer wg.Done()
-						task := &taskWithQueue{}
-
-						if err := json.Unmarshal(msg.Body, task); err != nil {
-							t.l.Error().Msgf("error unmarshaling message: %v", err)
-							return
-						}
 
-						t.l.Debug().Msgf("(session: %d) got task: %v", sessionCount, task.ID)
+					// deliveries of a fanout queue are handled one at a time, so subscribers receive them in the
+					// order they were published
+					if q.FanoutExchangeKey() != "" {
+						handleDelivery(msg)
+						continue
+					}
 
-						tasks <- task.Task
-
-						if err := sub.Ack(msg.DeliveryTag, false); err != nil {
-							t.l.Error().Msgf("error acknowledging message: %v", err)
-							return
-						}
-					}(msg)
+					go handleDelivery(msg)
 				case <-ctx.Done():
 					return
 				}
@@ -18,6 +18,7 @@ const (
 	HatchetNameErr = "Hatchet names must match the regex ^[a-zA-Z0-9\\.\\-_]+$"
 	ActionIDErr    = "Invalid action ID. Action IDs must be in the format <integrationId>:<verb>"
 	CronErr        = "Invalid cron expression"
+	KeyExprErr     = "Invalid key expression. Key expressions must be dot-separated field names, such as input.customer_id"
 )
 
 // Validator will validate the fields for a request object to ensure that
@@ -115,6 +116,8 @@ func getErrorStr(errObj *ValidationErrObject) string {
 		return errObj.SafeExternalError(ActionIDErr)
 	case "cron":
 		return errObj.SafeExternalError(CronErr)
+	case "keyexpression":
+		return errObj.SafeExternalError(KeyExprErr)
 	default:
 		return errObj.SafeExternalError("")
 	}
@@ -10,12 +10,13 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 
+	"github.com/hatchet-dev/hatchet/internal/cronutils"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 var NameRegex = regexp.MustCompile("^[a-zA-Z0-9\\.\\-_]+$") //nolint:gosimple
 
-var CronRegex = regexp.MustCompile(`(@(annually|yearly|monthly|weekly|daily|hourly|reboot))|(@every (\d+(ns|us|µs|ms|s|m|h))+)|((((\d+,)+\d+|(\d+(\/|-)\d+)|\d+|\*) ?){5,7})`) //nolint:gosimple
+var KeyExpressionRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-]+(\.[a-zA-Z0-9_\-]+)*$`)
 
 func newValidator() *validator.Validate {
 	validate := validator.New()
@@ -33,7 +34,25 @@ func newValidator() *validator.Validate {
 	})
 
 	_ = validate.RegisterValidation("cron", func(fl validator.FieldLevel) bool {
-		return CronRegex.MatchString(fl.Field().String())
+		_, err := cronutils.Parse(fl.Field().String(), "")
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("cronTimezone", func(fl validator.FieldLevel) bool {
+		_, err := cronutils.LoadLocation(fl.Field().String())
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("cronJitter", func(fl validator.FieldLevel) bool {
+		_, err := cronutils.ParseJitter(fl.Field().String())
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("keyExpression", func(fl validator.FieldLevel) bool {
+		return KeyExpressionRegex.MatchString(fl.Field().String())
 	})
 
 	_ = validate.RegisterValidation("actionId", func(fl validator.FieldLevel) bool {
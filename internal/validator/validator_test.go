@@ -47,11 +47,81 @@ func TestValidatorValidCron(t *testing.T) {
 func TestValidatorInvalidCron(t *testing.T) {
 	v := newValidator()
 
-	err := v.Struct(&cronResource{
-		Cron: "*/5 * * *",
-	})
+	for _, cron := range []string{"*/5 * * *", "61 * * * *", "0 0 30 2 * *"} {
+		err := v.Struct(&cronResource{
+			Cron: cron,
+		})
+
+		assert.ErrorContains(t, err, "validation for 'Cron' failed on the 'cron' tag", "should throw error on invalid cron")
+	}
+}
+
+func TestValidatorValidRandomCron(t *testing.T) {
+	v := newValidator()
+
+	for _, cron := range []string{"random_15_min", "random_hourly", "random_daily"} {
+		err := v.Struct(&cronResource{
+			Cron: cron,
+		})
+
+		assert.NoError(t, err, "no error")
+	}
+}
+
+type cronJitterResource struct {
+	Jitter string `validate:"cronJitter"`
+}
+
+func TestValidatorValidCronJitter(t *testing.T) {
+	v := newValidator()
+
+	for _, jitter := range []string{"30s", "15m", "24h"} {
+		err := v.Struct(&cronJitterResource{
+			Jitter: jitter,
+		})
+
+		assert.NoError(t, err, "no error")
+	}
+}
+
+func TestValidatorInvalidCronJitter(t *testing.T) {
+	v := newValidator()
+
+	for _, jitter := range []string{"", "0s", "48h", "soon"} {
+		err := v.Struct(&cronJitterResource{
+			Jitter: jitter,
+		})
+
+		assert.ErrorContains(t, err, "validation for 'Jitter' failed on the 'cronJitter' tag", "should throw error on invalid jitter")
+	}
+}
+
+type cronTimezoneResource struct {
+	Timezone string `validate:"cronTimezone"`
+}
+
+func TestValidatorValidCronTimezone(t *testing.T) {
+	v := newValidator()
+
+	for _, tz := range []string{"", "UTC", "America/New_York"} {
+		err := v.Struct(&cronTimezoneResource{
+			Timezone: tz,
+		})
+
+		assert.NoError(t, err, "no error")
+	}
+}
+
+func TestValidatorInvalidCronTimezone(t *testing.T) {
+	v := newValidator()
 
-	assert.ErrorContains(t, err, "validation for 'Cron' failed on the 'cron' tag", "should throw error on invalid cron")
+	for _, tz := range []string{"Local", "EST5EDT/Nowhere", "+02:00"} {
+		err := v.Struct(&cronTimezoneResource{
+			Timezone: tz,
+		})
+
+		assert.ErrorContains(t, err, "validation for 'Timezone' failed on the 'cronTimezone' tag", "should throw error on invalid time zone")
+	}
 }
 
 func TestValidatorValidDuration(t *testing.T) {
@@ -77,3 +147,27 @@ func TestValidatorInvalidDuration(t *testing.T) {
 
 	assert.ErrorContains(t, err, "validation for 'Duration' failed on the 'duration' tag", "should throw error on invalid duration")
 }
+
+func TestValidatorValidKeyExpression(t *testing.T) {
+	v := newValidator()
+
+	err := v.Struct(&struct {
+		Expr string `validate:"keyExpression"`
+	}{
+		Expr: "input.customer_id",
+	})
+
+	assert.NoError(t, err, "no error")
+}
+
+func TestValidatorInvalidKeyExpression(t *testing.T) {
+	v := newValidator()
+
+	err := v.Struct(&struct {
+		Expr string `validate:"keyExpression"`
+	}{
+		Expr: "input..customer_id",
+	})
+
+	assert.ErrorContains(t, err, "validation for 'Expr' failed on the 'keyExpression' tag", "should throw error on invalid key expression")
+}
@@ -0,0 +1,431 @@
+package workflowdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdded   ChangeType = "ADDED"
+	ChangeTypeRemoved ChangeType = "REMOVED"
+	ChangeTypeChanged ChangeType = "CHANGED"
+)
+
+// ChangeKind is the part of a workflow which changed.
+type ChangeKind string
+
+const (
+	ChangeKindJob          ChangeKind = "JOB"
+	ChangeKindStep         ChangeKind = "STEP"
+	ChangeKindAction       ChangeKind = "ACTION"
+	ChangeKindParent       ChangeKind = "PARENT"
+	ChangeKindTimeout      ChangeKind = "TIMEOUT"
+	ChangeKindRetries      ChangeKind = "RETRIES"
+	ChangeKindEventTrigger ChangeKind = "EVENT_TRIGGER"
+	ChangeKindCronTrigger  ChangeKind = "CRON_TRIGGER"
+	ChangeKindConcurrency  ChangeKind = "CONCURRENCY"
+)
+
+type Change struct {
+	Type ChangeType
+	Kind ChangeKind
+
+	// the job which changed, or which the step belongs to. Empty for changes to the triggers or concurrency of the
+	// workflow.
+	Job string
+
+	// the step which changed, or which the parent belongs to. Empty for changes which do not belong to a step.
+	Step string
+
+	// the value before the change, empty if it was added
+	From string
+
+	// the value after the change, empty if it was removed
+	To string
+}
+
+func (c *Change) String() string {
+	path := string(c.Kind)
+
+	if c.Job != "" {
+		path = fmt.Sprintf("%s (job %s", path, c.Job)
+
+		if c.Step != "" {
+			path = fmt.Sprintf("%s, step %s", path, c.Step)
+		}
+
+		path += ")"
+	}
+
+	switch c.Type {
+	case ChangeTypeAdded:
+		return fmt.Sprintf("added %s: %s", path, c.To)
+	case ChangeTypeRemoved:
+		return fmt.Sprintf("removed %s: %s", path, c.From)
+	default:
+		return fmt.Sprintf("changed %s: %q -> %q", path, c.From, c.To)
+	}
+}
+
+type Diff struct {
+	FromVersionId string
+	ToVersionId   string
+
+	// the changes, ordered from the workflow concurrency and triggers to the jobs and steps by name
+	Changes []*Change
+
+	// a unified diff of the YAML definitions of the versions
+	RawDiff string
+}
+
+// Compare returns the diff between two versions of a workflow. The versions should be fetched with their triggers,
+// concurrency, jobs and steps.
+func Compare(workflow *db.WorkflowModel, from, to *db.WorkflowVersionModel) (*Diff, error) {
+	fromFile, err := ToWorkflowFile(workflow, from)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not convert version %s: %w", from.ID, err)
+	}
+
+	toFile, err := ToWorkflowFile(workflow, to)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not convert version %s: %w", to.ID, err)
+	}
+
+	rawDiff, err := RenderYAML(fromFile, toFile, versionName(from), versionName(to))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Diff{
+		FromVersionId: from.ID,
+		ToVersionId:   to.ID,
+		Changes:       Changes(fromFile, toFile),
+		RawDiff:       rawDiff,
+	}, nil
+}
+
+func versionName(version *db.WorkflowVersionModel) string {
+	if setVersion, ok := version.Version(); ok && setVersion != "" {
+		return setVersion
+	}
+
+	return version.ID
+}
+
+// RenderYAML returns a unified diff of the YAML definitions of two workflow files, which is empty if they are
+// equal. Steps, parents and triggers are sorted, as their order does not change the workflow.
+func RenderYAML(from, to *types.Workflow, fromName, toName string) (string, error) {
+	fromYAML, err := types.ToYAML(context.Background(), normalize(from))
+
+	if err != nil {
+		return "", fmt.Errorf("could not render %s: %w", fromName, err)
+	}
+
+	toYAML, err := types.ToYAML(context.Background(), normalize(to))
+
+	if err != nil {
+		return "", fmt.Errorf("could not render %s: %w", toName, err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromYAML)),
+		B:        difflib.SplitLines(string(toYAML)),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
+// normalize returns a copy of the workflow file with its unordered lists sorted.
+func normalize(file *types.Workflow) *types.Workflow {
+	res := *file
+
+	res.Triggers.Events = sortedCopy(file.Triggers.Events)
+	res.Triggers.Cron = sortedCopy(file.Triggers.Cron)
+	res.Triggers.CronTriggers = append([]types.WorkflowCronTrigger{}, file.Triggers.CronTriggers...)
+
+	sort.Slice(res.Triggers.CronTriggers, func(i, j int) bool {
+		return res.Triggers.CronTriggers[i].Expression < res.Triggers.CronTriggers[j].Expression
+	})
+
+	res.Jobs = make(map[string]types.WorkflowJob, len(file.Jobs))
+
+	for name, job := range file.Jobs {
+		steps := make([]types.WorkflowStep, len(job.Steps))
+
+		for i, step := range job.Steps {
+			step.Parents = sortedCopy(step.Parents)
+			steps[i] = step
+		}
+
+		sort.Slice(steps, func(i, j int) bool {
+			return steps[i].ID < steps[j].ID
+		})
+
+		job.Steps = steps
+		res.Jobs[name] = job
+	}
+
+	return &res
+}
+
+// Changes returns the changes between two workflow files. Added and removed jobs and steps are reported as a single
+// change, without the changes to their steps or fields.
+func Changes(from, to *types.Workflow) []*Change {
+	changes := []*Change{}
+
+	changes = append(changes, fieldChanges(ChangeKindConcurrency, "", "", describeConcurrency(from.Concurrency), describeConcurrency(to.Concurrency))...)
+	changes = append(changes, setChanges(ChangeKindEventTrigger, "", "", from.Triggers.Events, to.Triggers.Events)...)
+
+	fromCrons, toCrons := describeCrons(&from.Triggers), describeCrons(&to.Triggers)
+
+	for _, expression := range unionKeys(fromCrons, toCrons) {
+		changes = append(changes, fieldChanges(ChangeKindCronTrigger, "", "", fromCrons[expression], toCrons[expression])...)
+	}
+
+	for _, jobName := range unionKeys(from.Jobs, to.Jobs) {
+		fromJob, inFrom := from.Jobs[jobName]
+		toJob, inTo := to.Jobs[jobName]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Kind: ChangeKindJob, Job: jobName, To: jobName})
+		case !inTo:
+			changes = append(changes, &Change{Type: ChangeTypeRemoved, Kind: ChangeKindJob, Job: jobName, From: jobName})
+		default:
+			changes = append(changes, jobChanges(jobName, &fromJob, &toJob)...)
+		}
+	}
+
+	return changes
+}
+
+func jobChanges(jobName string, from, to *types.WorkflowJob) []*Change {
+	changes := []*Change{}
+
+	if from.Timeout != to.Timeout {
+		changes = append(changes, &Change{Type: ChangeTypeChanged, Kind: ChangeKindTimeout, Job: jobName, From: from.Timeout, To: to.Timeout})
+	}
+
+	fromSteps, toSteps := stepsById(from.Steps), stepsById(to.Steps)
+
+	for _, stepId := range unionKeys(fromSteps, toSteps) {
+		fromStep, inFrom := fromSteps[stepId]
+		toStep, inTo := toSteps[stepId]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Kind: ChangeKindStep, Job: jobName, Step: stepId, To: stepId})
+		case !inTo:
+			changes = append(changes, &Change{Type: ChangeTypeRemoved, Kind: ChangeKindStep, Job: jobName, Step: stepId, From: stepId})
+		default:
+			if fromStep.ActionID != toStep.ActionID {
+				changes = append(changes, &Change{Type: ChangeTypeChanged, Kind: ChangeKindAction, Job: jobName, Step: stepId, From: fromStep.ActionID, To: toStep.ActionID})
+			}
+
+			if fromStep.Timeout != toStep.Timeout {
+				changes = append(changes, &Change{Type: ChangeTypeChanged, Kind: ChangeKindTimeout, Job: jobName, Step: stepId, From: fromStep.Timeout, To: toStep.Timeout})
+			}
+
+			if fromStep.Retries != toStep.Retries {
+				changes = append(changes, &Change{
+					Type: ChangeTypeChanged,
+					Kind: ChangeKindRetries,
+					Job:  jobName,
+					Step: stepId,
+					From: strconv.Itoa(fromStep.Retries),
+					To:   strconv.Itoa(toStep.Retries),
+				})
+			}
+
+			changes = append(changes, setChanges(ChangeKindParent, jobName, stepId, fromStep.Parents, toStep.Parents)...)
+			changes = append(changes, fieldChanges(ChangeKindConcurrency, jobName, stepId, describeStepConcurrency(fromStep.Concurrency), describeStepConcurrency(toStep.Concurrency))...)
+		}
+	}
+
+	return changes
+}
+
+// fieldChanges returns the change of an optional field, where an empty description means the field is unset.
+func fieldChanges(kind ChangeKind, job, step, from, to string) []*Change {
+	switch {
+	case from == to:
+		return nil
+	case from == "":
+		return []*Change{{Type: ChangeTypeAdded, Kind: kind, Job: job, Step: step, To: to}}
+	case to == "":
+		return []*Change{{Type: ChangeTypeRemoved, Kind: kind, Job: job, Step: step, From: from}}
+	default:
+		return []*Change{{Type: ChangeTypeChanged, Kind: kind, Job: job, Step: step, From: from, To: to}}
+	}
+}
+
+// setChanges returns the values which were added to or removed from an unordered list.
+func setChanges(kind ChangeKind, job, step string, from, to []string) []*Change {
+	changes := []*Change{}
+
+	fromSet, toSet := toSet(from), toSet(to)
+
+	for _, value := range unionKeys(fromSet, toSet) {
+		switch {
+		case !fromSet[value]:
+			changes = append(changes, &Change{Type: ChangeTypeAdded, Kind: kind, Job: job, Step: step, To: value})
+		case !toSet[value]:
+			changes = append(changes, &Change{Type: ChangeTypeRemoved, Kind: kind, Job: job, Step: step, From: value})
+		}
+	}
+
+	return changes
+}
+
+func describeConcurrency(concurrency *types.WorkflowConcurrency) string {
+	if concurrency == nil {
+		return ""
+	}
+
+	return describe(
+		"action", concurrency.ActionID,
+		"expression", concurrency.Expression,
+		"maxRuns", formatInt(int(concurrency.MaxRuns)),
+		"limitStrategy", string(concurrency.LimitStrategy),
+	)
+}
+
+func describeStepConcurrency(concurrency *types.WorkflowStepConcurrency) string {
+	if concurrency == nil {
+		return ""
+	}
+
+	return describe(
+		"key", concurrency.Key,
+		"maxRuns", formatInt(int(concurrency.MaxRuns)),
+	)
+}
+
+// describeCrons returns the description of each cron by its expression, which is unique within a workflow version.
+func describeCrons(triggers *types.WorkflowTriggers) map[string]string {
+	res := map[string]string{}
+
+	for _, expression := range triggers.Cron {
+		res[expression] = expression
+	}
+
+	for _, cron := range triggers.CronTriggers {
+		var input string
+
+		if cron.Input != nil {
+			// maps are marshaled with sorted keys, so equal inputs are described the same way
+			inputBytes, err := json.Marshal(cron.Input)
+
+			if err == nil {
+				input = string(inputBytes)
+			}
+		}
+
+		timezone := cron.Timezone
+
+		if timezone == "UTC" {
+			timezone = ""
+		}
+
+		options := describe(
+			"timezone", timezone,
+			"jitter", cron.Jitter,
+			"misfirePolicy", string(cron.MisfirePolicy),
+			"maxMisfires", formatInt(int(cron.MaxMisfires)),
+			"input", input,
+		)
+
+		if options == "" {
+			res[cron.Expression] = cron.Expression
+		} else {
+			res[cron.Expression] = fmt.Sprintf("%s (%s)", cron.Expression, options)
+		}
+	}
+
+	return res
+}
+
+// describe joins the set fields of a setting, given as pairs of names and values.
+func describe(fields ...string) string {
+	parts := []string{}
+
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", fields[i], fields[i+1]))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func formatInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(i)
+}
+
+func stepsById(steps []types.WorkflowStep) map[string]types.WorkflowStep {
+	res := make(map[string]types.WorkflowStep, len(steps))
+
+	for _, step := range steps {
+		res[step.ID] = step
+	}
+
+	return res
+}
+
+func toSet(values []string) map[string]bool {
+	res := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		res[value] = true
+	}
+
+	return res
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedCopy(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	res := append([]string{}, values...)
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,136 @@
+package workflowdiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/workflowdiff"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+func testWorkflow() *types.Workflow {
+	return &types.Workflow{
+		Name: "test-workflow",
+		Triggers: types.WorkflowTriggers{
+			Events: []string{"user:create", "user:update"},
+			Cron:   []string{"0 * * * *"},
+		},
+		Jobs: map[string]types.WorkflowJob{
+			"job": {
+				Timeout: "10m",
+				Steps: []types.WorkflowStep{
+					{ID: "one", ActionID: "test:one"},
+					{ID: "two", ActionID: "test:two", Parents: []string{"one"}},
+				},
+			},
+			"other-job": {
+				Steps: []types.WorkflowStep{
+					{ID: "one", ActionID: "test:other"},
+				},
+			},
+		},
+	}
+}
+
+func TestChangesOfEqualWorkflows(t *testing.T) {
+	from, to := testWorkflow(), testWorkflow()
+
+	// the order of steps, parents and triggers does not change the workflow
+	to.Triggers.Events = []string{"user:update", "user:create"}
+	to.Jobs["job"] = types.WorkflowJob{
+		Timeout: "10m",
+		Steps: []types.WorkflowStep{
+			{ID: "two", ActionID: "test:two", Parents: []string{"one"}},
+			{ID: "one", ActionID: "test:one"},
+		},
+	}
+
+	assert.Empty(t, workflowdiff.Changes(from, to))
+
+	rawDiff, err := workflowdiff.RenderYAML(from, to, "v1", "v2")
+	require.NoError(t, err)
+	assert.Empty(t, rawDiff)
+}
+
+func TestChanges(t *testing.T) {
+	from, to := testWorkflow(), testWorkflow()
+
+	to.Concurrency = &types.WorkflowConcurrency{
+		Expression:    "input.user_id",
+		MaxRuns:       2,
+		LimitStrategy: types.GroupRoundRobin,
+	}
+
+	to.Triggers.Events = []string{"user:create", "user:delete"}
+	to.Triggers.Cron = nil
+	to.Triggers.CronTriggers = []types.WorkflowCronTrigger{
+		{Expression: "0 * * * *", Timezone: "America/New_York"},
+	}
+
+	delete(to.Jobs, "other-job")
+
+	to.Jobs["new-job"] = types.WorkflowJob{
+		Steps: []types.WorkflowStep{
+			{ID: "one", ActionID: "test:new"},
+		},
+	}
+
+	to.Jobs["job"] = types.WorkflowJob{
+		Timeout: "20m",
+		Steps: []types.WorkflowStep{
+			{ID: "one", ActionID: "test:one-v2", Retries: 3},
+			{ID: "two", ActionID: "test:two", Timeout: "5m"},
+			{ID: "three", ActionID: "test:three", Parents: []string{"one"}},
+		},
+	}
+
+	expected := []*workflowdiff.Change{
+		{Type: workflowdiff.ChangeTypeAdded, Kind: workflowdiff.ChangeKindConcurrency, To: "expression: input.user_id, maxRuns: 2, limitStrategy: GROUP_ROUND_ROBIN"},
+		{Type: workflowdiff.ChangeTypeAdded, Kind: workflowdiff.ChangeKindEventTrigger, To: "user:delete"},
+		{Type: workflowdiff.ChangeTypeRemoved, Kind: workflowdiff.ChangeKindEventTrigger, From: "user:update"},
+		{Type: workflowdiff.ChangeTypeChanged, Kind: workflowdiff.ChangeKindCronTrigger, From: "0 * * * *", To: "0 * * * * (timezone: America/New_York)"},
+		{Type: workflowdiff.ChangeTypeChanged, Kind: workflowdiff.ChangeKindTimeout, Job: "job", From: "10m", To: "20m"},
+		{Type: workflowdiff.ChangeTypeChanged, Kind: workflowdiff.ChangeKindAction, Job: "job", Step: "one", From: "test:one", To: "test:one-v2"},
+		{Type: workflowdiff.ChangeTypeChanged, Kind: workflowdiff.ChangeKindRetries, Job: "job", Step: "one", From: "0", To: "3"},
+		{Type: workflowdiff.ChangeTypeAdded, Kind: workflowdiff.ChangeKindStep, Job: "job", Step: "three", To: "three"},
+		{Type: workflowdiff.ChangeTypeChanged, Kind: workflowdiff.ChangeKindTimeout, Job: "job", Step: "two", To: "5m"},
+		{Type: workflowdiff.ChangeTypeRemoved, Kind: workflowdiff.ChangeKindParent, Job: "job", Step: "two", From: "one"},
+		{Type: workflowdiff.ChangeTypeAdded, Kind: workflowdiff.ChangeKindJob, Job: "new-job", To: "new-job"},
+		{Type: workflowdiff.ChangeTypeRemoved, Kind: workflowdiff.ChangeKindJob, Job: "other-job", From: "other-job"},
+	}
+
+	assert.Equal(t, expected, workflowdiff.Changes(from, to))
+}
+
+func TestRenderYAML(t *testing.T) {
+	from, to := testWorkflow(), testWorkflow()
+
+	to.Jobs["job"] = types.WorkflowJob{
+		Timeout: "10m",
+		Steps: []types.WorkflowStep{
+			{ID: "one", ActionID: "test:one", Retries: 3},
+			{ID: "two", ActionID: "test:two", Parents: []string{"one"}},
+		},
+	}
+
+	rawDiff, err := workflowdiff.RenderYAML(from, to, "v1", "v2")
+	require.NoError(t, err)
+
+	assert.Contains(t, rawDiff, "--- v1\n+++ v2\n")
+	assert.Contains(t, rawDiff, "-        retries: 0\n+        retries: 3\n")
+}
+
+func TestChangeString(t *testing.T) {
+	change := &workflowdiff.Change{
+		Type: workflowdiff.ChangeTypeChanged,
+		Kind: workflowdiff.ChangeKindRetries,
+		Job:  "job",
+		Step: "one",
+		From: "0",
+		To:   "3",
+	}
+
+	assert.Equal(t, `changed RETRIES (job job, step one): "0" -> "3"`, change.String())
+}
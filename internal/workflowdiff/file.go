@@ -0,0 +1,172 @@
+package workflowdiff
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+// ToWorkflowFile converts a workflow version to the workflow file which declares it.
+func ToWorkflowFile(workflow *db.WorkflowModel, version *db.WorkflowVersionModel) (*types.Workflow, error) {
+	res := &types.Workflow{
+		Name: workflow.Name,
+	}
+
+	if setVersion, ok := version.Version(); ok {
+		res.Version = setVersion
+	}
+
+	if description, ok := workflow.Description(); ok {
+		res.Description = description
+	}
+
+	if workerSelection, ok := version.WorkerSelectionStrategy(); ok {
+		res.WorkerSelection = types.WorkerSelectionStrategy(workerSelection)
+	}
+
+	if concurrency, ok := version.Concurrency(); ok {
+		res.Concurrency = &types.WorkflowConcurrency{
+			MaxRuns:       int32(concurrency.MaxRuns),
+			LimitStrategy: types.WorkflowConcurrencyLimitStrategy(concurrency.LimitStrategy),
+		}
+
+		if getGroup, ok := concurrency.GetConcurrencyGroup(); ok {
+			res.Concurrency.ActionID = getGroup.ActionID
+		}
+
+		if expr, ok := concurrency.ConcurrencyGroupExpression(); ok {
+			res.Concurrency.Expression = expr
+		}
+	}
+
+	if triggers, ok := version.Triggers(); ok && triggers != nil {
+		triggersResp := types.WorkflowTriggers{}
+
+		for _, cron := range triggers.Crons() {
+			cronCp := cron
+
+			input, err := toCronInput(&cronCp)
+
+			if err != nil {
+				return nil, err
+			}
+
+			jitter, _ := cron.Jitter()
+			maxMisfires, _ := cron.MaxMisfires()
+
+			// crons in UTC with the default misfire policy and without input or jitter are written in the short form
+			if input == nil && jitter == "" && cron.MisfirePolicy == db.CronMisfirePolicySkip && (cron.Timezone == "" || cron.Timezone == "UTC") {
+				triggersResp.Cron = append(triggersResp.Cron, cron.Cron)
+				continue
+			}
+
+			cronTrigger := types.WorkflowCronTrigger{
+				Expression:  cron.Cron,
+				Timezone:    cron.Timezone,
+				Input:       input,
+				Jitter:      jitter,
+				MaxMisfires: int32(maxMisfires),
+			}
+
+			if cron.MisfirePolicy != db.CronMisfirePolicySkip {
+				cronTrigger.MisfirePolicy = types.CronMisfirePolicy(cron.MisfirePolicy)
+			}
+
+			triggersResp.CronTriggers = append(triggersResp.CronTriggers, cronTrigger)
+		}
+
+		if events := triggers.Events(); len(events) > 0 {
+			triggersResp.Events = make([]string, len(events))
+
+			for i, event := range events {
+				triggersResp.Events[i] = event.EventKey
+			}
+		}
+
+		res.Triggers = triggersResp
+	}
+
+	if jobs := version.Jobs(); jobs != nil {
+		res.Jobs = make(map[string]types.WorkflowJob, len(jobs))
+
+		for _, job := range jobs {
+			jobCp := job
+
+			jobRes := types.WorkflowJob{}
+
+			if description, ok := jobCp.Description(); ok {
+				jobRes.Description = description
+			}
+
+			if timeout, ok := jobCp.Timeout(); ok {
+				jobRes.Timeout = timeout
+			}
+
+			if steps := jobCp.Steps(); steps != nil {
+				jobRes.Steps = make([]types.WorkflowStep, 0)
+
+				for _, step := range steps {
+					stepCp := step
+
+					stepRes := types.WorkflowStep{
+						ID:       stepReadableId(&stepCp),
+						ActionID: step.ActionID,
+						Retries:  step.Retries,
+					}
+
+					if timeout, ok := step.Timeout(); ok {
+						stepRes.Timeout = timeout
+					}
+
+					if step.RelationsStep.Parents != nil {
+						for _, parent := range step.Parents() {
+							parentCp := parent
+							stepRes.Parents = append(stepRes.Parents, stepReadableId(&parentCp))
+						}
+					}
+
+					if keyExpr, ok := step.ConcurrencyKeyExpr(); ok {
+						maxRuns, _ := step.ConcurrencyMaxRuns()
+
+						stepRes.Concurrency = &types.WorkflowStepConcurrency{
+							Key:     keyExpr,
+							MaxRuns: int32(maxRuns),
+						}
+					}
+
+					jobRes.Steps = append(jobRes.Steps, stepRes)
+				}
+
+				res.Jobs[jobCp.Name] = jobRes
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func stepReadableId(step *db.StepModel) string {
+	if readableId, ok := step.ReadableID(); ok {
+		return readableId
+	}
+
+	return step.ID
+}
+
+func toCronInput(cron *db.WorkflowTriggerCronRefModel) (map[string]interface{}, error) {
+	data, ok := cron.Input()
+
+	if !ok {
+		return nil, nil
+	}
+
+	input := map[string]interface{}{}
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("could not unmarshal cron input: %w", err)
+	}
+
+	return input, nil
+}
@@ -19,8 +19,26 @@ type AdminClient interface {
 	PutWorkflow(workflow *types.Workflow, opts ...PutOptFunc) error
 	ScheduleWorkflow(workflowName string, opts ...ScheduleOptFunc) error
 
+	// ListScheduledWorkflows returns the scheduled runs of a workflow, ordered by the time they are triggered
+	ListScheduledWorkflows(workflowName string) ([]*ScheduledWorkflow, error)
+
+	// UpdateScheduledWorkflow updates the trigger time or input of a scheduled run which has not fired yet
+	UpdateScheduledWorkflow(scheduledWorkflowId string, opts ...UpdateScheduledOptFunc) (*ScheduledWorkflow, error)
+
+	// DeleteScheduledWorkflow deletes a scheduled run
+	DeleteScheduledWorkflow(scheduledWorkflowId string) error
+
 	// RunWorkflow triggers a workflow run and returns the run id
 	RunWorkflow(workflowName string, input interface{}) (string, error)
+
+	// PauseWorkflow pauses a workflow, so it does not start new runs until it is resumed
+	PauseWorkflow(workflowName string, opts ...PauseOptFunc) error
+
+	// ResumeWorkflow resumes a paused workflow
+	ResumeWorkflow(workflowName string, opts ...ResumeOptFunc) error
+
+	// PreviewCron validates a cron expression and returns the next times it fires
+	PreviewCron(expression string, opts ...PreviewCronOptFunc) ([]time.Time, error)
 }
 
 type adminClientImpl struct {
@@ -43,14 +61,57 @@ func newAdmin(conn *grpc.ClientConn, opts *sharedClientOpts) AdminClient {
 }
 
 type putOpts struct {
+	onDiff func(diff *WorkflowVersionDiff)
 }
 
 type PutOptFunc func(*putOpts)
 
+// WithDiff asks for the diff between the previous version of the workflow and the version it is put as, and calls
+// f with it. f is not called if the workflow did not exist yet.
+func WithDiff(f func(diff *WorkflowVersionDiff)) PutOptFunc {
+	return func(opts *putOpts) {
+		opts.onDiff = f
+	}
+}
+
 func defaultPutOpts() *putOpts {
 	return &putOpts{}
 }
 
+// WorkflowVersionDiff is the diff between two versions of a workflow.
+type WorkflowVersionDiff struct {
+	FromVersionId string
+
+	ToVersionId string
+
+	Changes []*WorkflowVersionChange
+
+	// RawDiff is a unified diff of the YAML definitions of the versions, which is empty if they are equal
+	RawDiff string
+}
+
+// WorkflowVersionChange is a single change between two versions of a workflow.
+type WorkflowVersionChange struct {
+	// Type is ADDED, REMOVED or CHANGED
+	Type string
+
+	// Kind is the part of the workflow which changed, such as JOB, STEP, PARENT, TIMEOUT, RETRIES, EVENT_TRIGGER,
+	// CRON_TRIGGER or CONCURRENCY
+	Kind string
+
+	// Job is the job which changed, or which the step belongs to
+	Job string
+
+	// Step is the step which changed, or which the parent belongs to
+	Step string
+
+	// From is the value before the change, empty if it was added
+	From string
+
+	// To is the value after the change, empty if it was removed
+	To string
+}
+
 func (a *adminClientImpl) PutWorkflow(workflow *types.Workflow, fs ...PutOptFunc) error {
 	opts := defaultPutOpts()
 
@@ -64,15 +125,43 @@ func (a *adminClientImpl) PutWorkflow(workflow *types.Workflow, fs ...PutOptFunc
 		return fmt.Errorf("could not get put opts: %w", err)
 	}
 
-	_, err = a.client.PutWorkflow(a.ctx.newContext(context.Background()), req)
+	req.IncludeDiff = opts.onDiff != nil
+
+	res, err := a.client.PutWorkflow(a.ctx.newContext(context.Background()), req)
 
 	if err != nil {
 		return fmt.Errorf("could not create workflow: %w", err)
 	}
 
+	if diff := res.GetDiff(); diff != nil && opts.onDiff != nil {
+		opts.onDiff(toWorkflowVersionDiff(diff))
+	}
+
 	return nil
 }
 
+func toWorkflowVersionDiff(diff *admincontracts.WorkflowVersionDiff) *WorkflowVersionDiff {
+	res := &WorkflowVersionDiff{
+		FromVersionId: diff.FromVersionId,
+		ToVersionId:   diff.ToVersionId,
+		Changes:       make([]*WorkflowVersionChange, len(diff.Changes)),
+		RawDiff:       diff.RawDiff,
+	}
+
+	for i, change := range diff.Changes {
+		res.Changes[i] = &WorkflowVersionChange{
+			Type: change.Type,
+			Kind: change.Kind,
+			Job:  change.Job,
+			Step: change.Step,
+			From: change.From,
+			To:   change.To,
+		}
+	}
+
+	return res
+}
+
 type scheduleOpts struct {
 	schedules []time.Time
 	input     any
@@ -141,6 +230,134 @@ func (a *adminClientImpl) ScheduleWorkflow(workflowName string, fs ...ScheduleOp
 	return nil
 }
 
+// ScheduledWorkflow is a run of a workflow scheduled for a specific time.
+type ScheduledWorkflow struct {
+	Id string
+
+	WorkflowName string
+
+	TriggerAt time.Time
+
+	// Input is the JSON input of the run
+	Input []byte
+
+	// WorkflowRunId is the id of the run triggered by the schedule, or nil if it has not fired yet
+	WorkflowRunId *string
+}
+
+func (a *adminClientImpl) ListScheduledWorkflows(workflowName string) ([]*ScheduledWorkflow, error) {
+	workflow, err := a.client.GetWorkflowByName(a.ctx.newContext(context.Background()), &admincontracts.GetWorkflowByNameRequest{
+		Name: workflowName,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	res := []*ScheduledWorkflow{}
+	limit := int32(100)
+
+	for {
+		offset := int32(len(res))
+
+		resp, err := a.client.ListScheduledWorkflows(a.ctx.newContext(context.Background()), &admincontracts.ListScheduledWorkflowsRequest{
+			WorkflowId: &workflow.Id,
+			Offset:     &offset,
+			Limit:      &limit,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list scheduled workflows: %w", err)
+		}
+
+		for _, scheduled := range resp.ScheduledWorkflows {
+			res = append(res, toScheduledWorkflow(scheduled))
+		}
+
+		if len(resp.ScheduledWorkflows) < int(limit) || len(res) >= int(resp.Count) {
+			return res, nil
+		}
+	}
+}
+
+type updateScheduledOpts struct {
+	triggerAt *time.Time
+	input     any
+}
+
+type UpdateScheduledOptFunc func(*updateScheduledOpts)
+
+// WithTriggerAt moves a scheduled run to a new time, which must be in the future.
+func WithTriggerAt(triggerAt time.Time) UpdateScheduledOptFunc {
+	return func(opts *updateScheduledOpts) {
+		opts.triggerAt = &triggerAt
+	}
+}
+
+// WithScheduledInput replaces the input of a scheduled run.
+func WithScheduledInput(input any) UpdateScheduledOptFunc {
+	return func(opts *updateScheduledOpts) {
+		opts.input = input
+	}
+}
+
+func (a *adminClientImpl) UpdateScheduledWorkflow(scheduledWorkflowId string, fs ...UpdateScheduledOptFunc) (*ScheduledWorkflow, error) {
+	opts := &updateScheduledOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	req := &admincontracts.UpdateScheduledWorkflowRequest{
+		ScheduledWorkflowId: scheduledWorkflowId,
+	}
+
+	if opts.triggerAt != nil {
+		req.TriggerAt = timestamppb.New(*opts.triggerAt)
+	}
+
+	if opts.input != nil {
+		inputBytes, err := json.Marshal(opts.input)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal input: %w", err)
+		}
+
+		input := string(inputBytes)
+		req.Input = &input
+	}
+
+	resp, err := a.client.UpdateScheduledWorkflow(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update scheduled workflow: %w", err)
+	}
+
+	return toScheduledWorkflow(resp), nil
+}
+
+func (a *adminClientImpl) DeleteScheduledWorkflow(scheduledWorkflowId string) error {
+	_, err := a.client.DeleteScheduledWorkflow(a.ctx.newContext(context.Background()), &admincontracts.DeleteScheduledWorkflowRequest{
+		ScheduledWorkflowId: scheduledWorkflowId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not delete scheduled workflow: %w", err)
+	}
+
+	return nil
+}
+
+func toScheduledWorkflow(scheduled *admincontracts.ScheduledWorkflow) *ScheduledWorkflow {
+	return &ScheduledWorkflow{
+		Id:            scheduled.Id,
+		WorkflowName:  scheduled.WorkflowName,
+		TriggerAt:     scheduled.TriggerAt.AsTime(),
+		Input:         []byte(scheduled.Input),
+		WorkflowRunId: scheduled.WorkflowRunId,
+	}
+}
+
 func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}) (string, error) {
 	inputBytes, err := json.Marshal(input)
 
@@ -160,6 +377,159 @@ func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}) (s
 	return res.WorkflowRunId, nil
 }
 
+type pauseOpts struct {
+	queueEvents bool
+}
+
+type PauseOptFunc func(*pauseOpts)
+
+// WithQueueEvents holds back the events which trigger the workflow while it is paused, so they are replayed
+// when it is resumed.
+func WithQueueEvents() PauseOptFunc {
+	return func(opts *pauseOpts) {
+		opts.queueEvents = true
+	}
+}
+
+func (a *adminClientImpl) PauseWorkflow(workflowName string, fs ...PauseOptFunc) error {
+	opts := &pauseOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	workflow, err := a.client.GetWorkflowByName(a.ctx.newContext(context.Background()), &admincontracts.GetWorkflowByNameRequest{
+		Name: workflowName,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	_, err = a.client.PauseWorkflow(a.ctx.newContext(context.Background()), &admincontracts.PauseWorkflowRequest{
+		WorkflowId:  workflow.Id,
+		QueueEvents: opts.queueEvents,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not pause workflow: %w", err)
+	}
+
+	return nil
+}
+
+type resumeOpts struct {
+	replayEvents bool
+}
+
+type ResumeOptFunc func(*resumeOpts)
+
+// WithReplayEvents replays the events which triggered the workflow while it was paused.
+func WithReplayEvents() ResumeOptFunc {
+	return func(opts *resumeOpts) {
+		opts.replayEvents = true
+	}
+}
+
+func (a *adminClientImpl) ResumeWorkflow(workflowName string, fs ...ResumeOptFunc) error {
+	opts := &resumeOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	workflow, err := a.client.GetWorkflowByName(a.ctx.newContext(context.Background()), &admincontracts.GetWorkflowByNameRequest{
+		Name: workflowName,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	_, err = a.client.ResumeWorkflow(a.ctx.newContext(context.Background()), &admincontracts.ResumeWorkflowRequest{
+		WorkflowId:   workflow.Id,
+		ReplayEvents: opts.replayEvents,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not resume workflow: %w", err)
+	}
+
+	return nil
+}
+
+type previewCronOpts struct {
+	timezone     string
+	jitter       time.Duration
+	workflowName *string
+	count        *int32
+}
+
+type PreviewCronOptFunc func(*previewCronOpts)
+
+// WithPreviewTimezone evaluates the previewed cron expression in an IANA time zone instead of UTC.
+func WithPreviewTimezone(timezone string) PreviewCronOptFunc {
+	return func(opts *previewCronOpts) {
+		opts.timezone = timezone
+	}
+}
+
+// WithPreviewJitter delays the previewed fire times within a jitter window.
+func WithPreviewJitter(window time.Duration) PreviewCronOptFunc {
+	return func(opts *previewCronOpts) {
+		opts.jitter = window
+	}
+}
+
+// WithPreviewWorkflow previews the cron of a workflow. The delay of jittered and random crons is derived from the
+// workflow, so without it they are previewed at the start of each jitter window.
+func WithPreviewWorkflow(workflowName string) PreviewCronOptFunc {
+	return func(opts *previewCronOpts) {
+		opts.workflowName = &workflowName
+	}
+}
+
+// WithPreviewCount sets the number of fire times to return, which defaults to 5.
+func WithPreviewCount(count int32) PreviewCronOptFunc {
+	return func(opts *previewCronOpts) {
+		opts.count = &count
+	}
+}
+
+func (a *adminClientImpl) PreviewCron(expression string, fs ...PreviewCronOptFunc) ([]time.Time, error) {
+	opts := &previewCronOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	req := &admincontracts.PreviewCronRequest{
+		Cron:         expression,
+		Timezone:     opts.timezone,
+		WorkflowName: opts.workflowName,
+		Count:        opts.count,
+	}
+
+	if opts.jitter != 0 {
+		jitter := opts.jitter.String()
+		req.Jitter = &jitter
+	}
+
+	resp, err := a.client.PreviewCron(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not preview cron: %w", err)
+	}
+
+	res := make([]time.Time, len(resp.NextFireTimes))
+
+	for i, fireAt := range resp.NextFireTimes {
+		res[i] = fireAt.AsTime()
+	}
+
+	return res, nil
+}
+
 func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontracts.PutWorkflowRequest, error) {
 	opts := &admincontracts.CreateWorkflowVersionOpts{
 		Name:          workflow.Name,
@@ -169,9 +539,49 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 		CronTriggers:  workflow.Triggers.Cron,
 	}
 
+	for _, cronTrigger := range workflow.Triggers.CronTriggers {
+		cronTriggerOpts := &admincontracts.CreateWorkflowCronTriggerOpts{
+			Cron:     cronTrigger.Expression,
+			Timezone: cronTrigger.Timezone,
+		}
+
+		if cronTrigger.Input != nil {
+			inputBytes, err := json.Marshal(cronTrigger.Input)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal input for cron trigger %s: %w", cronTrigger.Expression, err)
+			}
+
+			cronTriggerOpts.Input = string(inputBytes)
+		}
+
+		if cronTrigger.MisfirePolicy != "" {
+			policy, ok := admincontracts.CronMisfirePolicy_value[string(cronTrigger.MisfirePolicy)]
+
+			if !ok {
+				return nil, fmt.Errorf("invalid misfire policy for cron trigger %s: %s", cronTrigger.Expression, cronTrigger.MisfirePolicy)
+			}
+
+			cronTriggerOpts.MisfirePolicy = admincontracts.CronMisfirePolicy(policy).Enum()
+		}
+
+		if cronTrigger.MaxMisfires != 0 {
+			maxMisfires := cronTrigger.MaxMisfires
+			cronTriggerOpts.MaxMisfires = &maxMisfires
+		}
+
+		if cronTrigger.Jitter != "" {
+			jitter := cronTrigger.Jitter
+			cronTriggerOpts.Jitter = &jitter
+		}
+
+		opts.CronTriggerOpts = append(opts.CronTriggerOpts, cronTriggerOpts)
+	}
+
 	if workflow.Concurrency != nil {
 		opts.Concurrency = &admincontracts.WorkflowConcurrencyOpts{
-			Action: workflow.Concurrency.ActionID,
+			Action:     workflow.Concurrency.ActionID,
+			Expression: workflow.Concurrency.Expression,
 		}
 
 		switch workflow.Concurrency.LimitStrategy {
@@ -189,6 +599,16 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 		}
 	}
 
+	if workflow.WorkerSelection != "" {
+		strategy, ok := admincontracts.WorkerSelectionStrategy_value[string(workflow.WorkerSelection)]
+
+		if !ok {
+			return nil, fmt.Errorf("invalid worker selection strategy: %s", workflow.WorkerSelection)
+		}
+
+		opts.WorkerSelectionStrategy = admincontracts.WorkerSelectionStrategy(strategy).Enum()
+	}
+
 	jobOpts := make([]*admincontracts.CreateWorkflowJobOpts, 0)
 
 	for jobName, job := range workflow.Jobs {
@@ -216,6 +636,13 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 				Retries:    int32(step.Retries),
 			}
 
+			if step.Concurrency != nil {
+				stepOpt.Concurrency = &admincontracts.StepConcurrencyOpts{
+					Key:     step.Concurrency.Key,
+					MaxRuns: step.Concurrency.MaxRuns,
+				}
+			}
+
 			stepOpts[i] = stepOpt
 		}
 
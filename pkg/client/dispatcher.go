@@ -25,11 +25,23 @@ type DispatcherClient interface {
 	SendStepActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
 
 	SendGroupKeyActionEvent(ctx context.Context, in *ActionEvent) (*ActionEventResponse, error)
+
+	// RefreshTimeout moves the timeout of a running step run to the given duration from now, and returns the new
+	// timeout.
+	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest) (*time.Time, error)
+
+	ReportProgress(ctx context.Context, in *StepRunProgress) (*ActionEventResponse, error)
+
+	// PutStreamEvent streams a chunk of a step run's partial output to subscribers of its workflow run.
+	PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error
 }
 
 const (
 	DefaultActionListenerRetryInterval = 5 * time.Second
 	DefaultActionListenerRetryCount    = 5
+
+	// DefaultHeartbeatInterval is the interval at which the action listener sends heartbeats to the dispatcher.
+	DefaultHeartbeatInterval = 5 * time.Second
 )
 
 // TODO: add validator to client side
@@ -38,6 +50,18 @@ type GetActionListenerRequest struct {
 	Services   []string
 	Actions    []string
 	MaxRuns    *int
+
+	// ActionSlots is the maximum number of runs for an action, keyed by action id
+	ActionSlots map[string]int
+
+	// InFlightStepRuns returns the ids of the step runs which the worker is running. It is called for every
+	// heartbeat, and step runs assigned to the worker which are not returned are reassigned or failed by the
+	// dispatcher.
+	InFlightStepRuns func() []string
+
+	// OnDrained is called when the dispatcher reports that the worker is draining and has no step runs in flight,
+	// so the worker can stop listening.
+	OnDrained func()
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -87,11 +111,18 @@ type Action struct {
 
 	// the action type
 	ActionType ActionType
+
+	// the number of times the step run was retried before this attempt
+	RetryCount int32
 }
 
 type WorkerActionListener interface {
 	Actions(ctx context.Context) (<-chan *Action, error)
 
+	// Drain stops the worker from being assigned new actions. The worker keeps listening, so it can finish its
+	// in-flight step runs and report their results before it unregisters.
+	Drain() error
+
 	Unregister() error
 }
 
@@ -115,6 +146,41 @@ type ActionEvent struct {
 
 	// The event payload. This must be JSON-compatible as it gets marshalled to a JSON string.
 	EventPayload interface{}
+
+	// WorkerShutdown is set on failed events which are sent because the worker shut down before the step run
+	// finished. The step run is retried without counting against the step's retries.
+	WorkerShutdown bool
+}
+
+type StepRunProgress struct {
+	// the worker id
+	WorkerId string
+
+	// the step run id
+	StepRunId string
+
+	// the progress of the step run in percent, between 0 and 100
+	Progress int `validate:"min=0,max=100"`
+
+	// (optional) a message which describes the progress
+	Message string
+
+	// the retry count of the attempt which reports the progress
+	RetryCount int32
+}
+
+type RefreshTimeoutRequest struct {
+	// the worker id
+	WorkerId string
+
+	// the step run id
+	StepRunId string
+
+	// the retry count of the attempt which refreshes the timeout
+	RetryCount int32
+
+	// the duration from now after which the step run times out
+	Timeout time.Duration
 }
 
 type ActionEventResponse struct {
@@ -156,6 +222,10 @@ type actionListenerImpl struct {
 
 	workerId string
 
+	inFlightStepRuns func() []string
+
+	onDrained func()
+
 	l *zerolog.Logger
 
 	v validator.Validator
@@ -180,6 +250,14 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		registerReq.MaxRuns = &mr
 	}
 
+	if len(req.ActionSlots) > 0 {
+		registerReq.ActionSlots = make(map[string]int32, len(req.ActionSlots))
+
+		for actionId, slots := range req.ActionSlots {
+			registerReq.ActionSlots[actionId] = int32(slots)
+		}
+	}
+
 	// register the worker
 	resp, err := d.client.Register(d.ctx.newContext(ctx), registerReq)
 
@@ -191,21 +269,37 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 
 	// subscribe to the worker
 	listener, err := d.client.Listen(d.ctx.newContext(ctx), &dispatchercontracts.WorkerListenRequest{
-		WorkerId: resp.WorkerId,
+		WorkerId:   resp.WorkerId,
+		Heartbeats: true,
+		Acks:       true,
 	})
 
 	if err != nil {
 		return nil, fmt.Errorf("could not subscribe to the worker: %w", err)
 	}
 
+	inFlightStepRuns := req.InFlightStepRuns
+
+	if inFlightStepRuns == nil {
+		inFlightStepRuns = func() []string { return nil }
+	}
+
+	onDrained := req.OnDrained
+
+	if onDrained == nil {
+		onDrained = func() {}
+	}
+
 	return &actionListenerImpl{
-		client:       d.client,
-		listenClient: listener,
-		workerId:     resp.WorkerId,
-		l:            d.l,
-		v:            d.v,
-		tenantId:     d.tenantId,
-		ctx:          d.ctx,
+		client:           d.client,
+		listenClient:     listener,
+		workerId:         resp.WorkerId,
+		inFlightStepRuns: inFlightStepRuns,
+		onDrained:        onDrained,
+		l:                d.l,
+		v:                d.v,
+		tenantId:         d.tenantId,
+		ctx:              d.ctx,
 	}, nil
 }
 
@@ -214,6 +308,8 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 
 	a.l.Debug().Msgf("Starting to listen for actions")
 
+	go a.sendHeartbeats(ctx)
+
 	go func() {
 		for {
 			assignedAction, err := a.listenClient.Recv()
@@ -237,8 +333,9 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				statusErr, isStatusErr := status.FromError(err)
 
 				// latter case handles errors like `rpc error: code = Unavailable desc = error reading from server: EOF`
-				// which apparently is not an EOF error
-				if errors.Is(err, io.EOF) || (isStatusErr && statusErr.Code() == codes.Unavailable) {
+				// which apparently is not an EOF error. the dispatcher closes the stream with DeadlineExceeded if it
+				// has not received a heartbeat in time.
+				if errors.Is(err, io.EOF) || (isStatusErr && (statusErr.Code() == codes.Unavailable || statusErr.Code() == codes.DeadlineExceeded)) {
 					err = a.retrySubscribe(ctx)
 
 					if err != nil {
@@ -269,6 +366,11 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 
 			a.l.Debug().Msgf("Received action type: %s", actionType)
 
+			// step runs which were not acknowledged are reassigned by the dispatcher, so they must not run here
+			if actionType == ActionTypeStartStepRun && !a.ackAssignedAction(ctx, assignedAction.StepRunId) {
+				continue
+			}
+
 			unquoted, err := strconv.Unquote(assignedAction.ActionPayload)
 
 			if err != nil {
@@ -288,6 +390,7 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				ActionId:         assignedAction.ActionId,
 				ActionType:       actionType,
 				ActionPayload:    []byte(unquoted),
+				RetryCount:       assignedAction.RetryCount,
 			}
 		}
 	}()
@@ -295,6 +398,26 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 	return ch, nil
 }
 
+// ackAssignedAction acknowledges a step run sent to the worker, and returns whether the worker should run it.
+func (a *actionListenerImpl) ackAssignedAction(ctx context.Context, stepRunId string) bool {
+	_, err := a.client.AckAssignedAction(a.ctx.newContext(ctx), &dispatchercontracts.AssignedActionAck{
+		WorkerId:  a.workerId,
+		StepRunId: stepRunId,
+	})
+
+	if err != nil {
+		if statusErr, ok := status.FromError(err); ok && statusErr.Code() == codes.FailedPrecondition {
+			a.l.Warn().Msgf("step run %s is no longer assigned to this worker, skipping", stepRunId)
+		} else {
+			a.l.Error().Err(err).Msgf("could not acknowledge step run %s, skipping", stepRunId)
+		}
+
+		return false
+	}
+
+	return true
+}
+
 func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 	retries := 0
 
@@ -302,7 +425,9 @@ func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 		time.Sleep(DefaultActionListenerRetryInterval)
 
 		listenClient, err := a.client.Listen(a.ctx.newContext(ctx), &dispatchercontracts.WorkerListenRequest{
-			WorkerId: a.workerId,
+			WorkerId:   a.workerId,
+			Heartbeats: true,
+			Acks:       true,
 		})
 
 		if err != nil {
@@ -319,6 +444,56 @@ func (a *actionListenerImpl) retrySubscribe(ctx context.Context) error {
 	return fmt.Errorf("could not subscribe to the worker after %d retries", retries)
 }
 
+// sendHeartbeats reports the worker's liveness and in-flight step runs to the dispatcher until the context is
+// cancelled. Heartbeats are sent even while the listener is resubscribing, but are rejected by the dispatcher until
+// the worker is listening again.
+func (a *actionListenerImpl) sendHeartbeats(ctx context.Context) {
+	ticker := time.NewTicker(DefaultHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			resp, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
+				WorkerId:           a.workerId,
+				HeartbeatAt:        timestamppb.New(time.Now().UTC()),
+				InFlightStepRunIds: a.inFlightStepRuns(),
+			})
+
+			if err != nil {
+				if ctx.Err() == nil {
+					a.l.Warn().Err(err).Msgf("could not send heartbeat")
+				}
+
+				continue
+			}
+
+			for _, stepRunId := range resp.ReconciledStepRunIds {
+				a.l.Warn().Msgf("step run %s was assigned to this worker but is not running, dispatcher reconciled it", stepRunId)
+			}
+
+			if resp.Drained {
+				a.l.Info().Msgf("worker %s is drained", a.workerId)
+				a.onDrained()
+			}
+		}
+	}
+}
+
+func (a *actionListenerImpl) Drain() error {
+	_, err := a.client.Unsubscribe(
+		a.ctx.newContext(context.Background()),
+		&dispatchercontracts.WorkerUnsubscribeRequest{
+			WorkerId: a.workerId,
+			Drain:    true,
+		},
+	)
+
+	return err
+}
+
 func (a *actionListenerImpl) Unregister() error {
 	_, err := a.client.Unsubscribe(
 		a.ctx.newContext(context.Background()),
@@ -373,6 +548,8 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventTimestamp: timestamppb.New(*in.EventTimestamp),
 		EventType:      actionEventType,
 		EventPayload:   string(payloadBytes),
+		RetryCount:     &in.RetryCount,
+		WorkerShutdown: in.WorkerShutdown,
 	})
 
 	if err != nil {
@@ -429,3 +606,60 @@ func (d *dispatcherClientImpl) SendGroupKeyActionEvent(ctx context.Context, in *
 		WorkerId: resp.WorkerId,
 	}, nil
 }
+
+func (d *dispatcherClientImpl) RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest) (*time.Time, error) {
+	resp, err := d.client.RefreshTimeout(d.ctx.newContext(ctx), &dispatchercontracts.RefreshTimeoutRequest{
+		WorkerId:   in.WorkerId,
+		StepRunId:  in.StepRunId,
+		Timeout:    in.Timeout.String(),
+		RetryCount: &in.RetryCount,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutAt := resp.TimeoutAt.AsTime()
+
+	return &timeoutAt, nil
+}
+
+func (d *dispatcherClientImpl) PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error {
+	_, err := d.client.PutStreamEvent(d.ctx.newContext(ctx), &dispatchercontracts.PutStreamEventRequest{
+		StepRunId: stepRunId,
+		CreatedAt: timestamppb.New(time.Now().UTC()),
+		Message:   message,
+	})
+
+	return err
+}
+
+func (d *dispatcherClientImpl) ReportProgress(ctx context.Context, in *StepRunProgress) (*ActionEventResponse, error) {
+	// validate the request
+	if err := d.v.Validate(in); err != nil {
+		return nil, err
+	}
+
+	req := &dispatchercontracts.StepRunProgress{
+		WorkerId:       in.WorkerId,
+		StepRunId:      in.StepRunId,
+		Progress:       int32(in.Progress),
+		EventTimestamp: timestamppb.New(time.Now().UTC()),
+		RetryCount:     &in.RetryCount,
+	}
+
+	if in.Message != "" {
+		req.Message = &in.Message
+	}
+
+	resp, err := d.client.ReportProgress(d.ctx.newContext(ctx), req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActionEventResponse{
+		TenantId: resp.TenantId,
+		WorkerId: resp.WorkerId,
+	}, nil
+}
@@ -2,9 +2,16 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 	"github.com/hatchet-dev/hatchet/internal/validator"
@@ -12,8 +19,14 @@ import (
 
 type RunHandler func(event *StepRunEvent) error
 
+type WorkflowEventHandler func(event *WorkflowEvent) error
+
 type RunClient interface {
 	On(ctx context.Context, workflowRunId string, handler RunHandler) error
+
+	// Subscribe calls the handler for the live events of all workflow runs of the tenant which match the options,
+	// until the context is cancelled or the handler returns an error.
+	Subscribe(ctx context.Context, opts *SubscribeOpts, handler WorkflowEventHandler) error
 }
 
 type StepRunEventType string
@@ -24,14 +37,50 @@ const (
 	StepRunEventTypeFailed    StepRunEventType = "STEP_RUN_EVENT_TYPE_FAILED"
 	StepRunEventTypeCancelled StepRunEventType = "STEP_RUN_EVENT_TYPE_CANCELLED"
 	StepRunEventTypeTimedOut  StepRunEventType = "STEP_RUN_EVENT_TYPE_TIMED_OUT"
+	StepRunEventTypeProgress  StepRunEventType = "STEP_RUN_EVENT_TYPE_PROGRESS"
+	StepRunEventTypeStream    StepRunEventType = "STEP_RUN_EVENT_TYPE_STREAM"
 )
 
 type StepRunEvent struct {
 	Type StepRunEventType
 
+	// Payload is the event payload. For stream events, this is the chunk which the step streamed.
 	Payload []byte
 }
 
+type ResourceType string
+
+const (
+	ResourceTypeStepRun     ResourceType = "RESOURCE_TYPE_STEP_RUN"
+	ResourceTypeWorkflowRun ResourceType = "RESOURCE_TYPE_WORKFLOW_RUN"
+)
+
+// WorkflowEvent is an event of a step run or a workflow run of the tenant.
+type WorkflowEvent struct {
+	WorkflowRunId string
+	ResourceType  ResourceType
+	ResourceId    string
+	Type          StepRunEventType
+
+	// Payload is the event payload. For stream events, this is the chunk which the step streamed.
+	Payload []byte
+
+	// Timestamp is the time of the event, or the zero time if the engine did not send it.
+	Timestamp time.Time
+}
+
+type SubscribeOpts struct {
+	// (optional) only receive events of runs of these workflows
+	WorkflowIds []string
+
+	// (optional) only receive events of these types. Stream events are only received if StepRunEventTypeStream
+	// is set.
+	EventTypes []StepRunEventType
+
+	// (optional) only receive events of these resource types
+	ResourceTypes []ResourceType
+}
+
 type ClientEventListener interface {
 	OnStepRunEvent(ctx context.Context, event *StepRunEvent) error
 	// OnWorkflowRunEvent(ctx context.Context, event *WorkflowRunEvent) error
@@ -56,42 +105,250 @@ func newRun(conn *grpc.ClientConn, opts *sharedClientOpts) RunClient {
 	}
 }
 
+// On subscribes to the events of a workflow run and calls the handler for each event until the workflow run
+// finishes. If the connection to the engine drops, the subscription is resumed after the received events, so
+// events are not missed.
 func (r *runClientImpl) On(ctx context.Context, workflowRunId string, handler RunHandler) error {
-	stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), &dispatchercontracts.SubscribeToWorkflowEventsRequest{
-		WorkflowRunId: workflowRunId,
+	cursor := newEventCursor()
+
+	return r.withRetries(ctx, func(retries *int) (bool, error) {
+		req := &dispatchercontracts.SubscribeToWorkflowEventsRequest{
+			WorkflowRunId: workflowRunId,
+			FromBeginning: cursor.last == 0,
+		}
+
+		if cursor.last != 0 {
+			lastEventId := cursor.last
+			req.LastEventId = &lastEventId
+		}
+
+		stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), req)
+
+		if err != nil {
+			return false, err
+		}
+
+		for {
+			event, err := stream.Recv()
+
+			if err != nil {
+				return false, err
+			}
+
+			// the subscription is healthy again
+			*retries = 0
+
+			// events which are not persisted don't have an id, and can't be resumed from. events which were
+			// already received before resuming are replayed again, and are skipped.
+			if event.EventId != 0 && !cursor.add(event.EventId) {
+				continue
+			}
+
+			// the handler receives step run events and the final event of the workflow run
+			if event.ResourceType == dispatchercontracts.ResourceType_RESOURCE_TYPE_WORKFLOW_RUN && !event.Hangup {
+				continue
+			}
+
+			e, err := toWorkflowEvent(event)
+
+			if err != nil {
+				return false, err
+			}
+
+			if err := handler(&StepRunEvent{
+				Type:    e.Type,
+				Payload: e.Payload,
+			}); err != nil {
+				return false, err
+			}
+
+			if event.Hangup {
+				return true, nil
+			}
+		}
 	})
+}
+
+// eventCursor tracks the received events of a workflow run. Event ids increase by one within a workflow run, but live
+// events can be received out of order, so the cursor is the id up to which every event was received. Events after it
+// which were received are remembered, so they are skipped when they are replayed after resuming.
+type eventCursor struct {
+	// every event up to this id was received, or 0 if the first event was not received yet
+	last int64
 
-	if err != nil {
-		return err
+	// the ids of received events after last
+	received map[int64]bool
+}
+
+func newEventCursor() *eventCursor {
+	return &eventCursor{
+		received: map[int64]bool{},
 	}
+}
 
-	for {
-		event, err := stream.Recv()
+// add records a received event, and returns false if the event was already received.
+func (c *eventCursor) add(eventId int64) bool {
+	if eventId <= c.last || c.received[eventId] {
+		return false
+	}
+
+	c.received[eventId] = true
+
+	for c.received[c.last+1] {
+		delete(c.received, c.last+1)
+		c.last++
+	}
+
+	return true
+}
+
+// Subscribe receives live events only, so events which are sent while the connection to the engine is dropped are
+// missed.
+func (r *runClientImpl) Subscribe(ctx context.Context, opts *SubscribeOpts, handler WorkflowEventHandler) error {
+	req := &dispatchercontracts.SubscribeToTenantEventsRequest{
+		WorkflowIds: opts.WorkflowIds,
+	}
+
+	for _, eventType := range opts.EventTypes {
+		contractType, ok := eventTypes[eventType]
+
+		if !ok {
+			return fmt.Errorf("unknown event type %s", eventType)
+		}
+
+		req.EventTypes = append(req.EventTypes, contractType)
+	}
+
+	for _, resourceType := range opts.ResourceTypes {
+		contractType, ok := dispatchercontracts.ResourceType_value[string(resourceType)]
+
+		if !ok {
+			return fmt.Errorf("unknown resource type %s", resourceType)
+		}
+
+		req.ResourceTypes = append(req.ResourceTypes, dispatchercontracts.ResourceType(contractType))
+	}
+
+	return r.withRetries(ctx, func(retries *int) (bool, error) {
+		stream, err := r.client.SubscribeToTenantEvents(r.ctx.newContext(ctx), req)
 
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		var eventType StepRunEventType
-
-		switch event.EventType {
-		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED:
-			eventType = StepRunEventTypeStarted
-		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED:
-			eventType = StepRunEventTypeCompleted
-		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED:
-			eventType = StepRunEventTypeFailed
-		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED:
-			eventType = StepRunEventTypeCancelled
-		case dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT:
-			eventType = StepRunEventTypeTimedOut
+		for {
+			event, err := stream.Recv()
+
+			if err != nil {
+				return false, err
+			}
+
+			// the subscription is healthy again
+			*retries = 0
+
+			e, err := toWorkflowEvent(event)
+
+			if err != nil {
+				return false, err
+			}
+
+			if err := handler(e); err != nil {
+				return false, err
+			}
+		}
+	})
+}
+
+// withRetries calls subscribe until it is done, resubscribing if the connection to the engine drops. Subscribe
+// should reset the retries once it receives an event.
+func (r *runClientImpl) withRetries(ctx context.Context, subscribe func(retries *int) (bool, error)) error {
+	retries := 0
+
+	for {
+		done, err := subscribe(&retries)
+
+		if done {
+			return nil
 		}
 
-		if err := handler(&StepRunEvent{
-			Type:    eventType,
-			Payload: []byte(event.EventPayload),
-		}); err != nil {
+		if !isResumable(ctx, err) || retries >= DefaultActionListenerRetryCount {
 			return err
 		}
+
+		retries++
+
+		r.l.Warn().Err(err).Msgf("subscription dropped, resubscribing in %s", DefaultActionListenerRetryInterval)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(DefaultActionListenerRetryInterval):
+		}
 	}
 }
+
+var eventTypes = map[StepRunEventType]dispatchercontracts.ResourceEventType{
+	StepRunEventTypeStarted:   dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED,
+	StepRunEventTypeCompleted: dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED,
+	StepRunEventTypeFailed:    dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED,
+	StepRunEventTypeCancelled: dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED,
+	StepRunEventTypeTimedOut:  dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT,
+	StepRunEventTypeProgress:  dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_PROGRESS,
+	StepRunEventTypeStream:    dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM,
+}
+
+var contractEventTypes = map[dispatchercontracts.ResourceEventType]StepRunEventType{
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STARTED:   StepRunEventTypeStarted,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_COMPLETED: StepRunEventTypeCompleted,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_FAILED:    StepRunEventTypeFailed,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_CANCELLED: StepRunEventTypeCancelled,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_TIMED_OUT: StepRunEventTypeTimedOut,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_PROGRESS:  StepRunEventTypeProgress,
+	dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM:    StepRunEventTypeStream,
+}
+
+func toWorkflowEvent(event *dispatchercontracts.WorkflowEvent) (*WorkflowEvent, error) {
+	eventType := contractEventTypes[event.EventType]
+
+	e := &WorkflowEvent{
+		WorkflowRunId: event.WorkflowRunId,
+		ResourceType:  ResourceType(event.ResourceType.String()),
+		ResourceId:    event.ResourceId,
+		Type:          eventType,
+		Payload:       []byte(event.EventPayload),
+	}
+
+	if event.EventTimestamp != nil {
+		e.Timestamp = event.EventTimestamp.AsTime()
+	}
+
+	// stream events carry the base64-encoded chunk
+	if eventType == StepRunEventTypeStream {
+		payload, err := base64.StdEncoding.DecodeString(event.EventPayload)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decode stream event: %w", err)
+		}
+
+		e.Payload = payload
+	}
+
+	return e, nil
+}
+
+// isResumable returns true if the subscription failed because the connection to the engine dropped.
+func isResumable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+
+	if statusErr, ok := status.FromError(err); ok {
+		return statusErr.Code() == codes.Unavailable || statusErr.Code() == codes.DeadlineExceeded
+	}
+
+	return false
+}
@@ -18,11 +18,23 @@ type Workflow struct {
 
 	Description string `yaml:"description,omitempty"`
 
+	// the strategy for selecting a worker to assign a step run to, defaults to the tenant's strategy
+	WorkerSelection WorkerSelectionStrategy `yaml:"workerSelection,omitempty"`
+
 	Triggers WorkflowTriggers `yaml:"triggers"`
 
 	Jobs map[string]WorkflowJob `yaml:"jobs"`
 }
 
+type WorkerSelectionStrategy string
+
+const (
+	LeastLoaded         WorkerSelectionStrategy = "LEAST_LOADED"
+	RoundRobin          WorkerSelectionStrategy = "ROUND_ROBIN"
+	Random              WorkerSelectionStrategy = "RANDOM"
+	MostRecentHeartbeat WorkerSelectionStrategy = "MOST_RECENT_HEARTBEAT"
+)
+
 type WorkflowConcurrencyLimitStrategy string
 
 const (
@@ -33,6 +45,10 @@ const (
 type WorkflowConcurrency struct {
 	ActionID string `yaml:"action,omitempty"`
 
+	// a key expression over the workflow input, such as input.customer_id, which is evaluated by the engine
+	// instead of running an action on a worker
+	Expression string `yaml:"expression,omitempty"`
+
 	MaxRuns int32 `yaml:"maxRuns,omitempty"`
 
 	LimitStrategy WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
@@ -42,8 +58,41 @@ type WorkflowTriggers struct {
 	Events    []string    `yaml:"events,omitempty"`
 	Cron      []string    `yaml:"crons,omitempty"`
 	Schedules []time.Time `yaml:"schedules,omitempty"`
+
+	// cron triggers with a time zone or input, crons in Cron are evaluated in UTC without input
+	CronTriggers []WorkflowCronTrigger `yaml:"cronTriggers,omitempty"`
+}
+
+type WorkflowCronTrigger struct {
+	Expression string `yaml:"expression"`
+
+	// the IANA time zone the expression is evaluated in, such as America/New_York, defaults to UTC
+	Timezone string `yaml:"timezone,omitempty"`
+
+	// the input for workflow runs triggered by the cron
+	Input map[string]interface{} `yaml:"input,omitempty"`
+
+	// what to do with fires which were missed while the cron was not running, defaults to skipping them
+	MisfirePolicy CronMisfirePolicy `yaml:"misfirePolicy,omitempty"`
+
+	// the maximum number of missed fires which are run with the RunAllMisfires policy
+	MaxMisfires int32 `yaml:"maxMisfires,omitempty"`
+
+	// the window every fire is delayed within, such as 15m, by an amount which is stable for the workflow. The
+	// random shorthands such as random_hourly are jittered over their interval by default.
+	Jitter string `yaml:"jitter,omitempty"`
 }
 
+type CronMisfirePolicy string
+
+const (
+	SkipMisfires   CronMisfirePolicy = "SKIP"
+	RunOnceMisfire CronMisfirePolicy = "RUN_ONCE"
+	RunAllMisfires CronMisfirePolicy = "RUN_ALL"
+)
+
+// RandomScheduleOpt is a shorthand for a cron which fires once in each interval, at a point of the interval which
+// is stable for the workflow. It can be used as the expression of a cron.
 type RandomScheduleOpt string
 
 const (
@@ -69,13 +118,21 @@ type WorkflowJob struct {
 }
 
 type WorkflowStep struct {
-	Name     string                 `yaml:"name,omitempty"`
-	ID       string                 `yaml:"id,omitempty"`
-	ActionID string                 `yaml:"action"`
-	Timeout  string                 `yaml:"timeout,omitempty"`
-	With     map[string]interface{} `yaml:"with,omitempty"`
-	Parents  []string               `yaml:"parents,omitempty"`
-	Retries  int                    `yaml:"retries"`
+	Name        string                   `yaml:"name,omitempty"`
+	ID          string                   `yaml:"id,omitempty"`
+	ActionID    string                   `yaml:"action"`
+	Timeout     string                   `yaml:"timeout,omitempty"`
+	With        map[string]interface{}   `yaml:"with,omitempty"`
+	Parents     []string                 `yaml:"parents,omitempty"`
+	Retries     int                      `yaml:"retries"`
+	Concurrency *WorkflowStepConcurrency `yaml:"concurrency,omitempty"`
+}
+
+type WorkflowStepConcurrency struct {
+	// Key is an expression evaluated against the step run input, such as input.customer_id
+	Key string `yaml:"key"`
+
+	MaxRuns int32 `yaml:"maxRuns,omitempty"`
 }
 
 func ParseYAML(ctx context.Context, yamlBytes []byte) (Workflow, error) {
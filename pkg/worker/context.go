@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
@@ -20,6 +21,17 @@ type HatchetContext interface {
 	TriggeredByEvent() bool
 
 	WorkflowInput(target interface{}) error
+
+	// RefreshTimeout moves the step run's timeout to the given duration from now, so long running steps can
+	// declare a short timeout and extend it while they make progress.
+	RefreshTimeout(timeout time.Duration) error
+
+	// ReportProgress reports the step run's progress in percent, between 0 and 100, with an optional message.
+	ReportProgress(progress int, message string) error
+
+	// StreamEvent streams a chunk of partial output, such as generated tokens, to subscribers of the workflow
+	// run. Chunks of a step run are delivered in order, and are not persisted.
+	StreamEvent(message []byte) error
 }
 
 // TODO: move this into proto definitions
@@ -49,12 +61,14 @@ type hatchetContext struct {
 	context.Context
 	action   *client.Action
 	stepData *StepRunData
+	client   client.Client
 }
 
-func newHatchetContext(ctx context.Context, action *client.Action) (HatchetContext, error) {
+func newHatchetContext(ctx context.Context, action *client.Action, workerClient client.Client) (HatchetContext, error) {
 	c := &hatchetContext{
 		Context: ctx,
 		action:  action,
+		client:  workerClient,
 	}
 
 	if action.GetGroupKeyRunId != "" {
@@ -98,6 +112,59 @@ func (h *hatchetContext) WorkflowInput(target interface{}) error {
 	return toTarget(h.stepData.Input, target)
 }
 
+func (h *hatchetContext) RefreshTimeout(timeout time.Duration) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("timeouts can only be refreshed for step runs")
+	}
+
+	_, err := h.client.Dispatcher().RefreshTimeout(h.GetContext(), &client.RefreshTimeoutRequest{
+		WorkerId:   h.action.WorkerId,
+		StepRunId:  h.action.StepRunId,
+		RetryCount: h.action.RetryCount,
+		Timeout:    timeout,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not refresh timeout: %w", err)
+	}
+
+	return nil
+}
+
+func (h *hatchetContext) ReportProgress(progress int, message string) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("progress can only be reported for step runs")
+	}
+
+	_, err := h.client.Dispatcher().ReportProgress(h.GetContext(), &client.StepRunProgress{
+		WorkerId:   h.action.WorkerId,
+		StepRunId:  h.action.StepRunId,
+		Progress:   progress,
+		Message:    message,
+		RetryCount: h.action.RetryCount,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not report progress: %w", err)
+	}
+
+	return nil
+}
+
+func (h *hatchetContext) StreamEvent(message []byte) error {
+	if h.action.StepRunId == "" {
+		return fmt.Errorf("stream events can only be sent for step runs")
+	}
+
+	err := h.client.Dispatcher().PutStreamEvent(h.GetContext(), h.action.StepRunId, message)
+
+	if err != nil {
+		return fmt.Errorf("could not put stream event: %w", err)
+	}
+
+	return nil
+}
+
 func (h *hatchetContext) populateStepDataForGroupKeyRun() error {
 	if h.stepData != nil {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 )
 
 type testHatchetContext struct {
@@ -30,6 +31,18 @@ func (c *testHatchetContext) WorkflowInput(target interface{}) error {
 	return nil
 }
 
+func (c *testHatchetContext) RefreshTimeout(timeout time.Duration) error {
+	return nil
+}
+
+func (c *testHatchetContext) ReportProgress(progress int, message string) error {
+	return nil
+}
+
+func (c *testHatchetContext) StreamEvent(message []byte) error {
+	return nil
+}
+
 func TestAddMiddleware(t *testing.T) {
 	m := middlewares{}
 	middlewareFunc := func(ctx HatchetContext, next func(HatchetContext) error) error {
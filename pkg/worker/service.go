@@ -60,7 +60,8 @@ func (s *Service) On(t triggerConverter, workflow workflowConverter) error {
 }
 
 type registerActionOpts struct {
-	name string
+	name  string
+	slots *int
 }
 
 type RegisterActionOpt func(*registerActionOpts)
@@ -71,6 +72,14 @@ func WithActionName(name string) RegisterActionOpt {
 	}
 }
 
+// WithSlots sets the maximum number of runs for the action which the worker runs at a time. This is in addition to
+// the worker's max runs, so an expensive action can be limited on a worker which runs many cheaper actions.
+func WithSlots(slots int) RegisterActionOpt {
+	return func(opts *registerActionOpts) {
+		opts.slots = &slots
+	}
+}
+
 func (s *Service) RegisterAction(fn any, opts ...RegisterActionOpt) error {
 	fnOpts := &registerActionOpts{}
 
@@ -82,7 +91,17 @@ func (s *Service) RegisterAction(fn any, opts ...RegisterActionOpt) error {
 		fnOpts.name = getFnName(fn)
 	}
 
-	return s.worker.registerAction(s.Name, fnOpts.name, fn)
+	err := s.worker.registerAction(s.Name, fnOpts.name, fn)
+
+	if err != nil {
+		return err
+	}
+
+	if fnOpts.slots != nil {
+		s.worker.actionSlots[fmt.Sprintf("%s:%s", s.Name, fnOpts.name)] = *fnOpts.slots
+	}
+
+	return nil
 }
 
 func (s *Service) Call(verb string) *WorkflowStep {
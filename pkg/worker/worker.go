@@ -18,6 +18,10 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/integrations"
 )
 
+// ErrWorkerShutdown is the cause of the context of a step run which was cancelled because the worker shut down before
+// the step run finished.
+var ErrWorkerShutdown = fmt.Errorf("worker shutdown")
+
 type actionFunc func(args ...any) []any
 
 // Action is an individual action that can be run by the worker.
@@ -77,6 +81,14 @@ type Worker struct {
 
 	cancelConcurrencyMap sync.Map
 
+	// inFlightStepRuns holds the actions of the step runs which were assigned to the worker and have not finished,
+	// keyed by step run id
+	inFlightStepRuns sync.Map
+
+	// drained is closed once the dispatcher reports that the worker is drained
+	drained     chan struct{}
+	drainedOnce sync.Once
+
 	services sync.Map
 
 	alerter errors.Alerter
@@ -84,6 +96,10 @@ type Worker struct {
 	middlewares *middlewares
 
 	maxRuns *int
+
+	actionSlots map[string]int
+
+	shutdownTimeout *time.Duration
 }
 
 type WorkerOpt func(*WorkerOpts)
@@ -96,6 +112,9 @@ type WorkerOpts struct {
 	integrations []integrations.Integration
 	alerter      errors.Alerter
 	maxRuns      *int
+	actionSlots  map[string]int
+
+	shutdownTimeout *time.Duration
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -106,6 +125,7 @@ func defaultWorkerOpts() *WorkerOpts {
 		l:            &logger,
 		integrations: []integrations.Integration{},
 		alerter:      errors.NoOpAlerter{},
+		actionSlots:  map[string]int{},
 	}
 }
 
@@ -139,6 +159,24 @@ func WithMaxRuns(maxRuns int) WorkerOpt {
 	}
 }
 
+// WithActionSlots sets the maximum number of runs for an action which the worker runs at a time. This can be used
+// for the actions of workflow steps, which are not registered with RegisterAction.
+func WithActionSlots(actionId string, slots int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.actionSlots[actionId] = slots
+	}
+}
+
+// WithGracefulShutdown makes the worker shut down gracefully: when it is stopped, it is no longer assigned new step
+// runs and waits up to the timeout for its in-flight step runs to finish and report their results. Step runs which
+// are still running after the timeout are cancelled with ErrWorkerShutdown, and are retried on another worker without
+// counting against the step's retries.
+func WithGracefulShutdown(timeout time.Duration) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.shutdownTimeout = &timeout
+	}
+}
+
 // NewWorker creates a new worker instance
 func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	opts := defaultWorkerOpts()
@@ -159,6 +197,10 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		alerter:     opts.alerter,
 		middlewares: mws,
 		maxRuns:     opts.maxRuns,
+		actionSlots: opts.actionSlots,
+		drained:     make(chan struct{}),
+
+		shutdownTimeout: opts.shutdownTimeout,
 	}
 
 	// register all integrations
@@ -218,7 +260,9 @@ func (w *Worker) On(t triggerConverter, workflow workflowConverter) error {
 // - func(ctx context.Context, input *Input) error
 // - func(ctx context.Context, input *Input) (*Output, error)
 // - func(ctx context.Context) (*Output, error)
-func (w *Worker) RegisterAction(actionId string, method any) error {
+//
+// The action's slots can be set with WithSlots. The action name is always taken from the action id.
+func (w *Worker) RegisterAction(actionId string, method any, opts ...RegisterActionOpt) error {
 	// parse the action
 	action, err := types.ParseActionID(actionId)
 
@@ -226,7 +270,23 @@ func (w *Worker) RegisterAction(actionId string, method any) error {
 		return fmt.Errorf("could not parse action id: %w", err)
 	}
 
-	return w.registerAction(action.Service, action.Verb, method)
+	fnOpts := &registerActionOpts{}
+
+	for _, opt := range opts {
+		opt(fnOpts)
+	}
+
+	err = w.registerAction(action.Service, action.Verb, method)
+
+	if err != nil {
+		return err
+	}
+
+	if fnOpts.slots != nil {
+		w.actionSlots[fmt.Sprintf("%s:%s", action.Service, action.Verb)] = *fnOpts.slots
+	}
+
+	return nil
 }
 
 func (w *Worker) registerAction(service, verb string, method any) error {
@@ -272,15 +332,30 @@ func (w *Worker) Start() (func() error, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	actionNames := []string{}
+	actionSlots := map[string]int{}
 
 	for _, action := range w.actions {
 		actionNames = append(actionNames, action.Name())
+
+		if slots, ok := w.actionSlots[action.Name()]; ok {
+			actionSlots[action.Name()] = slots
+		}
 	}
 
 	listener, err := w.client.Dispatcher().GetActionListener(ctx, &client.GetActionListenerRequest{
-		WorkerName: w.name,
-		Actions:    actionNames,
-		MaxRuns:    w.maxRuns,
+		WorkerName:       w.name,
+		Actions:          actionNames,
+		MaxRuns:          w.maxRuns,
+		ActionSlots:      actionSlots,
+		InFlightStepRuns: w.inFlightStepRunIds,
+		OnDrained: func() {
+			w.drainedOnce.Do(func() {
+				close(w.drained)
+			})
+
+			// a drained worker is not assigned new step runs, so it stops listening
+			cancel()
+		},
 	})
 
 	if err != nil {
@@ -298,8 +373,27 @@ func (w *Worker) Start() (func() error, error) {
 	go func() {
 		for {
 			select {
-			case action := <-actionCh:
+			case action, ok := <-actionCh:
+				// the channel is closed once the listener stops
+				if !ok {
+					return
+				}
+
+				// the step run is tracked until its final event has been sent, so the dispatcher does not reconcile
+				// a step run which is still being reported. step runs are delivered at least once, so a step run
+				// which is already running on this worker is skipped.
+				if action.ActionType == client.ActionTypeStartStepRun {
+					if _, running := w.inFlightStepRuns.LoadOrStore(action.StepRunId, action); running {
+						w.l.Debug().Msgf("step run %s is already running, skipping", action.StepRunId)
+						continue
+					}
+				}
+
 				go func(action *client.Action) {
+					if action.ActionType == client.ActionTypeStartStepRun {
+						defer w.inFlightStepRuns.Delete(action.StepRunId)
+					}
+
 					err := w.executeAction(context.Background(), action)
 
 					if err != nil {
@@ -316,6 +410,10 @@ func (w *Worker) Start() (func() error, error) {
 	}()
 
 	cleanup := func() error {
+		if w.shutdownTimeout != nil {
+			w.shutdown(listener, *w.shutdownTimeout)
+		}
+
 		cancel()
 
 		w.l.Debug().Msgf("worker %s is stopping...", w.name)
@@ -333,6 +431,76 @@ func (w *Worker) Start() (func() error, error) {
 	return cleanup, nil
 }
 
+// shutdown stops the worker from being assigned new step runs and waits up to the timeout for its in-flight step
+// runs to finish. The worker keeps listening while it waits, so it still receives cancellations.
+func (w *Worker) shutdown(listener client.WorkerActionListener, timeout time.Duration) {
+	w.l.Debug().Msgf("worker %s is draining...", w.name)
+
+	if err := listener.Drain(); err != nil {
+		w.l.Error().Err(err).Msgf("could not drain worker %s", w.name)
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for len(w.inFlightStepRunIds()) > 0 {
+		select {
+		case <-deadline.C:
+			w.cancelInFlightStepRuns()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cancelInFlightStepRuns cancels the step runs which did not finish before the worker shut down, and reports them as
+// failed because of the shutdown so the engine retries them.
+func (w *Worker) cancelInFlightStepRuns() {
+	w.inFlightStepRuns.Range(func(_, value any) bool {
+		action := value.(*client.Action)
+
+		w.l.Warn().Msgf("cancelling step run %s which did not finish before the worker shut down", action.StepRunId)
+
+		if cancel, ok := w.cancelMap.Load(action.StepRunId); ok {
+			cancel.(context.CancelCauseFunc)(ErrWorkerShutdown)
+		}
+
+		failureEvent := w.getActionEvent(action, client.ActionEventTypeFailed)
+
+		failureEvent.EventPayload = ErrWorkerShutdown.Error()
+		failureEvent.WorkerShutdown = true
+
+		_, err := w.client.Dispatcher().SendStepActionEvent(context.Background(), failureEvent)
+
+		if err != nil {
+			w.l.Error().Err(err).Msgf("could not report step run %s as cancelled", action.StepRunId)
+		}
+
+		return true
+	})
+}
+
+// Drained returns a channel which is closed once the worker was drained from the API and its in-flight step runs
+// have finished. The worker stops listening for actions when it is drained, so the cleanup func returned by Start
+// should be called to unregister the worker before exiting.
+func (w *Worker) Drained() <-chan struct{} {
+	return w.drained
+}
+
+func (w *Worker) inFlightStepRunIds() []string {
+	stepRunIds := []string{}
+
+	w.inFlightStepRuns.Range(func(key, _ any) bool {
+		stepRunIds = append(stepRunIds, key.(string))
+		return true
+	})
+
+	return stepRunIds
+}
+
 func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Action) error {
 	switch assignedAction.ActionType {
 	case client.ActionTypeStartStepRun:
@@ -369,11 +537,11 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 		return fmt.Errorf("could not decode args to interface: %w", err)
 	}
 
-	runContext, cancel := context.WithCancel(context.Background())
+	runContext, cancel := context.WithCancelCause(context.Background())
 
 	w.cancelMap.Store(assignedAction.StepRunId, cancel)
 
-	hCtx, err := newHatchetContext(runContext, assignedAction)
+	hCtx, err := newHatchetContext(runContext, assignedAction, w.client)
 
 	if err != nil {
 		return fmt.Errorf("could not create hatchet context: %w", err)
@@ -490,7 +658,7 @@ func (w *Worker) startGetGroupKey(ctx context.Context, assignedAction *client.Ac
 
 	w.cancelConcurrencyMap.Store(assignedAction.WorkflowRunId, cancel)
 
-	hCtx, err := newHatchetContext(runContext, assignedAction)
+	hCtx, err := newHatchetContext(runContext, assignedAction, w.client)
 
 	if err != nil {
 		return fmt.Errorf("could not create hatchet context: %w", err)
@@ -551,9 +719,9 @@ func (w *Worker) cancelStepRun(ctx context.Context, assignedAction *client.Actio
 
 	w.l.Debug().Msgf("cancelling step run %s", assignedAction.StepRunId)
 
-	cancelFn := cancel.(context.CancelFunc)
+	cancelFn := cancel.(context.CancelCauseFunc)
 
-	cancelFn()
+	cancelFn(nil)
 
 	return nil
 }
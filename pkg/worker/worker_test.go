@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestRegisterActionSlots(t *testing.T) {
+	w, err := NewWorker(
+		WithActionSlots("default:step-one", 5),
+	)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.RegisterAction("default:render-video", func(ctx context.Context) error {
+		return nil
+	}, WithSlots(1))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.NewService("email").RegisterAction(func(ctx context.Context) error {
+		return nil
+	}, WithActionName("send"), WithSlots(10))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.RegisterAction("default:unlimited", func(ctx context.Context) error {
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string]int{
+		"default:step-one":     5,
+		"default:render-video": 1,
+		"email:send":           10,
+	}, w.actionSlots)
+}
+
+type fakeListener struct {
+	client.WorkerActionListener
+
+	drained bool
+}
+
+func (l *fakeListener) Drain() error {
+	l.drained = true
+	return nil
+}
+
+type fakeDispatcher struct {
+	client.DispatcherClient
+
+	mu     sync.Mutex
+	events []*client.ActionEvent
+}
+
+func (d *fakeDispatcher) SendStepActionEvent(ctx context.Context, in *client.ActionEvent) (*client.ActionEventResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.events = append(d.events, in)
+
+	return &client.ActionEventResponse{}, nil
+}
+
+type fakeClient struct {
+	client.Client
+
+	dispatcher *fakeDispatcher
+}
+
+func (c *fakeClient) Dispatcher() client.DispatcherClient {
+	return c.dispatcher
+}
+
+func TestShutdown(t *testing.T) {
+	dispatcher := &fakeDispatcher{}
+
+	w, err := NewWorker(
+		WithClient(&fakeClient{dispatcher: dispatcher}),
+		WithGracefulShutdown(500*time.Millisecond),
+	)
+
+	require.NoError(t, err)
+
+	// a step run which finishes during the shutdown is not cancelled
+	finishing := &client.Action{StepRunId: "finishing", ActionType: client.ActionTypeStartStepRun}
+	w.inFlightStepRuns.Store(finishing.StepRunId, finishing)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		w.inFlightStepRuns.Delete(finishing.StepRunId)
+	}()
+
+	// a step run which is still running after the timeout is cancelled and reported
+	stuck := &client.Action{StepRunId: "stuck", ActionType: client.ActionTypeStartStepRun}
+	w.inFlightStepRuns.Store(stuck.StepRunId, stuck)
+
+	stuckCtx, cancel := context.WithCancelCause(context.Background())
+	w.cancelMap.Store(stuck.StepRunId, cancel)
+
+	listener := &fakeListener{}
+
+	w.shutdown(listener, *w.shutdownTimeout)
+
+	assert.True(t, listener.drained)
+	assert.ErrorIs(t, context.Cause(stuckCtx), ErrWorkerShutdown)
+
+	require.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "stuck", dispatcher.events[0].StepRunId)
+	assert.Equal(t, client.ActionEventTypeFailed, dispatcher.events[0].EventType)
+	assert.True(t, dispatcher.events[0].WorkerShutdown)
+}
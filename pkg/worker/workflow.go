@@ -14,18 +14,99 @@ type triggerConverter interface {
 	ToWorkflowTriggers(*types.WorkflowTriggers)
 }
 
-type cron string
+type cron struct {
+	expression    string
+	timezone      string
+	input         map[string]interface{}
+	misfirePolicy types.CronMisfirePolicy
+	maxMisfires   int32
+	jitter        time.Duration
+}
+
+type CronOpt func(*cron)
+
+// WithTimezone evaluates the cron expression in an IANA time zone, such as America/New_York, instead of UTC.
+func WithTimezone(timezone string) CronOpt {
+	return func(c *cron) {
+		c.timezone = timezone
+	}
+}
+
+// WithCronInput sets the input of workflow runs triggered by the cron.
+func WithCronInput(input map[string]interface{}) CronOpt {
+	return func(c *cron) {
+		c.input = input
+	}
+}
+
+// WithMisfirePolicy sets what to do with fires which were missed while the cron was not running. By default, missed
+// fires are skipped.
+func WithMisfirePolicy(policy types.CronMisfirePolicy) CronOpt {
+	return func(c *cron) {
+		c.misfirePolicy = policy
+	}
+}
+
+// WithMaxMisfires sets the maximum number of missed fires which are run with the types.RunAllMisfires policy.
+func WithMaxMisfires(maxMisfires int32) CronOpt {
+	return func(c *cron) {
+		c.maxMisfires = maxMisfires
+	}
+}
+
+// WithJitter delays every fire of the cron by an amount of time within the window, which is stable for the workflow,
+// so crons which share an expression do not all fire at once.
+func WithJitter(window time.Duration) CronOpt {
+	return func(c *cron) {
+		c.jitter = window
+	}
+}
+
+// RandomCron returns a cron which fires once in each interval of a random schedule, such as types.RandomHourly, at a
+// point of the interval which is stable for the workflow.
+func RandomCron(schedule types.RandomScheduleOpt, opts ...CronOpt) cron {
+	return Cron(string(schedule), opts...)
+}
+
+func Cron(expression string, opts ...CronOpt) cron {
+	c := cron{
+		expression: expression,
+	}
 
-func Cron(c string) cron {
-	return cron(c)
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
 }
 
 func (c cron) ToWorkflowTriggers(wt *types.WorkflowTriggers) {
-	if wt.Cron == nil {
-		wt.Cron = []string{}
+	if c.timezone == "" && c.input == nil && c.misfirePolicy == "" && c.jitter == 0 {
+		if wt.Cron == nil {
+			wt.Cron = []string{}
+		}
+
+		wt.Cron = append(wt.Cron, c.expression)
+
+		return
+	}
+
+	wt.CronTriggers = append(wt.CronTriggers, types.WorkflowCronTrigger{
+		Expression:    c.expression,
+		Timezone:      c.timezone,
+		Input:         c.input,
+		MisfirePolicy: c.misfirePolicy,
+		MaxMisfires:   c.maxMisfires,
+		Jitter:        formatJitter(c.jitter),
+	})
+}
+
+func formatJitter(jitter time.Duration) string {
+	if jitter == 0 {
+		return ""
 	}
 
-	wt.Cron = append(wt.Cron, string(c))
+	return jitter.String()
 }
 
 type cronArr []string
@@ -134,19 +215,39 @@ type WorkflowJob struct {
 
 	Concurrency *WorkflowConcurrency
 
+	// (optional) the strategy for selecting a worker to assign a step run to, defaults to the tenant's strategy
+	WorkerSelection types.WorkerSelectionStrategy
+
 	// The steps that are run in the job
 	Steps []*WorkflowStep
 }
 
 type WorkflowConcurrency struct {
 	fn            GetWorkflowConcurrencyGroupFn
+	expression    string
 	maxRuns       *int32
 	limitStrategy *types.WorkflowConcurrencyLimitStrategy
 }
 
-func Concurrency(fn GetWorkflowConcurrencyGroupFn) *WorkflowConcurrency {
-	return &WorkflowConcurrency{
-		fn: fn,
+// Concurrency sets the concurrency group for a workflow. It accepts either a GetWorkflowConcurrencyGroupFn, which
+// is run on a worker to compute the group, or a key expression over the workflow input such as "input.customer_id",
+// which is evaluated by the engine without a round trip to a worker.
+func Concurrency(fnOrExpression any) *WorkflowConcurrency {
+	switch v := fnOrExpression.(type) {
+	case string:
+		return &WorkflowConcurrency{
+			expression: v,
+		}
+	case GetWorkflowConcurrencyGroupFn:
+		return &WorkflowConcurrency{
+			fn: v,
+		}
+	case func(ctx HatchetContext) (string, error):
+		return &WorkflowConcurrency{
+			fn: v,
+		}
+	default:
+		panic(fmt.Sprintf("concurrency must be a GetWorkflowConcurrencyGroupFn or a key expression, got %T", fnOrExpression))
 	}
 }
 
@@ -172,13 +273,18 @@ func (j *WorkflowJob) ToWorkflow(svcName string) types.Workflow {
 	}
 
 	w := types.Workflow{
-		Name: j.Name,
-		Jobs: jobs,
+		Name:            j.Name,
+		Jobs:            jobs,
+		WorkerSelection: j.WorkerSelection,
 	}
 
 	if j.Concurrency != nil {
-		w.Concurrency = &types.WorkflowConcurrency{
-			ActionID: "concurrency:" + getFnName(j.Concurrency.fn),
+		w.Concurrency = &types.WorkflowConcurrency{}
+
+		if j.Concurrency.fn != nil {
+			w.Concurrency.ActionID = "concurrency:" + getFnName(j.Concurrency.fn)
+		} else {
+			w.Concurrency.Expression = j.Concurrency.expression
 		}
 
 		if j.Concurrency.maxRuns != nil {
@@ -234,7 +340,7 @@ func (j *WorkflowJob) ToActionMap(svcName string) map[string]any {
 		res[actionId] = step.Function
 	}
 
-	if j.Concurrency != nil {
+	if j.Concurrency != nil && j.Concurrency.fn != nil {
 		res["concurrency:"+getFnName(j.Concurrency.fn)] = j.Concurrency.fn
 	}
 
@@ -255,6 +361,12 @@ type WorkflowStep struct {
 	Parents []string
 
 	Retries int
+
+	// An optional key expression, such as input.customer_id, which groups runs of this step for concurrency limiting
+	ConcurrencyKey string
+
+	// The maximum number of concurrent runs of this step per concurrency key. Defaults to 1.
+	MaxConcurrency int32
 }
 
 func Fn(f any) *WorkflowStep {
@@ -279,6 +391,12 @@ func (w *WorkflowStep) SetRetries(retries int) *WorkflowStep {
 	return w
 }
 
+func (w *WorkflowStep) SetConcurrency(key string, maxRuns int32) *WorkflowStep {
+	w.ConcurrencyKey = key
+	w.MaxConcurrency = maxRuns
+	return w
+}
+
 func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	w.Parents = append(w.Parents, parents...)
 	return w
@@ -336,6 +454,13 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int) (*Step, error)
 		Retries:  w.Retries,
 	}
 
+	if w.ConcurrencyKey != "" {
+		res.APIStep.Concurrency = &types.WorkflowStepConcurrency{
+			Key:     w.ConcurrencyKey,
+			MaxRuns: w.MaxConcurrency,
+		}
+	}
+
 	inputs, err := decodeFnArgTypes(fnType)
 
 	if err != nil {
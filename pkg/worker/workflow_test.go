@@ -3,8 +3,11 @@ package worker
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 func namedFunction() {}
@@ -74,3 +77,106 @@ func TestFnToWorkflow(t *testing.T) {
 
 	assert.Equal(t, "TestFnToWorkflow-func1", workflow.Name)
 }
+
+func TestStepConcurrencyToWorkflow(t *testing.T) {
+	workflow := Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}).SetName("charge-card").SetConcurrency("input.customer_id", 1).ToWorkflow("default")
+
+	step := workflow.Jobs["charge-card"].Steps[0]
+
+	if assert.NotNil(t, step.Concurrency) {
+		assert.Equal(t, "input.customer_id", step.Concurrency.Key)
+		assert.Equal(t, int32(1), step.Concurrency.MaxRuns)
+	}
+}
+
+func TestWorkflowConcurrencyExpression(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:        "test",
+		Concurrency: Concurrency("input.customer_id").MaxRuns(2),
+		Steps: []*WorkflowStep{
+			{
+				Function: func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+					return nil, nil
+				},
+			},
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default")
+
+	if assert.NotNil(t, workflow.Concurrency) {
+		assert.Equal(t, "input.customer_id", workflow.Concurrency.Expression)
+		assert.Equal(t, "", workflow.Concurrency.ActionID)
+		assert.Equal(t, int32(2), workflow.Concurrency.MaxRuns)
+	}
+
+	// no concurrency action should be registered on the worker
+	assert.Len(t, testJob.ToActionMap("default"), 1)
+}
+
+func TestWorkflowConcurrencyFn(t *testing.T) {
+	testJob := WorkflowJob{
+		Name: "test",
+		Concurrency: Concurrency(func(ctx HatchetContext) (string, error) {
+			return "group", nil
+		}),
+		Steps: []*WorkflowStep{
+			{
+				Function: func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+					return nil, nil
+				},
+			},
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default")
+
+	if assert.NotNil(t, workflow.Concurrency) {
+		assert.Equal(t, "concurrency:TestWorkflowConcurrencyFn-func1", workflow.Concurrency.ActionID)
+		assert.Equal(t, "", workflow.Concurrency.Expression)
+	}
+
+	assert.Len(t, testJob.ToActionMap("default"), 2)
+}
+
+func TestCronToWorkflowTriggers(t *testing.T) {
+	wt := &types.WorkflowTriggers{}
+
+	Cron("*/5 * * * *").ToWorkflowTriggers(wt)
+	Cron("0 9 * * *", WithTimezone("America/New_York"), WithCronInput(map[string]interface{}{"report": "daily"})).ToWorkflowTriggers(wt)
+	Cron("0 * * * *", WithJitter(10*time.Minute)).ToWorkflowTriggers(wt)
+	RandomCron(types.RandomDaily).ToWorkflowTriggers(wt)
+
+	assert.Equal(t, []string{"*/5 * * * *", "random_daily"}, wt.Cron)
+	assert.Equal(t, []types.WorkflowCronTrigger{
+		{
+			Expression: "0 9 * * *",
+			Timezone:   "America/New_York",
+			Input:      map[string]interface{}{"report": "daily"},
+		},
+		{
+			Expression: "0 * * * *",
+			Jitter:     "10m0s",
+		},
+	}, wt.CronTriggers)
+}
+
+func TestWorkflowWorkerSelection(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:            "test",
+		WorkerSelection: types.RoundRobin,
+		Steps: []*WorkflowStep{
+			{
+				Function: func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+					return nil, nil
+				},
+			},
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default")
+
+	assert.Equal(t, types.RoundRobin, workflow.WorkerSelection)
+}
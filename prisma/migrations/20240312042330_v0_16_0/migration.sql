@@ -0,0 +1,177 @@
+-- CreateEnum
+CREATE TYPE "WorkerSelectionStrategy" AS ENUM ('LEAST_LOADED', 'ROUND_ROBIN', 'RANDOM', 'MOST_RECENT_HEARTBEAT');
+
+-- AlterTable
+ALTER TABLE "Step" ADD COLUMN     "concurrencyKeyExpr" TEXT,
+ADD COLUMN     "concurrencyMaxRuns" INTEGER;
+
+-- AlterTable
+ALTER TABLE "WorkflowConcurrency" ADD COLUMN     "concurrencyGroupExpression" TEXT;
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "concurrencyKey" TEXT;
+
+-- CreateIndex
+CREATE INDEX "StepRun_tenantId_concurrencyKey_idx" ON "StepRun"("tenantId", "concurrencyKey");
+
+-- AlterTable
+ALTER TABLE "Tenant" ADD COLUMN     "workerSelectionStrategy" "WorkerSelectionStrategy" NOT NULL DEFAULT 'LEAST_LOADED';
+
+-- AlterTable
+ALTER TABLE "WorkflowVersion" ADD COLUMN     "workerSelectionStrategy" "WorkerSelectionStrategy";
+
+-- CreateTable
+CREATE TABLE "WorkerActionSlot" (
+    "id" UUID NOT NULL,
+    "workerId" UUID NOT NULL,
+    "actionId" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkerActionSlot_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkerActionSlot_id_key" ON "WorkerActionSlot"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkerActionSlot_workerId_actionId_key" ON "WorkerActionSlot"("workerId", "actionId");
+
+-- AddForeignKey
+ALTER TABLE "WorkerActionSlot" ADD CONSTRAINT "WorkerActionSlot_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateIndex
+CREATE INDEX "GetGroupKeyRun_timeoutAt_idx" ON "GetGroupKeyRun"("timeoutAt");
+
+-- CreateIndex
+CREATE INDEX "JobRun_timeoutAt_idx" ON "JobRun"("timeoutAt");
+
+-- CreateIndex
+CREATE INDEX "StepRun_timeoutAt_idx" ON "StepRun"("timeoutAt");
+
+-- AlterTable
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "timezone" TEXT NOT NULL DEFAULT 'UTC';
+
+-- CreateEnum
+CREATE TYPE "CronMisfirePolicy" AS ENUM ('SKIP', 'RUN_ONCE', 'RUN_ALL');
+
+-- AlterTable
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN     "lastFiredAt" TIMESTAMP(3),
+ADD COLUMN     "maxMisfires" INTEGER,
+ADD COLUMN     "misfirePolicy" "CronMisfirePolicy" NOT NULL DEFAULT 'SKIP';
+
+-- AlterTable
+ALTER TABLE "WorkflowRunTriggeredBy" ADD COLUMN     "cronFireAt" TIMESTAMP(3);
+
+-- AlterTable
+ALTER TABLE "Workflow" ADD COLUMN     "isPaused" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "pausedAt" TIMESTAMP(3),
+ADD COLUMN     "queueEventsWhilePaused" BOOLEAN NOT NULL DEFAULT false;
+
+-- CreateTable
+CREATE TABLE "WorkflowHeldEvent" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "workflowId" UUID NOT NULL,
+    "eventId" UUID NOT NULL,
+
+    CONSTRAINT "WorkflowHeldEvent_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowHeldEvent_id_key" ON "WorkflowHeldEvent"("id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowHeldEvent_workflowId_eventId_key" ON "WorkflowHeldEvent"("workflowId", "eventId");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowHeldEvent" ADD CONSTRAINT "WorkflowHeldEvent_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowHeldEvent" ADD CONSTRAINT "WorkflowHeldEvent_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AlterTable
+ALTER TABLE "WorkflowTriggerScheduledRef" ADD COLUMN     "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ADD COLUMN     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- AlterTable
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN     "jitter" TEXT;
+
+-- AlterTable
+ALTER TABLE "Workflow" ADD COLUMN     "canaryPercentage" INTEGER,
+ADD COLUMN     "canaryVersionId" UUID,
+ADD COLUMN     "pinnedVersionId" UUID;
+
+-- AddForeignKey
+ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_pinnedVersionId_fkey" FOREIGN KEY ("pinnedVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "Workflow" ADD CONSTRAINT "Workflow_canaryVersionId_fkey" FOREIGN KEY ("canaryVersionId") REFERENCES "WorkflowVersion"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "progress" INTEGER,
+ADD COLUMN     "progressMessage" TEXT;
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "ackedAt" TIMESTAMP(3);
+
+-- CreateEnum
+CREATE TYPE "WorkflowRunEventResourceType" AS ENUM ('STEP_RUN', 'WORKFLOW_RUN');
+
+-- CreateEnum
+CREATE TYPE "WorkflowRunEventType" AS ENUM ('STARTED', 'COMPLETED', 'FAILED', 'CANCELLED', 'TIMED_OUT', 'PROGRESS');
+
+-- AlterTable
+ALTER TABLE "WorkflowRun" ADD COLUMN     "lastEventSequence" BIGINT NOT NULL DEFAULT 0;
+
+-- CreateTable
+CREATE TABLE "WorkflowRunEvent" (
+    "workflowRunId" UUID NOT NULL,
+    "sequence" BIGINT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "resourceType" "WorkflowRunEventResourceType" NOT NULL,
+    "resourceId" UUID NOT NULL,
+    "eventType" "WorkflowRunEventType" NOT NULL,
+    "payload" TEXT,
+
+    CONSTRAINT "WorkflowRunEvent_pkey" PRIMARY KEY ("workflowRunId","sequence")
+);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunEvent" ADD CONSTRAINT "WorkflowRunEvent_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunEvent" ADD CONSTRAINT "WorkflowRunEvent_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "WorkerSchedulingStatus" AS ENUM ('ACTIVE', 'PAUSED', 'DRAINING');
+
+-- AlterTable
+ALTER TABLE "Worker" ADD COLUMN     "schedulingStatus" "WorkerSchedulingStatus" NOT NULL DEFAULT 'ACTIVE';
+
+-- DropForeignKey
+ALTER TABLE "WorkflowRunTriggeredBy" DROP CONSTRAINT "WorkflowRunTriggeredBy_cronParentId_cronSchedule_fkey";
+
+-- DropIndex
+DROP INDEX "WorkflowTriggerCronRef_parentId_cron_key";
+
+-- AlterTable
+ALTER TABLE "WorkflowRunTriggeredBy" ADD COLUMN     "cronTimezone" TEXT;
+
+-- existing cron references were all evaluated in UTC
+UPDATE "WorkflowRunTriggeredBy" SET "cronTimezone" = 'UTC' WHERE "cronParentId" IS NOT NULL AND "cronSchedule" IS NOT NULL;
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowTriggerCronRef_parentId_cron_timezone_key" ON "WorkflowTriggerCronRef"("parentId", "cron", "timezone");
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunTriggeredBy" ADD CONSTRAINT "WorkflowRunTriggeredBy_cron_fkey" FOREIGN KEY ("cronParentId", "cronSchedule", "cronTimezone") REFERENCES "WorkflowTriggerCronRef"("parentId", "cron", "timezone") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AlterTable
+ALTER TABLE "StepRun" ADD COLUMN     "shutdownRequeueCount" INTEGER NOT NULL DEFAULT 0;
+
+-- steps with a concurrency key expression and no max runs default to 1 concurrent step run per key
+UPDATE "Step" SET "concurrencyMaxRuns" = 1 WHERE "concurrencyKeyExpr" IS NOT NULL AND "concurrencyMaxRuns" IS NULL;
+
+-- step runs in a final state can't time out
+UPDATE "StepRun" SET "timeoutAt" = NULL WHERE "timeoutAt" IS NOT NULL AND "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED');
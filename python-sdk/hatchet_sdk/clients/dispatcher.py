@@ -7,7 +7,7 @@ from ..loader import ClientConfig
 from ..logger import logger
 import json
 import grpc
-from typing import Callable, List, Union
+from typing import Callable, Dict, List, Union
 from ..metadata import get_metadata
 import time
 
@@ -33,11 +33,12 @@ DEFAULT_REGISTER_TIMEOUT = 30
 
 
 class GetActionListenerRequest:
-    def __init__(self, worker_name: str, services: List[str], actions: List[str], max_runs: int | None = None):
+    def __init__(self, worker_name: str, services: List[str], actions: List[str], max_runs: int | None = None, action_slots: Dict[str, int] | None = None):
         self.worker_name = worker_name
         self.services = services
         self.actions = actions
         self.max_runs = max_runs
+        self.action_slots = action_slots or {}
 
 class Action:
     def __init__(self, worker_id: str, tenant_id: str, workflow_run_id: str, get_group_key_run_id: str, job_id: str, job_name: str, job_run_id: str, step_id: str, step_run_id: str, action_id: str, action_payload: str, action_type: ActionType):
@@ -198,7 +199,8 @@ class DispatcherClientImpl(DispatcherClient):
             workerName=req.worker_name,
             actions=req.actions,
             services=req.services,
-            maxRuns=req.max_runs
+            maxRuns=req.max_runs,
+            actionSlots=req.action_slots,
         ), timeout=DEFAULT_REGISTER_TIMEOUT, metadata=get_metadata(self.token))
 
         return ActionListenerImpl(self.client, self.token, response.workerId)
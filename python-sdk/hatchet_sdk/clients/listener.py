@@ -5,6 +5,7 @@ from ..dispatcher_pb2_grpc import DispatcherStub
 from ..dispatcher_pb2 import SubscribeToWorkflowEventsRequest, ResourceEventType, WorkflowEvent, RESOURCE_TYPE_STEP_RUN, RESOURCE_TYPE_WORKFLOW_RUN
 from ..loader import ClientConfig
 from ..metadata import get_metadata
+import base64
 import json
 import time
 
@@ -19,6 +20,8 @@ class StepRunEventType:
     STEP_RUN_EVENT_TYPE_FAILED = 'STEP_RUN_EVENT_TYPE_FAILED'
     STEP_RUN_EVENT_TYPE_CANCELLED = 'STEP_RUN_EVENT_TYPE_CANCELLED'
     STEP_RUN_EVENT_TYPE_TIMED_OUT = 'STEP_RUN_EVENT_TYPE_TIMED_OUT'
+    STEP_RUN_EVENT_TYPE_PROGRESS = 'STEP_RUN_EVENT_TYPE_PROGRESS'
+    STEP_RUN_EVENT_TYPE_STREAM = 'STEP_RUN_EVENT_TYPE_STREAM'
 
 class WorkflowRunEventType:
     WORKFLOW_RUN_EVENT_TYPE_STARTED = 'WORKFLOW_RUN_EVENT_TYPE_STARTED'
@@ -33,6 +36,8 @@ step_run_event_type_mapping = {
     ResourceEventType.RESOURCE_EVENT_TYPE_FAILED: StepRunEventType.STEP_RUN_EVENT_TYPE_FAILED,
     ResourceEventType.RESOURCE_EVENT_TYPE_CANCELLED: StepRunEventType.STEP_RUN_EVENT_TYPE_CANCELLED,
     ResourceEventType.RESOURCE_EVENT_TYPE_TIMED_OUT: StepRunEventType.STEP_RUN_EVENT_TYPE_TIMED_OUT,
+    ResourceEventType.RESOURCE_EVENT_TYPE_PROGRESS: StepRunEventType.STEP_RUN_EVENT_TYPE_PROGRESS,
+    ResourceEventType.RESOURCE_EVENT_TYPE_STREAM: StepRunEventType.STEP_RUN_EVENT_TYPE_STREAM,
 }
 
 workflow_run_event_type_mapping = {
@@ -89,7 +94,10 @@ class HatchetListener:
                         payload = None
 
                         try:
-                            if workflow_event.eventPayload:
+                            if eventType == StepRunEventType.STEP_RUN_EVENT_TYPE_STREAM:
+                                # stream events carry the base64-encoded chunk
+                                payload = base64.b64decode(workflow_event.eventPayload)
+                            elif workflow_event.eventPayload:
                                 payload = json.loads(workflow_event.eventPayload)
                         except Exception as e:
                             pass
@@ -58,6 +58,8 @@ from hatchet_sdk.clients.rest.models.create_pull_request_from_step_run import Cr
 from hatchet_sdk.clients.rest.models.create_sns_integration_request import CreateSNSIntegrationRequest
 from hatchet_sdk.clients.rest.models.create_tenant_invite_request import CreateTenantInviteRequest
 from hatchet_sdk.clients.rest.models.create_tenant_request import CreateTenantRequest
+from hatchet_sdk.clients.rest.models.cron_preview import CronPreview
+from hatchet_sdk.clients.rest.models.cron_preview_request import CronPreviewRequest
 from hatchet_sdk.clients.rest.models.event import Event
 from hatchet_sdk.clients.rest.models.event_data import EventData
 from hatchet_sdk.clients.rest.models.event_key_list import EventKeyList
@@ -83,12 +85,16 @@ from hatchet_sdk.clients.rest.models.log_line_list import LogLineList
 from hatchet_sdk.clients.rest.models.log_line_order_by_direction import LogLineOrderByDirection
 from hatchet_sdk.clients.rest.models.log_line_order_by_field import LogLineOrderByField
 from hatchet_sdk.clients.rest.models.pagination_response import PaginationResponse
+from hatchet_sdk.clients.rest.models.pause_workflow_request import PauseWorkflowRequest
 from hatchet_sdk.clients.rest.models.pull_request import PullRequest
 from hatchet_sdk.clients.rest.models.pull_request_state import PullRequestState
 from hatchet_sdk.clients.rest.models.reject_invite_request import RejectInviteRequest
 from hatchet_sdk.clients.rest.models.replay_event_request import ReplayEventRequest
 from hatchet_sdk.clients.rest.models.rerun_step_run_request import RerunStepRunRequest
+from hatchet_sdk.clients.rest.models.resume_workflow_request import ResumeWorkflowRequest
 from hatchet_sdk.clients.rest.models.sns_integration import SNSIntegration
+from hatchet_sdk.clients.rest.models.scheduled_workflow import ScheduledWorkflow
+from hatchet_sdk.clients.rest.models.scheduled_workflow_list import ScheduledWorkflowList
 from hatchet_sdk.clients.rest.models.step import Step
 from hatchet_sdk.clients.rest.models.step_run import StepRun
 from hatchet_sdk.clients.rest.models.step_run_diff import StepRunDiff
@@ -101,7 +107,10 @@ from hatchet_sdk.clients.rest.models.tenant_member import TenantMember
 from hatchet_sdk.clients.rest.models.tenant_member_list import TenantMemberList
 from hatchet_sdk.clients.rest.models.tenant_member_role import TenantMemberRole
 from hatchet_sdk.clients.rest.models.trigger_workflow_run_request import TriggerWorkflowRunRequest
+from hatchet_sdk.clients.rest.models.update_scheduled_workflow_request import UpdateScheduledWorkflowRequest
 from hatchet_sdk.clients.rest.models.update_tenant_invite_request import UpdateTenantInviteRequest
+from hatchet_sdk.clients.rest.models.update_tenant_request import UpdateTenantRequest
+from hatchet_sdk.clients.rest.models.update_workflow_rollout_request import UpdateWorkflowRolloutRequest
 from hatchet_sdk.clients.rest.models.user import User
 from hatchet_sdk.clients.rest.models.user_login_request import UserLoginRequest
 from hatchet_sdk.clients.rest.models.user_register_request import UserRegisterRequest
@@ -109,6 +118,7 @@ from hatchet_sdk.clients.rest.models.user_tenant_memberships_list import UserTen
 from hatchet_sdk.clients.rest.models.user_tenant_public import UserTenantPublic
 from hatchet_sdk.clients.rest.models.worker import Worker
 from hatchet_sdk.clients.rest.models.worker_list import WorkerList
+from hatchet_sdk.clients.rest.models.worker_selection_strategy import WorkerSelectionStrategy
 from hatchet_sdk.clients.rest.models.workflow import Workflow
 from hatchet_sdk.clients.rest.models.workflow_concurrency import WorkflowConcurrency
 from hatchet_sdk.clients.rest.models.workflow_deployment_config import WorkflowDeploymentConfig
@@ -122,5 +132,7 @@ from hatchet_sdk.clients.rest.models.workflow_trigger_cron_ref import WorkflowTr
 from hatchet_sdk.clients.rest.models.workflow_trigger_event_ref import WorkflowTriggerEventRef
 from hatchet_sdk.clients.rest.models.workflow_triggers import WorkflowTriggers
 from hatchet_sdk.clients.rest.models.workflow_version import WorkflowVersion
+from hatchet_sdk.clients.rest.models.workflow_version_change import WorkflowVersionChange
 from hatchet_sdk.clients.rest.models.workflow_version_definition import WorkflowVersionDefinition
+from hatchet_sdk.clients.rest.models.workflow_version_diff import WorkflowVersionDiff
 from hatchet_sdk.clients.rest.models.workflow_version_meta import WorkflowVersionMeta
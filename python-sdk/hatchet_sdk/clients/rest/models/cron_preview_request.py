@@ -0,0 +1,96 @@
+# coding: utf-8
+
+"""
+    Hatchet API
+
+    The Hatchet API
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, Field, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional
+from typing_extensions import Annotated
+from typing import Optional, Set
+from typing_extensions import Self
+
+class CronPreviewRequest(BaseModel):
+    """
+    CronPreviewRequest
+    """ # noqa: E501
+    cron: StrictStr = Field(description="The cron expression, or one of the shorthands random_15_min, random_hourly and random_daily.")
+    timezone: Optional[StrictStr] = Field(default=None, description="The IANA time zone the cron expression is evaluated in, defaults to UTC.")
+    jitter: Optional[StrictStr] = Field(default=None, description="The window every fire is delayed within, such as 15m.")
+    workflow_id: Optional[Annotated[str, Field(min_length=36, strict=True, max_length=36)]] = Field(default=None, description="The workflow the cron belongs to. Jittered and random crons fire at the start of each window unless it is set, as the delay is derived from the workflow.", alias="workflowId")
+    count: Optional[Annotated[int, Field(le=100, strict=True, ge=1)]] = Field(default=None, description="The number of fire times to return, defaults to 5.")
+    __properties: ClassVar[List[str]] = ["cron", "timezone", "jitter", "workflowId", "count"]
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of CronPreviewRequest from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of CronPreviewRequest from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "cron": obj.get("cron"),
+            "timezone": obj.get("timezone"),
+            "jitter": obj.get("jitter"),
+            "workflowId": obj.get("workflowId"),
+            "count": obj.get("count")
+        })
+        return _obj
+
+
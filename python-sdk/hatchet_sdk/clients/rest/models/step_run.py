@@ -51,11 +51,13 @@ class StepRun(BaseModel):
     finished_at_epoch: Optional[StrictInt] = Field(default=None, alias="finishedAtEpoch")
     timeout_at: Optional[datetime] = Field(default=None, alias="timeoutAt")
     timeout_at_epoch: Optional[StrictInt] = Field(default=None, alias="timeoutAtEpoch")
+    progress: Optional[StrictInt] = Field(default=None, description="The progress of the step run in percent, as last reported by the worker.")
+    progress_message: Optional[StrictStr] = Field(default=None, description="The message of the last progress report.", alias="progressMessage")
     cancelled_at: Optional[datetime] = Field(default=None, alias="cancelledAt")
     cancelled_at_epoch: Optional[StrictInt] = Field(default=None, alias="cancelledAtEpoch")
     cancelled_reason: Optional[StrictStr] = Field(default=None, alias="cancelledReason")
     cancelled_error: Optional[StrictStr] = Field(default=None, alias="cancelledError")
-    __properties: ClassVar[List[str]] = ["metadata", "tenantId", "jobRunId", "jobRun", "stepId", "step", "children", "parents", "workerId", "input", "output", "status", "requeueAfter", "result", "error", "startedAt", "startedAtEpoch", "finishedAt", "finishedAtEpoch", "timeoutAt", "timeoutAtEpoch", "cancelledAt", "cancelledAtEpoch", "cancelledReason", "cancelledError"]
+    __properties: ClassVar[List[str]] = ["metadata", "tenantId", "jobRunId", "jobRun", "stepId", "step", "children", "parents", "workerId", "input", "output", "status", "requeueAfter", "result", "error", "startedAt", "startedAtEpoch", "finishedAt", "finishedAtEpoch", "timeoutAt", "timeoutAtEpoch", "progress", "progressMessage", "cancelledAt", "cancelledAtEpoch", "cancelledReason", "cancelledError"]
 
     model_config = {
         "populate_by_name": True,
@@ -138,6 +140,8 @@ class StepRun(BaseModel):
             "finishedAtEpoch": obj.get("finishedAtEpoch"),
             "timeoutAt": obj.get("timeoutAt"),
             "timeoutAtEpoch": obj.get("timeoutAtEpoch"),
+            "progress": obj.get("progress"),
+            "progressMessage": obj.get("progressMessage"),
             "cancelledAt": obj.get("cancelledAt"),
             "cancelledAtEpoch": obj.get("cancelledAtEpoch"),
             "cancelledReason": obj.get("cancelledReason"),
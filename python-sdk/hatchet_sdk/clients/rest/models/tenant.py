@@ -20,6 +20,7 @@ import json
 from pydantic import BaseModel, Field, StrictStr
 from typing import Any, ClassVar, Dict, List
 from hatchet_sdk.clients.rest.models.api_resource_meta import APIResourceMeta
+from hatchet_sdk.clients.rest.models.worker_selection_strategy import WorkerSelectionStrategy
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -30,7 +31,8 @@ class Tenant(BaseModel):
     metadata: APIResourceMeta
     name: StrictStr = Field(description="The name of the tenant.")
     slug: StrictStr = Field(description="The slug of the tenant.")
-    __properties: ClassVar[List[str]] = ["metadata", "name", "slug"]
+    worker_selection_strategy: WorkerSelectionStrategy = Field(description="The default strategy for selecting a worker to assign a step run to.", alias="workerSelectionStrategy")
+    __properties: ClassVar[List[str]] = ["metadata", "name", "slug", "workerSelectionStrategy"]
 
     model_config = {
         "populate_by_name": True,
@@ -88,7 +90,8 @@ class Tenant(BaseModel):
         _obj = cls.model_validate({
             "metadata": APIResourceMeta.from_dict(obj["metadata"]) if obj.get("metadata") is not None else None,
             "name": obj.get("name"),
-            "slug": obj.get("slug")
+            "slug": obj.get("slug"),
+            "workerSelectionStrategy": obj.get("workerSelectionStrategy")
         })
         return _obj
 
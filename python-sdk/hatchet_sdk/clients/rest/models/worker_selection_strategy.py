@@ -0,0 +1,39 @@
+# coding: utf-8
+
+"""
+    Hatchet API
+
+    The Hatchet API
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import json
+from enum import Enum
+from typing_extensions import Self
+
+
+class WorkerSelectionStrategy(str, Enum):
+    """
+    WorkerSelectionStrategy
+    """
+
+    """
+    allowed enum values
+    """
+    LEAST_LOADED = 'LEAST_LOADED'
+    ROUND_ROBIN = 'ROUND_ROBIN'
+    RANDOM = 'RANDOM'
+    MOST_RECENT_HEARTBEAT = 'MOST_RECENT_HEARTBEAT'
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of WorkerSelectionStrategy from a JSON string"""
+        return cls(json.loads(json_str))
+
+
@@ -17,8 +17,10 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, Field, StrictStr
+from datetime import datetime
+from pydantic import BaseModel, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from typing_extensions import Annotated
 from hatchet_sdk.clients.rest.models.api_resource_meta import APIResourceMeta
 from hatchet_sdk.clients.rest.models.job import Job
 from hatchet_sdk.clients.rest.models.workflow_deployment_config import WorkflowDeploymentConfig
@@ -38,7 +40,13 @@ class Workflow(BaseModel):
     last_run: Optional[WorkflowRun] = Field(default=None, alias="lastRun")
     jobs: Optional[List[Job]] = Field(default=None, description="The jobs of the workflow.")
     deployment: Optional[WorkflowDeploymentConfig] = None
-    __properties: ClassVar[List[str]] = ["metadata", "name", "description", "versions", "tags", "lastRun", "jobs", "deployment"]
+    is_paused: Optional[StrictBool] = Field(default=None, description="Whether the workflow is paused.", alias="isPaused")
+    paused_at: Optional[datetime] = Field(default=None, description="The time the workflow was paused.", alias="pausedAt")
+    next_fire_times: Optional[List[datetime]] = Field(default=None, description="The next times the crons of the latest workflow version fire, which is empty while the workflow is paused.", alias="nextFireTimes")
+    pinned_version_id: Optional[Annotated[str, Field(min_length=36, strict=True, max_length=36)]] = Field(default=None, description="The version runs use instead of the latest version.", alias="pinnedVersionId")
+    canary_version_id: Optional[Annotated[str, Field(min_length=36, strict=True, max_length=36)]] = Field(default=None, description="The version which is rolled out to a percentage of runs.", alias="canaryVersionId")
+    canary_percentage: Optional[StrictInt] = Field(default=None, description="The percentage of runs which use the canary version.", alias="canaryPercentage")
+    __properties: ClassVar[List[str]] = ["metadata", "name", "description", "versions", "tags", "lastRun", "jobs", "deployment", "isPaused", "pausedAt", "nextFireTimes", "pinnedVersionId", "canaryVersionId", "canaryPercentage"]
 
     model_config = {
         "populate_by_name": True,
@@ -128,7 +136,13 @@ class Workflow(BaseModel):
             "tags": [WorkflowTag.from_dict(_item) for _item in obj["tags"]] if obj.get("tags") is not None else None,
             "lastRun": WorkflowRun.from_dict(obj["lastRun"]) if obj.get("lastRun") is not None else None,
             "jobs": [Job.from_dict(_item) for _item in obj["jobs"]] if obj.get("jobs") is not None else None,
-            "deployment": WorkflowDeploymentConfig.from_dict(obj["deployment"]) if obj.get("deployment") is not None else None
+            "deployment": WorkflowDeploymentConfig.from_dict(obj["deployment"]) if obj.get("deployment") is not None else None,
+            "isPaused": obj.get("isPaused"),
+            "pausedAt": obj.get("pausedAt"),
+            "nextFireTimes": obj.get("nextFireTimes"),
+            "pinnedVersionId": obj.get("pinnedVersionId"),
+            "canaryVersionId": obj.get("canaryVersionId"),
+            "canaryPercentage": obj.get("canaryPercentage")
         })
         return _obj
 
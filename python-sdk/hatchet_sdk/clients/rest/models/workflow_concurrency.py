@@ -29,7 +29,8 @@ class WorkflowConcurrency(BaseModel):
     max_runs: StrictInt = Field(description="The maximum number of concurrent workflow runs.", alias="maxRuns")
     limit_strategy: StrictStr = Field(description="The strategy to use when the concurrency limit is reached.", alias="limitStrategy")
     get_concurrency_group: StrictStr = Field(description="An action which gets the concurrency group for the WorkflowRun.", alias="getConcurrencyGroup")
-    __properties: ClassVar[List[str]] = ["maxRuns", "limitStrategy", "getConcurrencyGroup"]
+    expression: Optional[StrictStr] = Field(default=None, description="An expression over the workflow input which is evaluated by the engine to get the concurrency group for the WorkflowRun.")
+    __properties: ClassVar[List[str]] = ["maxRuns", "limitStrategy", "getConcurrencyGroup", "expression"]
 
     @field_validator('limit_strategy')
     def limit_strategy_validate_enum(cls, value):
@@ -91,7 +92,8 @@ class WorkflowConcurrency(BaseModel):
         _obj = cls.model_validate({
             "maxRuns": obj.get("maxRuns"),
             "limitStrategy": obj.get("limitStrategy"),
-            "getConcurrencyGroup": obj.get("getConcurrencyGroup")
+            "getConcurrencyGroup": obj.get("getConcurrencyGroup"),
+            "expression": obj.get("expression")
         })
         return _obj
 
@@ -17,6 +17,7 @@ import pprint
 import re  # noqa: F401
 import json
 
+from datetime import datetime
 from pydantic import BaseModel, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from hatchet_sdk.clients.rest.models.api_resource_meta import APIResourceMeta
@@ -34,7 +35,8 @@ class WorkflowRunTriggeredBy(BaseModel):
     event: Optional[Event] = None
     cron_parent_id: Optional[StrictStr] = Field(default=None, alias="cronParentId")
     cron_schedule: Optional[StrictStr] = Field(default=None, alias="cronSchedule")
-    __properties: ClassVar[List[str]] = ["metadata", "parentId", "eventId", "event", "cronParentId", "cronSchedule"]
+    cron_fire_at: Optional[datetime] = Field(default=None, description="The time the cron was scheduled to fire, which is earlier than the creation time for missed fires.", alias="cronFireAt")
+    __properties: ClassVar[List[str]] = ["metadata", "parentId", "eventId", "event", "cronParentId", "cronSchedule", "cronFireAt"]
 
     model_config = {
         "populate_by_name": True,
@@ -98,7 +100,8 @@ class WorkflowRunTriggeredBy(BaseModel):
             "eventId": obj.get("eventId"),
             "event": Event.from_dict(obj["event"]) if obj.get("event") is not None else None,
             "cronParentId": obj.get("cronParentId"),
-            "cronSchedule": obj.get("cronSchedule")
+            "cronSchedule": obj.get("cronSchedule"),
+            "cronFireAt": obj.get("cronFireAt")
         })
         return _obj
 
@@ -17,7 +17,8 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, StrictStr
+from datetime import datetime
+from pydantic import BaseModel, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from typing import Optional, Set
 from typing_extensions import Self
@@ -28,7 +29,11 @@ class WorkflowTriggerCronRef(BaseModel):
     """ # noqa: E501
     parent_id: Optional[StrictStr] = None
     cron: Optional[StrictStr] = None
-    __properties: ClassVar[List[str]] = ["parent_id", "cron"]
+    timezone: Optional[StrictStr] = Field(default=None, description="The IANA time zone the cron expression is evaluated in.")
+    input: Optional[Dict[str, Any]] = None
+    jitter: Optional[StrictStr] = Field(default=None, description="The window every fire is delayed within, by an amount which is stable for the workflow.")
+    next_fire_times: Optional[List[datetime]] = Field(default=None, description="The next times the cron fires.", alias="nextFireTimes")
+    __properties: ClassVar[List[str]] = ["parent_id", "cron", "timezone", "input", "jitter", "nextFireTimes"]
 
     model_config = {
         "populate_by_name": True,
@@ -82,7 +87,11 @@ class WorkflowTriggerCronRef(BaseModel):
 
         _obj = cls.model_validate({
             "parent_id": obj.get("parent_id"),
-            "cron": obj.get("cron")
+            "cron": obj.get("cron"),
+            "timezone": obj.get("timezone"),
+            "input": obj.get("input"),
+            "jitter": obj.get("jitter"),
+            "nextFireTimes": obj.get("nextFireTimes")
         })
         return _obj
 
@@ -0,0 +1,111 @@
+# coding: utf-8
+
+"""
+    Hatchet API
+
+    The Hatchet API
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, Field, StrictStr, field_validator
+from typing import Any, ClassVar, Dict, List, Optional
+from typing import Optional, Set
+from typing_extensions import Self
+
+class WorkflowVersionChange(BaseModel):
+    """
+    WorkflowVersionChange
+    """ # noqa: E501
+    type: StrictStr
+    kind: StrictStr = Field(description="The part of the workflow which changed.")
+    job: Optional[StrictStr] = Field(default=None, description="The job which changed, or which the step belongs to. Not set for changes to the triggers or concurrency of the workflow.")
+    step: Optional[StrictStr] = Field(default=None, description="The step which changed, or which the parent belongs to.")
+    var_from: Optional[StrictStr] = Field(default=None, description="The value before the change, not set if it was added.", alias="from")
+    to: Optional[StrictStr] = Field(default=None, description="The value after the change, not set if it was removed.")
+    __properties: ClassVar[List[str]] = ["type", "kind", "job", "step", "from", "to"]
+
+    @field_validator('type')
+    def type_validate_enum(cls, value):
+        """Validates the enum"""
+        if value not in set(['ADDED', 'REMOVED', 'CHANGED']):
+            raise ValueError("must be one of enum values ('ADDED', 'REMOVED', 'CHANGED')")
+        return value
+
+    @field_validator('kind')
+    def kind_validate_enum(cls, value):
+        """Validates the enum"""
+        if value not in set(['JOB', 'STEP', 'ACTION', 'PARENT', 'TIMEOUT', 'RETRIES', 'EVENT_TRIGGER', 'CRON_TRIGGER', 'CONCURRENCY']):
+            raise ValueError("must be one of enum values ('JOB', 'STEP', 'ACTION', 'PARENT', 'TIMEOUT', 'RETRIES', 'EVENT_TRIGGER', 'CRON_TRIGGER', 'CONCURRENCY')")
+        return value
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of WorkflowVersionChange from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of WorkflowVersionChange from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "type": obj.get("type"),
+            "kind": obj.get("kind"),
+            "job": obj.get("job"),
+            "step": obj.get("step"),
+            "from": obj.get("from"),
+            "to": obj.get("to")
+        })
+        return _obj
+
+
@@ -0,0 +1,102 @@
+# coding: utf-8
+
+"""
+    Hatchet API
+
+    The Hatchet API
+
+    The version of the OpenAPI document: 1.0.0
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, Field, StrictStr
+from typing import Any, ClassVar, Dict, List
+from typing_extensions import Annotated
+from hatchet_sdk.clients.rest.models.workflow_version_change import WorkflowVersionChange
+from typing import Optional, Set
+from typing_extensions import Self
+
+class WorkflowVersionDiff(BaseModel):
+    """
+    WorkflowVersionDiff
+    """ # noqa: E501
+    from_version_id: Annotated[str, Field(min_length=36, strict=True, max_length=36)] = Field(description="The version compared from.", alias="fromVersionId")
+    to_version_id: Annotated[str, Field(min_length=36, strict=True, max_length=36)] = Field(description="The version compared to.", alias="toVersionId")
+    changes: List[WorkflowVersionChange] = Field(description="The changes, ordered from the workflow concurrency and triggers to the jobs and steps by name.")
+    raw_diff: StrictStr = Field(description="A unified diff of the YAML definitions of the versions, which is empty if they are equal.", alias="rawDiff")
+    __properties: ClassVar[List[str]] = ["fromVersionId", "toVersionId", "changes", "rawDiff"]
+
+    model_config = {
+        "populate_by_name": True,
+        "validate_assignment": True,
+        "protected_namespaces": (),
+    }
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of WorkflowVersionDiff from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        # override the default output from pydantic by calling `to_dict()` of each item in changes (list)
+        _items = []
+        if self.changes:
+            for _item in self.changes:
+                if _item:
+                    _items.append(_item.to_dict())
+            _dict['changes'] = _items
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of WorkflowVersionDiff from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "fromVersionId": obj.get("fromVersionId"),
+            "toVersionId": obj.get("toVersionId"),
+            "changes": [WorkflowVersionChange.from_dict(_item) for _item in obj["changes"]] if obj.get("changes") is not None else None,
+            "rawDiff": obj.get("rawDiff")
+        })
+        return _obj
+
+
@@ -15,7 +15,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x64ispatcher.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"p\n\x15WorkerRegisterRequest\x12\x12\n\nworkerName\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x63tions\x18\x02 \x03(\t\x12\x10\n\x08services\x18\x03 \x03(\t\x12\x14\n\x07maxRuns\x18\x04 \x01(\x05H\x00\x88\x01\x01\x42\n\n\x08_maxRuns\"P\n\x16WorkerRegisterResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\x12\x12\n\nworkerName\x18\x03 \x01(\t\"\x84\x02\n\x0e\x41ssignedAction\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x15\n\rworkflowRunId\x18\x02 \x01(\t\x12\x18\n\x10getGroupKeyRunId\x18\x03 \x01(\t\x12\r\n\x05jobId\x18\x04 \x01(\t\x12\x0f\n\x07jobName\x18\x05 \x01(\t\x12\x10\n\x08jobRunId\x18\x06 \x01(\t\x12\x0e\n\x06stepId\x18\x07 \x01(\t\x12\x11\n\tstepRunId\x18\x08 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\t \x01(\t\x12\x1f\n\nactionType\x18\n \x01(\x0e\x32\x0b.ActionType\x12\x15\n\ractionPayload\x18\x0b \x01(\t\x12\x10\n\x08stepName\x18\x0c \x01(\t\"\'\n\x13WorkerListenRequest\x12\x10\n\x08workerId\x18\x01 \x01(\t\",\n\x18WorkerUnsubscribeRequest\x12\x10\n\x08workerId\x18\x01 \x01(\t\"?\n\x19WorkerUnsubscribeResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\"\xe1\x01\n\x13GroupKeyActionEvent\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\x15\n\rworkflowRunId\x18\x02 \x01(\t\x12\x18\n\x10getGroupKeyRunId\x18\x03 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\x04 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\teventType\x18\x06 \x01(\x0e\x32\x18.GroupKeyActionEventType\x12\x14\n\x0c\x65ventPayload\x18\x07 \x01(\t\"\xec\x01\n\x0fStepActionEvent\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x10\n\x08jobRunId\x18\x03 \x01(\t\x12\x0e\n\x06stepId\x18\x04 \x01(\t\x12\x11\n\tstepRunId\x18\x05 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\x06 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\teventType\x18\x08 \x01(\x0e\x32\x14.StepActionEventType\x12\x14\n\x0c\x65ventPayload\x18\t \x01(\t\"9\n\x13\x41\x63tionEventResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\"9\n SubscribeToWorkflowEventsRequest\x12\x15\n\rworkflowRunId\x18\x01 \x01(\t\"\xe0\x01\n\rWorkflowEvent\x12\x15\n\rworkflowRunId\x18\x01 \x01(\t\x12#\n\x0cresourceType\x18\x02 \x01(\x0e\x32\r.ResourceType\x12%\n\teventType\x18\x03 \x01(\x0e\x32\x12.ResourceEventType\x12\x12\n\nresourceId\x18\x04 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x14\n\x0c\x65ventPayload\x18\x06 \x01(\t\x12\x0e\n\x06hangup\x18\x07 \x01(\x08\"W\n\rOverridesData\x12\x11\n\tstepRunId\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\t\x12\x16\n\x0e\x63\x61llerFilename\x18\x04 \x01(\t\"\x17\n\x15OverridesDataResponse*N\n\nActionType\x12\x12\n\x0eSTART_STEP_RUN\x10\x00\x12\x13\n\x0f\x43\x41NCEL_STEP_RUN\x10\x01\x12\x17\n\x13START_GET_GROUP_KEY\x10\x02*\xa2\x01\n\x17GroupKeyActionEventType\x12 \n\x1cGROUP_KEY_EVENT_TYPE_UNKNOWN\x10\x00\x12 \n\x1cGROUP_KEY_EVENT_TYPE_STARTED\x10\x01\x12\"\n\x1eGROUP_KEY_EVENT_TYPE_COMPLETED\x10\x02\x12\x1f\n\x1bGROUP_KEY_EVENT_TYPE_FAILED\x10\x03*\x8a\x01\n\x13StepActionEventType\x12\x1b\n\x17STEP_EVENT_TYPE_UNKNOWN\x10\x00\x12\x1b\n\x17STEP_EVENT_TYPE_STARTED\x10\x01\x12\x1d\n\x19STEP_EVENT_TYPE_COMPLETED\x10\x02\x12\x1a\n\x16STEP_EVENT_TYPE_FAILED\x10\x03*e\n\x0cResourceType\x12\x19\n\x15RESOURCE_TYPE_UNKNOWN\x10\x00\x12\x1a\n\x16RESOURCE_TYPE_STEP_RUN\x10\x01\x12\x1e\n\x1aRESOURCE_TYPE_WORKFLOW_RUN\x10\x02*\xde\x01\n\x11ResourceEventType\x12\x1f\n\x1bRESOURCE_EVENT_TYPE_UNKNOWN\x10\x00\x12\x1f\n\x1bRESOURCE_EVENT_TYPE_STARTED\x10\x01\x12!\n\x1dRESOURCE_EVENT_TYPE_COMPLETED\x10\x02\x12\x1e\n\x1aRESOURCE_EVENT_TYPE_FAILED\x10\x03\x12!\n\x1dRESOURCE_EVENT_TYPE_CANCELLED\x10\x04\x12!\n\x1dRESOURCE_EVENT_TYPE_TIMED_OUT\x10\x05\x32\xe4\x03\n\nDispatcher\x12=\n\x08Register\x12\x16.WorkerRegisterRequest\x1a\x17.WorkerRegisterResponse\"\x00\x12\x33\n\x06Listen\x12\x14.WorkerListenRequest\x1a\x0f.AssignedAction\"\x00\x30\x01\x12R\n\x19SubscribeToWorkflowEvents\x12!.SubscribeToWorkflowEventsRequest\x1a\x0e.WorkflowEvent\"\x00\x30\x01\x12?\n\x13SendStepActionEvent\x12\x10.StepActionEvent\x1a\x14.ActionEventResponse\"\x00\x12G\n\x17SendGroupKeyActionEvent\x12\x14.GroupKeyActionEvent\x1a\x14.ActionEventResponse\"\x00\x12<\n\x10PutOverridesData\x12\x0e.OverridesData\x1a\x16.OverridesDataResponse\"\x00\x12\x46\n\x0bUnsubscribe\x12\x19.WorkerUnsubscribeRequest\x1a\x1a.WorkerUnsubscribeResponse\"\x00\x42GZEgithub.com/hatchet-dev/hatchet/internal/services/dispatcher/contractsb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x64ispatcher.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x01\n\x15WorkerRegisterRequest\x12\x12\n\nworkerName\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x63tions\x18\x02 \x03(\t\x12\x10\n\x08services\x18\x03 \x03(\t\x12\x14\n\x07maxRuns\x18\x04 \x01(\x05H\x00\x88\x01\x01\x12<\n\x0b\x61\x63tionSlots\x18\x05 \x03(\x0b\x32\'.WorkerRegisterRequest.ActionSlotsEntry\x1a\x32\n\x10\x41\x63tionSlotsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x05:\x02\x38\x01\x42\n\n\x08_maxRuns\"P\n\x16WorkerRegisterResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\x12\x12\n\nworkerName\x18\x03 \x01(\t\"\x98\x02\n\x0e\x41ssignedAction\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x15\n\rworkflowRunId\x18\x02 \x01(\t\x12\x18\n\x10getGroupKeyRunId\x18\x03 \x01(\t\x12\r\n\x05jobId\x18\x04 \x01(\t\x12\x0f\n\x07jobName\x18\x05 \x01(\t\x12\x10\n\x08jobRunId\x18\x06 \x01(\t\x12\x0e\n\x06stepId\x18\x07 \x01(\t\x12\x11\n\tstepRunId\x18\x08 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\t \x01(\t\x12\x1f\n\nactionType\x18\n \x01(\x0e\x32\x0b.ActionType\x12\x15\n\ractionPayload\x18\x0b \x01(\t\x12\x10\n\x08stepName\x18\x0c \x01(\t\x12\x12\n\nretryCount\x18\r \x01(\x05\"I\n\x13WorkerListenRequest\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\x12\n\nheartbeats\x18\x02 \x01(\x08\x12\x0c\n\x04\x61\x63ks\x18\x03 \x01(\x08\";\n\x18WorkerUnsubscribeRequest\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\r\n\x05\x64rain\x18\x02 \x01(\x08\"?\n\x19WorkerUnsubscribeResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\"\xe1\x01\n\x13GroupKeyActionEvent\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\x15\n\rworkflowRunId\x18\x02 \x01(\t\x12\x18\n\x10getGroupKeyRunId\x18\x03 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\x04 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\teventType\x18\x06 \x01(\x0e\x32\x18.GroupKeyActionEventType\x12\x14\n\x0c\x65ventPayload\x18\x07 \x01(\t\"\xac\x02\n\x0fStepActionEvent\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\r\n\x05jobId\x18\x02 \x01(\t\x12\x10\n\x08jobRunId\x18\x03 \x01(\t\x12\x0e\n\x06stepId\x18\x04 \x01(\t\x12\x11\n\tstepRunId\x18\x05 \x01(\t\x12\x10\n\x08\x61\x63tionId\x18\x06 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\teventType\x18\x08 \x01(\x0e\x32\x14.StepActionEventType\x12\x14\n\x0c\x65ventPayload\x18\t \x01(\t\x12\x17\n\nretryCount\x18\n \x01(\x05H\x00\x88\x01\x01\x12\x16\n\x0eworkerShutdown\x18\x0b \x01(\x08\x42\r\n\x0b_retryCount\"9\n\x13\x41\x63tionEventResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\"z\n SubscribeToWorkflowEventsRequest\x12\x15\n\rworkflowRunId\x18\x01 \x01(\t\x12\x18\n\x0blastEventId\x18\x02 \x01(\x03H\x00\x88\x01\x01\x12\x15\n\rfromBeginning\x18\x03 \x01(\x08\x42\x0e\n\x0c_lastEventId\"\x83\x01\n\x1eSubscribeToTenantEventsRequest\x12\x13\n\x0bworkflowIds\x18\x01 \x03(\t\x12&\n\neventTypes\x18\x02 \x03(\x0e\x32\x12.ResourceEventType\x12$\n\rresourceTypes\x18\x03 \x03(\x0e\x32\r.ResourceType\"\xf1\x01\n\rWorkflowEvent\x12\x15\n\rworkflowRunId\x18\x01 \x01(\t\x12#\n\x0cresourceType\x18\x02 \x01(\x0e\x32\r.ResourceType\x12%\n\teventType\x18\x03 \x01(\x0e\x32\x12.ResourceEventType\x12\x12\n\nresourceId\x18\x04 \x01(\t\x12\x32\n\x0e\x65ventTimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x14\n\x0c\x65ventPayload\x18\x06 \x01(\t\x12\x0e\n\x06hangup\x18\x07 \x01(\x08\x12\x0f\n\x07\x65ventId\x18\x08 \x01(\x03\"W\n\rOverridesData\x12\x11\n\tstepRunId\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\t\x12\x16\n\x0e\x63\x61llerFilename\x18\x04 \x01(\t\"\x17\n\x15OverridesDataResponse\"q\n\x10HeartbeatRequest\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12/\n\x0bheartbeatAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1a\n\x12inFlightStepRunIds\x18\x03 \x03(\t\"f\n\x11HeartbeatResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\x12\x1c\n\x14reconciledStepRunIds\x18\x03 \x03(\t\x12\x0f\n\x07\x64rained\x18\x04 \x01(\x08\"u\n\x15RefreshTimeoutRequest\x12\x11\n\tstepRunId\x18\x01 \x01(\t\x12\x0f\n\x07timeout\x18\x02 \x01(\t\x12\x10\n\x08workerId\x18\x03 \x01(\t\x12\x17\n\nretryCount\x18\x04 \x01(\x05H\x00\x88\x01\x01\x42\r\n\x0b_retryCount\"G\n\x16RefreshTimeoutResponse\x12-\n\ttimeoutAt\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xc6\x01\n\x0fStepRunProgress\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\x11\n\tstepRunId\x18\x02 \x01(\t\x12\x10\n\x08progress\x18\x03 \x01(\x05\x12\x14\n\x07message\x18\x04 \x01(\tH\x00\x88\x01\x01\x12\x32\n\x0e\x65ventTimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x17\n\nretryCount\x18\x06 \x01(\x05H\x01\x88\x01\x01\x42\n\n\x08_messageB\r\n\x0b_retryCount\"8\n\x11\x41ssignedActionAck\x12\x10\n\x08workerId\x18\x01 \x01(\t\x12\x11\n\tstepRunId\x18\x02 \x01(\t\"?\n\x19\x41ssignedActionAckResponse\x12\x10\n\x08tenantId\x18\x01 \x01(\t\x12\x10\n\x08workerId\x18\x02 \x01(\t\"j\n\x15PutStreamEventRequest\x12\x11\n\tstepRunId\x18\x01 \x01(\t\x12-\n\tcreatedAt\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07message\x18\x03 \x01(\x0c\"\x18\n\x16PutStreamEventResponse*N\n\nActionType\x12\x12\n\x0eSTART_STEP_RUN\x10\x00\x12\x13\n\x0f\x43\x41NCEL_STEP_RUN\x10\x01\x12\x17\n\x13START_GET_GROUP_KEY\x10\x02*\xa2\x01\n\x17GroupKeyActionEventType\x12 \n\x1cGROUP_KEY_EVENT_TYPE_UNKNOWN\x10\x00\x12 \n\x1cGROUP_KEY_EVENT_TYPE_STARTED\x10\x01\x12\"\n\x1eGROUP_KEY_EVENT_TYPE_COMPLETED\x10\x02\x12\x1f\n\x1bGROUP_KEY_EVENT_TYPE_FAILED\x10\x03*\x8a\x01\n\x13StepActionEventType\x12\x1b\n\x17STEP_EVENT_TYPE_UNKNOWN\x10\x00\x12\x1b\n\x17STEP_EVENT_TYPE_STARTED\x10\x01\x12\x1d\n\x19STEP_EVENT_TYPE_COMPLETED\x10\x02\x12\x1a\n\x16STEP_EVENT_TYPE_FAILED\x10\x03*e\n\x0cResourceType\x12\x19\n\x15RESOURCE_TYPE_UNKNOWN\x10\x00\x12\x1a\n\x16RESOURCE_TYPE_STEP_RUN\x10\x01\x12\x1e\n\x1aRESOURCE_TYPE_WORKFLOW_RUN\x10\x02*\xa0\x02\n\x11ResourceEventType\x12\x1f\n\x1bRESOURCE_EVENT_TYPE_UNKNOWN\x10\x00\x12\x1f\n\x1bRESOURCE_EVENT_TYPE_STARTED\x10\x01\x12!\n\x1dRESOURCE_EVENT_TYPE_COMPLETED\x10\x02\x12\x1e\n\x1aRESOURCE_EVENT_TYPE_FAILED\x10\x03\x12!\n\x1dRESOURCE_EVENT_TYPE_CANCELLED\x10\x04\x12!\n\x1dRESOURCE_EVENT_TYPE_TIMED_OUT\x10\x05\x12 \n\x1cRESOURCE_EVENT_TYPE_PROGRESS\x10\x06\x12\x1e\n\x1aRESOURCE_EVENT_TYPE_STREAM\x10\x07\x32\xf7\x06\n\nDispatcher\x12=\n\x08Register\x12\x16.WorkerRegisterRequest\x1a\x17.WorkerRegisterResponse\"\x00\x12\x33\n\x06Listen\x12\x14.WorkerListenRequest\x1a\x0f.AssignedAction\"\x00\x30\x01\x12R\n\x19SubscribeToWorkflowEvents\x12!.SubscribeToWorkflowEventsRequest\x1a\x0e.WorkflowEvent\"\x00\x30\x01\x12N\n\x17SubscribeToTenantEvents\x12\x1f.SubscribeToTenantEventsRequest\x1a\x0e.WorkflowEvent\"\x00\x30\x01\x12?\n\x13SendStepActionEvent\x12\x10.StepActionEvent\x1a\x14.ActionEventResponse\"\x00\x12G\n\x17SendGroupKeyActionEvent\x12\x14.GroupKeyActionEvent\x1a\x14.ActionEventResponse\"\x00\x12<\n\x10PutOverridesData\x12\x0e.OverridesData\x1a\x16.OverridesDataResponse\"\x00\x12\x46\n\x0bUnsubscribe\x12\x19.WorkerUnsubscribeRequest\x1a\x1a.WorkerUnsubscribeResponse\"\x00\x12\x34\n\tHeartbeat\x12\x11.HeartbeatRequest\x1a\x12.HeartbeatResponse\"\x00\x12\x43\n\x0eRefreshTimeout\x12\x16.RefreshTimeoutRequest\x1a\x17.RefreshTimeoutResponse\"\x00\x12:\n\x0eReportProgress\x12\x10.StepRunProgress\x1a\x14.ActionEventResponse\"\x00\x12\x45\n\x11\x41\x63kAssignedAction\x12\x12.AssignedActionAck\x1a\x1a.AssignedActionAckResponse\"\x00\x12\x43\n\x0ePutStreamEvent\x12\x16.PutStreamEventRequest\x1a\x17.PutStreamEventResponse\"\x00\x42GZEgithub.com/hatchet-dev/hatchet/internal/services/dispatcher/contractsb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -23,42 +23,64 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'dispatcher_pb2', _globals)
 if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['DESCRIPTOR']._options = None
   _globals['DESCRIPTOR']._serialized_options = b'ZEgithub.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts'
-  _globals['_ACTIONTYPE']._serialized_start=1590
-  _globals['_ACTIONTYPE']._serialized_end=1668
-  _globals['_GROUPKEYACTIONEVENTTYPE']._serialized_start=1671
-  _globals['_GROUPKEYACTIONEVENTTYPE']._serialized_end=1833
-  _globals['_STEPACTIONEVENTTYPE']._serialized_start=1836
-  _globals['_STEPACTIONEVENTTYPE']._serialized_end=1974
-  _globals['_RESOURCETYPE']._serialized_start=1976
-  _globals['_RESOURCETYPE']._serialized_end=2077
-  _globals['_RESOURCEEVENTTYPE']._serialized_start=2080
-  _globals['_RESOURCEEVENTTYPE']._serialized_end=2302
-  _globals['_WORKERREGISTERREQUEST']._serialized_start=53
-  _globals['_WORKERREGISTERREQUEST']._serialized_end=165
-  _globals['_WORKERREGISTERRESPONSE']._serialized_start=167
-  _globals['_WORKERREGISTERRESPONSE']._serialized_end=247
-  _globals['_ASSIGNEDACTION']._serialized_start=250
-  _globals['_ASSIGNEDACTION']._serialized_end=510
-  _globals['_WORKERLISTENREQUEST']._serialized_start=512
-  _globals['_WORKERLISTENREQUEST']._serialized_end=551
-  _globals['_WORKERUNSUBSCRIBEREQUEST']._serialized_start=553
-  _globals['_WORKERUNSUBSCRIBEREQUEST']._serialized_end=597
-  _globals['_WORKERUNSUBSCRIBERESPONSE']._serialized_start=599
-  _globals['_WORKERUNSUBSCRIBERESPONSE']._serialized_end=662
-  _globals['_GROUPKEYACTIONEVENT']._serialized_start=665
-  _globals['_GROUPKEYACTIONEVENT']._serialized_end=890
-  _globals['_STEPACTIONEVENT']._serialized_start=893
-  _globals['_STEPACTIONEVENT']._serialized_end=1129
-  _globals['_ACTIONEVENTRESPONSE']._serialized_start=1131
-  _globals['_ACTIONEVENTRESPONSE']._serialized_end=1188
-  _globals['_SUBSCRIBETOWORKFLOWEVENTSREQUEST']._serialized_start=1190
-  _globals['_SUBSCRIBETOWORKFLOWEVENTSREQUEST']._serialized_end=1247
-  _globals['_WORKFLOWEVENT']._serialized_start=1250
-  _globals['_WORKFLOWEVENT']._serialized_end=1474
-  _globals['_OVERRIDESDATA']._serialized_start=1476
-  _globals['_OVERRIDESDATA']._serialized_end=1563
-  _globals['_OVERRIDESDATARESPONSE']._serialized_start=1565
-  _globals['_OVERRIDESDATARESPONSE']._serialized_end=1588
-  _globals['_DISPATCHER']._serialized_start=2305
-  _globals['_DISPATCHER']._serialized_end=2789
+  _globals['_ACTIONTYPE']._serialized_start=2923
+  _globals['_ACTIONTYPE']._serialized_end=3001
+  _globals['_GROUPKEYACTIONEVENTTYPE']._serialized_start=3004
+  _globals['_GROUPKEYACTIONEVENTTYPE']._serialized_end=3166
+  _globals['_STEPACTIONEVENTTYPE']._serialized_start=3169
+  _globals['_STEPACTIONEVENTTYPE']._serialized_end=3307
+  _globals['_RESOURCETYPE']._serialized_start=3309
+  _globals['_RESOURCETYPE']._serialized_end=3410
+  _globals['_RESOURCEEVENTTYPE']._serialized_start=3413
+  _globals['_RESOURCEEVENTTYPE']._serialized_end=3701
+  _globals['_WORKERREGISTERREQUEST']._serialized_start=54
+  _globals['_WORKERREGISTERREQUEST']._serialized_end=280
+  _globals['_WORKERREGISTERREQUEST_ACTIONSLOTSENTRY']._serialized_start=218
+  _globals['_WORKERREGISTERREQUEST_ACTIONSLOTSENTRY']._serialized_end=268
+  _globals['_WORKERREGISTERRESPONSE']._serialized_start=282
+  _globals['_WORKERREGISTERRESPONSE']._serialized_end=362
+  _globals['_ASSIGNEDACTION']._serialized_start=365
+  _globals['_ASSIGNEDACTION']._serialized_end=645
+  _globals['_WORKERLISTENREQUEST']._serialized_start=647
+  _globals['_WORKERLISTENREQUEST']._serialized_end=720
+  _globals['_WORKERUNSUBSCRIBEREQUEST']._serialized_start=722
+  _globals['_WORKERUNSUBSCRIBEREQUEST']._serialized_end=781
+  _globals['_WORKERUNSUBSCRIBERESPONSE']._serialized_start=783
+  _globals['_WORKERUNSUBSCRIBERESPONSE']._serialized_end=846
+  _globals['_GROUPKEYACTIONEVENT']._serialized_start=849
+  _globals['_GROUPKEYACTIONEVENT']._serialized_end=1074
+  _globals['_STEPACTIONEVENT']._serialized_start=1077
+  _globals['_STEPACTIONEVENT']._serialized_end=1377
+  _globals['_ACTIONEVENTRESPONSE']._serialized_start=1379
+  _globals['_ACTIONEVENTRESPONSE']._serialized_end=1436
+  _globals['_SUBSCRIBETOWORKFLOWEVENTSREQUEST']._serialized_start=1438
+  _globals['_SUBSCRIBETOWORKFLOWEVENTSREQUEST']._serialized_end=1560
+  _globals['_SUBSCRIBETOTENANTEVENTSREQUEST']._serialized_start=1563
+  _globals['_SUBSCRIBETOTENANTEVENTSREQUEST']._serialized_end=1694
+  _globals['_WORKFLOWEVENT']._serialized_start=1697
+  _globals['_WORKFLOWEVENT']._serialized_end=1938
+  _globals['_OVERRIDESDATA']._serialized_start=1940
+  _globals['_OVERRIDESDATA']._serialized_end=2027
+  _globals['_OVERRIDESDATARESPONSE']._serialized_start=2029
+  _globals['_OVERRIDESDATARESPONSE']._serialized_end=2052
+  _globals['_HEARTBEATREQUEST']._serialized_start=2054
+  _globals['_HEARTBEATREQUEST']._serialized_end=2167
+  _globals['_HEARTBEATRESPONSE']._serialized_start=2169
+  _globals['_HEARTBEATRESPONSE']._serialized_end=2271
+  _globals['_REFRESHTIMEOUTREQUEST']._serialized_start=2273
+  _globals['_REFRESHTIMEOUTREQUEST']._serialized_end=2390
+  _globals['_REFRESHTIMEOUTRESPONSE']._serialized_start=2392
+  _globals['_REFRESHTIMEOUTRESPONSE']._serialized_end=2463
+  _globals['_STEPRUNPROGRESS']._serialized_start=2466
+  _globals['_STEPRUNPROGRESS']._serialized_end=2664
+  _globals['_ASSIGNEDACTIONACK']._serialized_start=2666
+  _globals['_ASSIGNEDACTIONACK']._serialized_end=2722
+  _globals['_ASSIGNEDACTIONACKRESPONSE']._serialized_start=2724
+  _globals['_ASSIGNEDACTIONACKRESPONSE']._serialized_end=2787
+  _globals['_PUTSTREAMEVENTREQUEST']._serialized_start=2789
+  _globals['_PUTSTREAMEVENTREQUEST']._serialized_end=2895
+  _globals['_PUTSTREAMEVENTRESPONSE']._serialized_start=2897
+  _globals['_PUTSTREAMEVENTRESPONSE']._serialized_end=2921
+  _globals['_DISPATCHER']._serialized_start=3704
+  _globals['_DISPATCHER']._serialized_end=4591
 # @@protoc_insertion_point(module_scope)
@@ -41,6 +41,8 @@ class ResourceEventType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     RESOURCE_EVENT_TYPE_FAILED: _ClassVar[ResourceEventType]
     RESOURCE_EVENT_TYPE_CANCELLED: _ClassVar[ResourceEventType]
     RESOURCE_EVENT_TYPE_TIMED_OUT: _ClassVar[ResourceEventType]
+    RESOURCE_EVENT_TYPE_PROGRESS: _ClassVar[ResourceEventType]
+    RESOURCE_EVENT_TYPE_STREAM: _ClassVar[ResourceEventType]
 START_STEP_RUN: ActionType
 CANCEL_STEP_RUN: ActionType
 START_GET_GROUP_KEY: ActionType
@@ -61,18 +63,22 @@ RESOURCE_EVENT_TYPE_COMPLETED: ResourceEventType
 RESOURCE_EVENT_TYPE_FAILED: ResourceEventType
 RESOURCE_EVENT_TYPE_CANCELLED: ResourceEventType
 RESOURCE_EVENT_TYPE_TIMED_OUT: ResourceEventType
+RESOURCE_EVENT_TYPE_PROGRESS: ResourceEventType
+RESOURCE_EVENT_TYPE_STREAM: ResourceEventType
 
 class WorkerRegisterRequest(_message.Message):
-    __slots__ = ("workerName", "actions", "services", "maxRuns")
+    __slots__ = ("workerName", "actions", "services", "maxRuns", "actionSlots")
     WORKERNAME_FIELD_NUMBER: _ClassVar[int]
     ACTIONS_FIELD_NUMBER: _ClassVar[int]
     SERVICES_FIELD_NUMBER: _ClassVar[int]
     MAXRUNS_FIELD_NUMBER: _ClassVar[int]
+    ACTIONSLOTS_FIELD_NUMBER: _ClassVar[int]
     workerName: str
     actions: _containers.RepeatedScalarFieldContainer[str]
     services: _containers.RepeatedScalarFieldContainer[str]
     maxRuns: int
-    def __init__(self, workerName: _Optional[str] = ..., actions: _Optional[_Iterable[str]] = ..., services: _Optional[_Iterable[str]] = ..., maxRuns: _Optional[int] = ...) -> None: ...
+    actionSlots: _containers.RepeatedCompositeFieldContainer[WorkerRegisterRequest.ActionSlotsEntry]
+    def __init__(self, workerName: _Optional[str] = ..., actions: _Optional[_Iterable[str]] = ..., services: _Optional[_Iterable[str]] = ..., maxRuns: _Optional[int] = ..., actionSlots: _Optional[_Iterable[_Union[WorkerRegisterRequest.ActionSlotsEntry, _Mapping]]] = ...) -> None: ...
 
 class WorkerRegisterResponse(_message.Message):
     __slots__ = ("tenantId", "workerId", "workerName")
@@ -85,7 +91,7 @@ class WorkerRegisterResponse(_message.Message):
     def __init__(self, tenantId: _Optional[str] = ..., workerId: _Optional[str] = ..., workerName: _Optional[str] = ...) -> None: ...
 
 class AssignedAction(_message.Message):
-    __slots__ = ("tenantId", "workflowRunId", "getGroupKeyRunId", "jobId", "jobName", "jobRunId", "stepId", "stepRunId", "actionId", "actionType", "actionPayload", "stepName")
+    __slots__ = ("tenantId", "workflowRunId", "getGroupKeyRunId", "jobId", "jobName", "jobRunId", "stepId", "stepRunId", "actionId", "actionType", "actionPayload", "stepName", "retryCount")
     TENANTID_FIELD_NUMBER: _ClassVar[int]
     WORKFLOWRUNID_FIELD_NUMBER: _ClassVar[int]
     GETGROUPKEYRUNID_FIELD_NUMBER: _ClassVar[int]
@@ -98,6 +104,7 @@ class AssignedAction(_message.Message):
     ACTIONTYPE_FIELD_NUMBER: _ClassVar[int]
     ACTIONPAYLOAD_FIELD_NUMBER: _ClassVar[int]
     STEPNAME_FIELD_NUMBER: _ClassVar[int]
+    RETRYCOUNT_FIELD_NUMBER: _ClassVar[int]
     tenantId: str
     workflowRunId: str
     getGroupKeyRunId: str
@@ -110,19 +117,26 @@ class AssignedAction(_message.Message):
     actionType: ActionType
     actionPayload: str
     stepName: str
-    def __init__(self, tenantId: _Optional[str] = ..., workflowRunId: _Optional[str] = ..., getGroupKeyRunId: _Optional[str] = ..., jobId: _Optional[str] = ..., jobName: _Optional[str] = ..., jobRunId: _Optional[str] = ..., stepId: _Optional[str] = ..., stepRunId: _Optional[str] = ..., actionId: _Optional[str] = ..., actionType: _Optional[_Union[ActionType, str]] = ..., actionPayload: _Optional[str] = ..., stepName: _Optional[str] = ...) -> None: ...
+    retryCount: int
+    def __init__(self, tenantId: _Optional[str] = ..., workflowRunId: _Optional[str] = ..., getGroupKeyRunId: _Optional[str] = ..., jobId: _Optional[str] = ..., jobName: _Optional[str] = ..., jobRunId: _Optional[str] = ..., stepId: _Optional[str] = ..., stepRunId: _Optional[str] = ..., actionId: _Optional[str] = ..., actionType: _Optional[_Union[ActionType, str]] = ..., actionPayload: _Optional[str] = ..., stepName: _Optional[str] = ..., retryCount: _Optional[int] = ...) -> None: ...
 
 class WorkerListenRequest(_message.Message):
-    __slots__ = ("workerId",)
+    __slots__ = ("workerId", "heartbeats", "acks")
     WORKERID_FIELD_NUMBER: _ClassVar[int]
+    HEARTBEATS_FIELD_NUMBER: _ClassVar[int]
+    ACKS_FIELD_NUMBER: _ClassVar[int]
     workerId: str
-    def __init__(self, workerId: _Optional[str] = ...) -> None: ...
+    heartbeats: bool
+    acks: bool
+    def __init__(self, workerId: _Optional[str] = ..., heartbeats: bool = ..., acks: bool = ...) -> None: ...
 
 class WorkerUnsubscribeRequest(_message.Message):
-    __slots__ = ("workerId",)
+    __slots__ = ("workerId", "drain")
     WORKERID_FIELD_NUMBER: _ClassVar[int]
+    DRAIN_FIELD_NUMBER: _ClassVar[int]
     workerId: str
-    def __init__(self, workerId: _Optional[str] = ...) -> None: ...
+    drain: bool
+    def __init__(self, workerId: _Optional[str] = ..., drain: bool = ...) -> None: ...
 
 class WorkerUnsubscribeResponse(_message.Message):
     __slots__ = ("tenantId", "workerId")
@@ -151,7 +165,7 @@ class GroupKeyActionEvent(_message.Message):
     def __init__(self, workerId: _Optional[str] = ..., workflowRunId: _Optional[str] = ..., getGroupKeyRunId: _Optional[str] = ..., actionId: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., eventType: _Optional[_Union[GroupKeyActionEventType, str]] = ..., eventPayload: _Optional[str] = ...) -> None: ...
 
 class StepActionEvent(_message.Message):
-    __slots__ = ("workerId", "jobId", "jobRunId", "stepId", "stepRunId", "actionId", "eventTimestamp", "eventType", "eventPayload")
+    __slots__ = ("workerId", "jobId", "jobRunId", "stepId", "stepRunId", "actionId", "eventTimestamp", "eventType", "eventPayload", "retryCount", "workerShutdown")
     WORKERID_FIELD_NUMBER: _ClassVar[int]
     JOBID_FIELD_NUMBER: _ClassVar[int]
     JOBRUNID_FIELD_NUMBER: _ClassVar[int]
@@ -161,6 +175,8 @@ class StepActionEvent(_message.Message):
     EVENTTIMESTAMP_FIELD_NUMBER: _ClassVar[int]
     EVENTTYPE_FIELD_NUMBER: _ClassVar[int]
     EVENTPAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    RETRYCOUNT_FIELD_NUMBER: _ClassVar[int]
+    WORKERSHUTDOWN_FIELD_NUMBER: _ClassVar[int]
     workerId: str
     jobId: str
     jobRunId: str
@@ -170,7 +186,9 @@ class StepActionEvent(_message.Message):
     eventTimestamp: _timestamp_pb2.Timestamp
     eventType: StepActionEventType
     eventPayload: str
-    def __init__(self, workerId: _Optional[str] = ..., jobId: _Optional[str] = ..., jobRunId: _Optional[str] = ..., stepId: _Optional[str] = ..., stepRunId: _Optional[str] = ..., actionId: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., eventType: _Optional[_Union[StepActionEventType, str]] = ..., eventPayload: _Optional[str] = ...) -> None: ...
+    retryCount: int
+    workerShutdown: bool
+    def __init__(self, workerId: _Optional[str] = ..., jobId: _Optional[str] = ..., jobRunId: _Optional[str] = ..., stepId: _Optional[str] = ..., stepRunId: _Optional[str] = ..., actionId: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., eventType: _Optional[_Union[StepActionEventType, str]] = ..., eventPayload: _Optional[str] = ..., retryCount: _Optional[int] = ..., workerShutdown: bool = ...) -> None: ...
 
 class ActionEventResponse(_message.Message):
     __slots__ = ("tenantId", "workerId")
@@ -181,13 +199,27 @@ class ActionEventResponse(_message.Message):
     def __init__(self, tenantId: _Optional[str] = ..., workerId: _Optional[str] = ...) -> None: ...
 
 class SubscribeToWorkflowEventsRequest(_message.Message):
-    __slots__ = ("workflowRunId",)
+    __slots__ = ("workflowRunId", "lastEventId", "fromBeginning")
     WORKFLOWRUNID_FIELD_NUMBER: _ClassVar[int]
+    LASTEVENTID_FIELD_NUMBER: _ClassVar[int]
+    FROMBEGINNING_FIELD_NUMBER: _ClassVar[int]
     workflowRunId: str
-    def __init__(self, workflowRunId: _Optional[str] = ...) -> None: ...
+    lastEventId: int
+    fromBeginning: bool
+    def __init__(self, workflowRunId: _Optional[str] = ..., lastEventId: _Optional[int] = ..., fromBeginning: bool = ...) -> None: ...
+
+class SubscribeToTenantEventsRequest(_message.Message):
+    __slots__ = ("workflowIds", "eventTypes", "resourceTypes")
+    WORKFLOWIDS_FIELD_NUMBER: _ClassVar[int]
+    EVENTTYPES_FIELD_NUMBER: _ClassVar[int]
+    RESOURCETYPES_FIELD_NUMBER: _ClassVar[int]
+    workflowIds: _containers.RepeatedScalarFieldContainer[str]
+    eventTypes: _containers.RepeatedScalarFieldContainer[ResourceEventType]
+    resourceTypes: _containers.RepeatedScalarFieldContainer[ResourceType]
+    def __init__(self, workflowIds: _Optional[_Iterable[str]] = ..., eventTypes: _Optional[_Iterable[_Union[ResourceEventType, str]]] = ..., resourceTypes: _Optional[_Iterable[_Union[ResourceType, str]]] = ...) -> None: ...
 
 class WorkflowEvent(_message.Message):
-    __slots__ = ("workflowRunId", "resourceType", "eventType", "resourceId", "eventTimestamp", "eventPayload", "hangup")
+    __slots__ = ("workflowRunId", "resourceType", "eventType", "resourceId", "eventTimestamp", "eventPayload", "hangup", "eventId")
     WORKFLOWRUNID_FIELD_NUMBER: _ClassVar[int]
     RESOURCETYPE_FIELD_NUMBER: _ClassVar[int]
     EVENTTYPE_FIELD_NUMBER: _ClassVar[int]
@@ -195,6 +227,7 @@ class WorkflowEvent(_message.Message):
     EVENTTIMESTAMP_FIELD_NUMBER: _ClassVar[int]
     EVENTPAYLOAD_FIELD_NUMBER: _ClassVar[int]
     HANGUP_FIELD_NUMBER: _ClassVar[int]
+    EVENTID_FIELD_NUMBER: _ClassVar[int]
     workflowRunId: str
     resourceType: ResourceType
     eventType: ResourceEventType
@@ -202,7 +235,8 @@ class WorkflowEvent(_message.Message):
     eventTimestamp: _timestamp_pb2.Timestamp
     eventPayload: str
     hangup: bool
-    def __init__(self, workflowRunId: _Optional[str] = ..., resourceType: _Optional[_Union[ResourceType, str]] = ..., eventType: _Optional[_Union[ResourceEventType, str]] = ..., resourceId: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., eventPayload: _Optional[str] = ..., hangup: bool = ...) -> None: ...
+    eventId: int
+    def __init__(self, workflowRunId: _Optional[str] = ..., resourceType: _Optional[_Union[ResourceType, str]] = ..., eventType: _Optional[_Union[ResourceEventType, str]] = ..., resourceId: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., eventPayload: _Optional[str] = ..., hangup: bool = ..., eventId: _Optional[int] = ...) -> None: ...
 
 class OverridesData(_message.Message):
     __slots__ = ("stepRunId", "path", "value", "callerFilename")
@@ -219,3 +253,89 @@ class OverridesData(_message.Message):
 class OverridesDataResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
+
+class HeartbeatRequest(_message.Message):
+    __slots__ = ("workerId", "heartbeatAt", "inFlightStepRunIds")
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    HEARTBEATAT_FIELD_NUMBER: _ClassVar[int]
+    INFLIGHTSTEPRUNIDS_FIELD_NUMBER: _ClassVar[int]
+    workerId: str
+    heartbeatAt: _timestamp_pb2.Timestamp
+    inFlightStepRunIds: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, workerId: _Optional[str] = ..., heartbeatAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., inFlightStepRunIds: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class HeartbeatResponse(_message.Message):
+    __slots__ = ("tenantId", "workerId", "reconciledStepRunIds", "drained")
+    TENANTID_FIELD_NUMBER: _ClassVar[int]
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    RECONCILEDSTEPRUNIDS_FIELD_NUMBER: _ClassVar[int]
+    DRAINED_FIELD_NUMBER: _ClassVar[int]
+    tenantId: str
+    workerId: str
+    reconciledStepRunIds: _containers.RepeatedScalarFieldContainer[str]
+    drained: bool
+    def __init__(self, tenantId: _Optional[str] = ..., workerId: _Optional[str] = ..., reconciledStepRunIds: _Optional[_Iterable[str]] = ..., drained: bool = ...) -> None: ...
+
+class RefreshTimeoutRequest(_message.Message):
+    __slots__ = ("stepRunId", "timeout", "workerId", "retryCount")
+    STEPRUNID_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    RETRYCOUNT_FIELD_NUMBER: _ClassVar[int]
+    stepRunId: str
+    timeout: str
+    workerId: str
+    retryCount: int
+    def __init__(self, stepRunId: _Optional[str] = ..., timeout: _Optional[str] = ..., workerId: _Optional[str] = ..., retryCount: _Optional[int] = ...) -> None: ...
+
+class RefreshTimeoutResponse(_message.Message):
+    __slots__ = ("timeoutAt",)
+    TIMEOUTAT_FIELD_NUMBER: _ClassVar[int]
+    timeoutAt: _timestamp_pb2.Timestamp
+    def __init__(self, timeoutAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class StepRunProgress(_message.Message):
+    __slots__ = ("workerId", "stepRunId", "progress", "message", "eventTimestamp", "retryCount")
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    STEPRUNID_FIELD_NUMBER: _ClassVar[int]
+    PROGRESS_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    EVENTTIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    RETRYCOUNT_FIELD_NUMBER: _ClassVar[int]
+    workerId: str
+    stepRunId: str
+    progress: int
+    message: str
+    eventTimestamp: _timestamp_pb2.Timestamp
+    retryCount: int
+    def __init__(self, workerId: _Optional[str] = ..., stepRunId: _Optional[str] = ..., progress: _Optional[int] = ..., message: _Optional[str] = ..., eventTimestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., retryCount: _Optional[int] = ...) -> None: ...
+
+class AssignedActionAck(_message.Message):
+    __slots__ = ("workerId", "stepRunId")
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    STEPRUNID_FIELD_NUMBER: _ClassVar[int]
+    workerId: str
+    stepRunId: str
+    def __init__(self, workerId: _Optional[str] = ..., stepRunId: _Optional[str] = ...) -> None: ...
+
+class AssignedActionAckResponse(_message.Message):
+    __slots__ = ("tenantId", "workerId")
+    TENANTID_FIELD_NUMBER: _ClassVar[int]
+    WORKERID_FIELD_NUMBER: _ClassVar[int]
+    tenantId: str
+    workerId: str
+    def __init__(self, tenantId: _Optional[str] = ..., workerId: _Optional[str] = ...) -> None: ...
+
+class PutStreamEventRequest(_message.Message):
+    __slots__ = ("stepRunId", "createdAt", "message")
+    STEPRUNID_FIELD_NUMBER: _ClassVar[int]
+    CREATEDAT_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    stepRunId: str
+    createdAt: _timestamp_pb2.Timestamp
+    message: bytes
+    def __init__(self, stepRunId: _Optional[str] = ..., createdAt: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., message: _Optional[bytes] = ...) -> None: ...
+
+class PutStreamEventResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
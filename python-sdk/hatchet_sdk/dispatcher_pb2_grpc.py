@@ -28,6 +28,11 @@ class DispatcherStub(object):
                 request_serializer=dispatcher__pb2.SubscribeToWorkflowEventsRequest.SerializeToString,
                 response_deserializer=dispatcher__pb2.WorkflowEvent.FromString,
                 )
+        self.SubscribeToTenantEvents = channel.unary_stream(
+                '/Dispatcher/SubscribeToTenantEvents',
+                request_serializer=dispatcher__pb2.SubscribeToTenantEventsRequest.SerializeToString,
+                response_deserializer=dispatcher__pb2.WorkflowEvent.FromString,
+                )
         self.SendStepActionEvent = channel.unary_unary(
                 '/Dispatcher/SendStepActionEvent',
                 request_serializer=dispatcher__pb2.StepActionEvent.SerializeToString,
@@ -48,6 +53,31 @@ class DispatcherStub(object):
                 request_serializer=dispatcher__pb2.WorkerUnsubscribeRequest.SerializeToString,
                 response_deserializer=dispatcher__pb2.WorkerUnsubscribeResponse.FromString,
                 )
+        self.Heartbeat = channel.unary_unary(
+                '/Dispatcher/Heartbeat',
+                request_serializer=dispatcher__pb2.HeartbeatRequest.SerializeToString,
+                response_deserializer=dispatcher__pb2.HeartbeatResponse.FromString,
+                )
+        self.RefreshTimeout = channel.unary_unary(
+                '/Dispatcher/RefreshTimeout',
+                request_serializer=dispatcher__pb2.RefreshTimeoutRequest.SerializeToString,
+                response_deserializer=dispatcher__pb2.RefreshTimeoutResponse.FromString,
+                )
+        self.ReportProgress = channel.unary_unary(
+                '/Dispatcher/ReportProgress',
+                request_serializer=dispatcher__pb2.StepRunProgress.SerializeToString,
+                response_deserializer=dispatcher__pb2.ActionEventResponse.FromString,
+                )
+        self.AckAssignedAction = channel.unary_unary(
+                '/Dispatcher/AckAssignedAction',
+                request_serializer=dispatcher__pb2.AssignedActionAck.SerializeToString,
+                response_deserializer=dispatcher__pb2.AssignedActionAckResponse.FromString,
+                )
+        self.PutStreamEvent = channel.unary_unary(
+                '/Dispatcher/PutStreamEvent',
+                request_serializer=dispatcher__pb2.PutStreamEventRequest.SerializeToString,
+                response_deserializer=dispatcher__pb2.PutStreamEventResponse.FromString,
+                )
 
 
 class DispatcherServicer(object):
@@ -60,7 +90,9 @@ class DispatcherServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def Listen(self, request, context):
-        """Missing associated documentation comment in .proto file."""
+        """Listen streams actions assigned to the worker. Actions are delivered at least once: a step run
+        may be sent again after a reconnect or a reassignment, so workers should dedupe by step run id.
+        """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
@@ -71,6 +103,14 @@ class DispatcherServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SubscribeToTenantEvents(self, request, context):
+        """SubscribeToTenantEvents streams the live events of all workflow runs of the tenant which match the
+        filters of the request. The connection is not hung up when a workflow run finishes.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def SendStepActionEvent(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -95,6 +135,41 @@ class DispatcherServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Heartbeat(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RefreshTimeout(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReportProgress(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AckAssignedAction(self, request, context):
+        """AckAssignedAction acknowledges that the worker received a START_STEP_RUN action. If the
+        step run is no longer assigned to the worker, FAILED_PRECONDITION is returned and the
+        worker must not run the step.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PutStreamEvent(self, request, context):
+        """PutStreamEvent streams a chunk of a step run's partial output to subscribers of the step run's
+        workflow run. Stream events are delivered in order per step run, and are not persisted.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DispatcherServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -113,6 +188,11 @@ def add_DispatcherServicer_to_server(servicer, server):
                     request_deserializer=dispatcher__pb2.SubscribeToWorkflowEventsRequest.FromString,
                     response_serializer=dispatcher__pb2.WorkflowEvent.SerializeToString,
             ),
+            'SubscribeToTenantEvents': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeToTenantEvents,
+                    request_deserializer=dispatcher__pb2.SubscribeToTenantEventsRequest.FromString,
+                    response_serializer=dispatcher__pb2.WorkflowEvent.SerializeToString,
+            ),
             'SendStepActionEvent': grpc.unary_unary_rpc_method_handler(
                     servicer.SendStepActionEvent,
                     request_deserializer=dispatcher__pb2.StepActionEvent.FromString,
@@ -133,6 +213,31 @@ def add_DispatcherServicer_to_server(servicer, server):
                     request_deserializer=dispatcher__pb2.WorkerUnsubscribeRequest.FromString,
                     response_serializer=dispatcher__pb2.WorkerUnsubscribeResponse.SerializeToString,
             ),
+            'Heartbeat': grpc.unary_unary_rpc_method_handler(
+                    servicer.Heartbeat,
+                    request_deserializer=dispatcher__pb2.HeartbeatRequest.FromString,
+                    response_serializer=dispatcher__pb2.HeartbeatResponse.SerializeToString,
+            ),
+            'RefreshTimeout': grpc.unary_unary_rpc_method_handler(
+                    servicer.RefreshTimeout,
+                    request_deserializer=dispatcher__pb2.RefreshTimeoutRequest.FromString,
+                    response_serializer=dispatcher__pb2.RefreshTimeoutResponse.SerializeToString,
+            ),
+            'ReportProgress': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReportProgress,
+                    request_deserializer=dispatcher__pb2.StepRunProgress.FromString,
+                    response_serializer=dispatcher__pb2.ActionEventResponse.SerializeToString,
+            ),
+            'AckAssignedAction': grpc.unary_unary_rpc_method_handler(
+                    servicer.AckAssignedAction,
+                    request_deserializer=dispatcher__pb2.AssignedActionAck.FromString,
+                    response_serializer=dispatcher__pb2.AssignedActionAckResponse.SerializeToString,
+            ),
+            'PutStreamEvent': grpc.unary_unary_rpc_method_handler(
+                    servicer.PutStreamEvent,
+                    request_deserializer=dispatcher__pb2.PutStreamEventRequest.FromString,
+                    response_serializer=dispatcher__pb2.PutStreamEventResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'Dispatcher', rpc_method_handlers)
@@ -194,6 +299,23 @@ class Dispatcher(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def SubscribeToTenantEvents(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/Dispatcher/SubscribeToTenantEvents',
+            dispatcher__pb2.SubscribeToTenantEventsRequest.SerializeToString,
+            dispatcher__pb2.WorkflowEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def SendStepActionEvent(request,
             target,
@@ -261,3 +383,88 @@ class Dispatcher(object):
             dispatcher__pb2.WorkerUnsubscribeResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Heartbeat(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/Dispatcher/Heartbeat',
+            dispatcher__pb2.HeartbeatRequest.SerializeToString,
+            dispatcher__pb2.HeartbeatResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RefreshTimeout(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/Dispatcher/RefreshTimeout',
+            dispatcher__pb2.RefreshTimeoutRequest.SerializeToString,
+            dispatcher__pb2.RefreshTimeoutResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReportProgress(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/Dispatcher/ReportProgress',
+            dispatcher__pb2.StepRunProgress.SerializeToString,
+            dispatcher__pb2.ActionEventResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def AckAssignedAction(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/Dispatcher/AckAssignedAction',
+            dispatcher__pb2.AssignedActionAck.SerializeToString,
+            dispatcher__pb2.AssignedActionAckResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PutStreamEvent(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/Dispatcher/PutStreamEvent',
+            dispatcher__pb2.PutStreamEventRequest.SerializeToString,
+            dispatcher__pb2.PutStreamEventResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
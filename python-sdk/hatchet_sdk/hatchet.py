@@ -1,11 +1,11 @@
 from .client import new_client 
-from typing import List
+from typing import Dict, List
 import asyncio
 from functools import wraps
 from .workflow import WorkflowMeta
 from .worker import Worker
 from .logger import logger
-from .workflows_pb2 import ConcurrencyLimitStrategy
+from .workflows_pb2 import ConcurrencyLimitStrategy, WorkerSelectionStrategy
 
 class Hatchet:
     def __init__(self, debug=False):
@@ -25,7 +25,7 @@ class Hatchet:
         
         return inner
 
-    def workflow(self, name : str='', on_events : list=[], on_crons : list=[], version : str='', timeout : str = '60m', schedule_timeout : str = '5m'):
+    def workflow(self, name : str='', on_events : list=[], on_crons : list=[], version : str='', timeout : str = '60m', schedule_timeout : str = '5m', worker_selection_strategy : WorkerSelectionStrategy = None):
         def inner(cls):
                 cls.on_events = on_events
                 cls.on_crons = on_crons
@@ -34,6 +34,7 @@ class Hatchet:
                 cls.version = version
                 cls.timeout = timeout
                 cls.schedule_timeout = schedule_timeout
+                cls.worker_selection_strategy = worker_selection_strategy
 
                 # Define a new class with the same name and bases as the original, but with WorkflowMeta as its metaclass
                 return WorkflowMeta(cls.name, cls.__bases__, dict(cls.__dict__))
@@ -57,5 +58,5 @@ class Hatchet:
 
         return inner
     
-    def worker(self, name: str, max_runs: int | None = None):
-        return Worker(name=name, max_runs=max_runs)
+    def worker(self, name: str, max_runs: int | None = None, action_slots: Dict[str, int] | None = None):
+        return Worker(name=name, max_runs=max_runs, action_slots=action_slots)
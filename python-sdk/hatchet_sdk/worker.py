@@ -19,10 +19,11 @@ from .logger import logger
 
 
 class Worker:
-    def __init__(self, name: str, max_runs: int | None = None, debug=False, handle_kill=True):
+    def __init__(self, name: str, max_runs: int | None = None, debug=False, handle_kill=True, action_slots: Dict[str, int] | None = None):
         self.name = name
         self.threads: Dict[str, Thread] = {}  # Store step run ids and threads
         self.max_runs = max_runs
+        self.action_slots = action_slots or {}  # Store the max runs per action id
         self.thread_pool = ThreadPoolExecutor(max_workers=max_runs)
         self.futures: Dict[str, Future] = {}  # Store step run ids and futures
         self.contexts: Dict[str, Context] = {}  # Store step run ids and contexts
@@ -336,6 +337,7 @@ class Worker:
                 services=["default"],
                 actions=self.action_registry.keys(),
                 max_runs=self.max_runs,
+                action_slots=self.action_slots,
             ))
 
             generator = self.listener.actions()
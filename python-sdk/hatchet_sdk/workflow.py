@@ -42,6 +42,7 @@ class WorkflowMeta(type):
         version = attrs['version']
         workflowTimeout = attrs['timeout']
         schedule_timeout = attrs['schedule_timeout']
+        worker_selection_strategy = attrs['worker_selection_strategy']
 
         createStepOpts: List[CreateWorkflowStepOpts] = [
             CreateWorkflowStepOpts(
@@ -72,6 +73,7 @@ class WorkflowMeta(type):
             event_triggers=event_triggers,
             cron_triggers=cron_triggers,
             schedule_timeout=schedule_timeout,
+            worker_selection_strategy=worker_selection_strategy,
             jobs=[
                 CreateWorkflowJobOpts(
                     name=name,
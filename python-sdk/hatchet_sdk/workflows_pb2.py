@@ -16,7 +16,7 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from google.protobuf import wrappers_pb2 as google_dot_protobuf_dot_wrappers__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0fworkflows.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\">\n\x12PutWorkflowRequest\x12(\n\x04opts\x18\x01 \x01(\x0b\x32\x1a.CreateWorkflowVersionOpts\"\xbf\x02\n\x19\x43reateWorkflowVersionOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x16\n\x0e\x65vent_triggers\x18\x04 \x03(\t\x12\x15\n\rcron_triggers\x18\x05 \x03(\t\x12\x36\n\x12scheduled_triggers\x18\x06 \x03(\x0b\x32\x1a.google.protobuf.Timestamp\x12$\n\x04jobs\x18\x07 \x03(\x0b\x32\x16.CreateWorkflowJobOpts\x12-\n\x0b\x63oncurrency\x18\x08 \x01(\x0b\x32\x18.WorkflowConcurrencyOpts\x12\x1d\n\x10schedule_timeout\x18\t \x01(\tH\x00\x88\x01\x01\x42\x13\n\x11_schedule_timeout\"n\n\x17WorkflowConcurrencyOpts\x12\x0e\n\x06\x61\x63tion\x18\x01 \x01(\t\x12\x10\n\x08max_runs\x18\x02 \x01(\x05\x12\x31\n\x0elimit_strategy\x18\x03 \x01(\x0e\x32\x19.ConcurrencyLimitStrategy\"s\n\x15\x43reateWorkflowJobOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12&\n\x05steps\x18\x04 \x03(\x0b\x32\x17.CreateWorkflowStepOpts\"\x93\x01\n\x16\x43reateWorkflowStepOpts\x12\x13\n\x0breadable_id\x18\x01 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12\x0e\n\x06inputs\x18\x04 \x01(\t\x12\x0f\n\x07parents\x18\x05 \x03(\t\x12\x11\n\tuser_data\x18\x06 \x01(\t\x12\x0f\n\x07retries\x18\x07 \x01(\x05\"\x16\n\x14ListWorkflowsRequest\"l\n\x17ScheduleWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\x12-\n\tschedules\x18\x02 \x03(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05input\x18\x03 \x01(\t\"5\n\x15ListWorkflowsResponse\x12\x1c\n\tworkflows\x18\x01 \x03(\x0b\x32\t.Workflow\"1\n\x1cListWorkflowsForEventRequest\x12\x11\n\tevent_key\x18\x01 \x01(\t\"\xee\x01\n\x08Workflow\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\ttenant_id\x18\x05 \x01(\t\x12\x0c\n\x04name\x18\x06 \x01(\t\x12\x31\n\x0b\x64\x65scription\x18\x07 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\"\n\x08versions\x18\x08 \x03(\x0b\x32\x10.WorkflowVersion\"\xeb\x01\n\x0fWorkflowVersion\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07version\x18\x05 \x01(\t\x12\r\n\x05order\x18\x06 \x01(\x05\x12\x13\n\x0bworkflow_id\x18\x07 \x01(\t\x12#\n\x08triggers\x18\x08 \x01(\x0b\x32\x11.WorkflowTriggers\x12\x12\n\x04jobs\x18\t \x03(\x0b\x32\x04.Job\"\x80\x02\n\x10WorkflowTriggers\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x13workflow_version_id\x18\x05 \x01(\t\x12\x11\n\ttenant_id\x18\x06 \x01(\t\x12(\n\x06\x65vents\x18\x07 \x03(\x0b\x32\x18.WorkflowTriggerEventRef\x12&\n\x05\x63rons\x18\x08 \x03(\x0b\x32\x17.WorkflowTriggerCronRef\"?\n\x17WorkflowTriggerEventRef\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x11\n\tevent_key\x18\x02 \x01(\t\"9\n\x16WorkflowTriggerCronRef\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x0c\n\x04\x63ron\x18\x02 \x01(\t\"\xa7\x02\n\x03Job\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\ttenant_id\x18\x05 \x01(\t\x12\x1b\n\x13workflow_version_id\x18\x06 \x01(\t\x12\x0c\n\x04name\x18\x07 \x01(\t\x12\x31\n\x0b\x64\x65scription\x18\x08 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x14\n\x05steps\x18\t \x03(\x0b\x32\x05.Step\x12-\n\x07timeout\x18\n \x01(\x0b\x32\x1c.google.protobuf.StringValue\"\xaa\x02\n\x04Step\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\x0breadable_id\x18\x05 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x11\n\ttenant_id\x18\x06 \x01(\t\x12\x0e\n\x06job_id\x18\x07 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x08 \x01(\t\x12-\n\x07timeout\x18\t \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x0f\n\x07parents\x18\n \x03(\t\x12\x10\n\x08\x63hildren\x18\x0b \x03(\t\",\n\x15\x44\x65leteWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\"(\n\x18GetWorkflowByNameRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"5\n\x16TriggerWorkflowRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05input\x18\x02 \x01(\t\"2\n\x17TriggerWorkflowResponse\x12\x17\n\x0fworkflow_run_id\x18\x01 \x01(\t*l\n\x18\x43oncurrencyLimitStrategy\x12\x16\n\x12\x43\x41NCEL_IN_PROGRESS\x10\x00\x12\x0f\n\x0b\x44ROP_NEWEST\x10\x01\x12\x10\n\x0cQUEUE_NEWEST\x10\x02\x12\x15\n\x11GROUP_ROUND_ROBIN\x10\x03\x32\xcd\x03\n\x0fWorkflowService\x12>\n\rListWorkflows\x12\x15.ListWorkflowsRequest\x1a\x16.ListWorkflowsResponse\x12\x34\n\x0bPutWorkflow\x12\x13.PutWorkflowRequest\x1a\x10.WorkflowVersion\x12>\n\x10ScheduleWorkflow\x12\x18.ScheduleWorkflowRequest\x1a\x10.WorkflowVersion\x12\x44\n\x0fTriggerWorkflow\x12\x17.TriggerWorkflowRequest\x1a\x18.TriggerWorkflowResponse\x12\x39\n\x11GetWorkflowByName\x12\x19.GetWorkflowByNameRequest\x1a\t.Workflow\x12N\n\x15ListWorkflowsForEvent\x12\x1d.ListWorkflowsForEventRequest\x1a\x16.ListWorkflowsResponse\x12\x33\n\x0e\x44\x65leteWorkflow\x12\x16.DeleteWorkflowRequest\x1a\t.WorkflowBBZ@github.com/hatchet-dev/hatchet/internal/services/admin/contractsb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0fworkflows.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"T\n\x12PutWorkflowRequest\x12(\n\x04opts\x18\x01 \x01(\x0b\x32\x1a.CreateWorkflowVersionOpts\x12\x14\n\x0cinclude_diff\x18\x02 \x01(\x08\"\xda\x03\n\x19\x43reateWorkflowVersionOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x16\n\x0e\x65vent_triggers\x18\x04 \x03(\t\x12\x15\n\rcron_triggers\x18\x05 \x03(\t\x12\x36\n\x12scheduled_triggers\x18\x06 \x03(\x0b\x32\x1a.google.protobuf.Timestamp\x12$\n\x04jobs\x18\x07 \x03(\x0b\x32\x16.CreateWorkflowJobOpts\x12-\n\x0b\x63oncurrency\x18\x08 \x01(\x0b\x32\x18.WorkflowConcurrencyOpts\x12\x1d\n\x10schedule_timeout\x18\t \x01(\tH\x00\x88\x01\x01\x12@\n\x19worker_selection_strategy\x18\n \x01(\x0e\x32\x18.WorkerSelectionStrategyH\x01\x88\x01\x01\x12\x39\n\x11\x63ron_trigger_opts\x18\x0b \x03(\x0b\x32\x1e.CreateWorkflowCronTriggerOptsB\x13\n\x11_schedule_timeoutB\x1c\n\x1a_worker_selection_strategy\"\xde\x01\n\x1d\x43reateWorkflowCronTriggerOpts\x12\x0c\n\x04\x63ron\x18\x01 \x01(\t\x12\x10\n\x08timezone\x18\x02 \x01(\t\x12\r\n\x05input\x18\x03 \x01(\t\x12/\n\x0emisfire_policy\x18\x04 \x01(\x0e\x32\x12.CronMisfirePolicyH\x00\x88\x01\x01\x12\x19\n\x0cmax_misfires\x18\x05 \x01(\x05H\x01\x88\x01\x01\x12\x13\n\x06jitter\x18\x06 \x01(\tH\x02\x88\x01\x01\x42\x11\n\x0f_misfire_policyB\x0f\n\r_max_misfiresB\t\n\x07_jitter\"\x82\x01\n\x17WorkflowConcurrencyOpts\x12\x0e\n\x06\x61\x63tion\x18\x01 \x01(\t\x12\x10\n\x08max_runs\x18\x02 \x01(\x05\x12\x31\n\x0elimit_strategy\x18\x03 \x01(\x0e\x32\x19.ConcurrencyLimitStrategy\x12\x12\n\nexpression\x18\x04 \x01(\t\"s\n\x15\x43reateWorkflowJobOpts\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12&\n\x05steps\x18\x04 \x03(\x0b\x32\x17.CreateWorkflowStepOpts\"\xbe\x01\n\x16\x43reateWorkflowStepOpts\x12\x13\n\x0breadable_id\x18\x01 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\t\x12\x0e\n\x06inputs\x18\x04 \x01(\t\x12\x0f\n\x07parents\x18\x05 \x03(\t\x12\x11\n\tuser_data\x18\x06 \x01(\t\x12\x0f\n\x07retries\x18\x07 \x01(\x05\x12)\n\x0b\x63oncurrency\x18\x08 \x01(\x0b\x32\x14.StepConcurrencyOpts\"4\n\x13StepConcurrencyOpts\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x10\n\x08max_runs\x18\x02 \x01(\x05\"\x16\n\x14ListWorkflowsRequest\"l\n\x17ScheduleWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\x12-\n\tschedules\x18\x02 \x03(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05input\x18\x03 \x01(\t\"\xb9\x02\n\x11ScheduledWorkflow\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x13\n\x0bworkflow_id\x18\x04 \x01(\t\x12\x15\n\rworkflow_name\x18\x05 \x01(\t\x12\x1b\n\x13workflow_version_id\x18\x06 \x01(\t\x12.\n\ntrigger_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05input\x18\x08 \x01(\t\x12\x1c\n\x0fworkflow_run_id\x18\t \x01(\tH\x00\x88\x01\x01\x42\x12\n\x10_workflow_run_id\"\xad\x01\n\x1dListScheduledWorkflowsRequest\x12\x18\n\x0bworkflow_id\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x16\n\ttriggered\x18\x02 \x01(\x08H\x01\x88\x01\x01\x12\x13\n\x06offset\x18\x03 \x01(\x05H\x02\x88\x01\x01\x12\x12\n\x05limit\x18\x04 \x01(\x05H\x03\x88\x01\x01\x42\x0e\n\x0c_workflow_idB\x0c\n\n_triggeredB\t\n\x07_offsetB\x08\n\x06_limit\"`\n\x1eListScheduledWorkflowsResponse\x12/\n\x13scheduled_workflows\x18\x01 \x03(\x0b\x32\x12.ScheduledWorkflow\x12\r\n\x05\x63ount\x18\x02 \x01(\x05\"<\n\x1bGetScheduledWorkflowRequest\x12\x1d\n\x15scheduled_workflow_id\x18\x01 \x01(\t\"\xa1\x01\n\x1eUpdateScheduledWorkflowRequest\x12\x1d\n\x15scheduled_workflow_id\x18\x01 \x01(\t\x12\x33\n\ntrigger_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00\x88\x01\x01\x12\x12\n\x05input\x18\x03 \x01(\tH\x01\x88\x01\x01\x42\r\n\x0b_trigger_atB\x08\n\x06_input\"?\n\x1e\x44\x65leteScheduledWorkflowRequest\x12\x1d\n\x15scheduled_workflow_id\x18\x01 \x01(\t\"\xa0\x01\n\x12PreviewCronRequest\x12\x0c\n\x04\x63ron\x18\x01 \x01(\t\x12\x10\n\x08timezone\x18\x02 \x01(\t\x12\x13\n\x06jitter\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x1a\n\rworkflow_name\x18\x04 \x01(\tH\x01\x88\x01\x01\x12\x12\n\x05\x63ount\x18\x05 \x01(\x05H\x02\x88\x01\x01\x42\t\n\x07_jitterB\x10\n\x0e_workflow_nameB\x08\n\x06_count\"J\n\x13PreviewCronResponse\x12\x33\n\x0fnext_fire_times\x18\x01 \x03(\x0b\x32\x1a.google.protobuf.Timestamp\"5\n\x15ListWorkflowsResponse\x12\x1c\n\tworkflows\x18\x01 \x03(\x0b\x32\t.Workflow\"1\n\x1cListWorkflowsForEventRequest\x12\x11\n\tevent_key\x18\x01 \x01(\t\"\x81\x02\n\x08Workflow\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\ttenant_id\x18\x05 \x01(\t\x12\x0c\n\x04name\x18\x06 \x01(\t\x12\x31\n\x0b\x64\x65scription\x18\x07 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\"\n\x08versions\x18\x08 \x03(\x0b\x32\x10.WorkflowVersion\x12\x11\n\tis_paused\x18\t \x01(\x08\"\x8f\x02\n\x0fWorkflowVersion\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07version\x18\x05 \x01(\t\x12\r\n\x05order\x18\x06 \x01(\x05\x12\x13\n\x0bworkflow_id\x18\x07 \x01(\t\x12#\n\x08triggers\x18\x08 \x01(\x0b\x32\x11.WorkflowTriggers\x12\x12\n\x04jobs\x18\t \x03(\x0b\x32\x04.Job\x12\"\n\x04\x64iff\x18\n \x01(\x0b\x32\x14.WorkflowVersionDiff\"\x80\x01\n\x13WorkflowVersionDiff\x12\x17\n\x0f\x66rom_version_id\x18\x01 \x01(\t\x12\x15\n\rto_version_id\x18\x02 \x01(\t\x12\'\n\x07\x63hanges\x18\x03 \x03(\x0b\x32\x16.WorkflowVersionChange\x12\x10\n\x08raw_diff\x18\x04 \x01(\t\"h\n\x15WorkflowVersionChange\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0c\n\x04kind\x18\x02 \x01(\t\x12\x0b\n\x03job\x18\x03 \x01(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\x12\x0c\n\x04\x66rom\x18\x05 \x01(\t\x12\n\n\x02to\x18\x06 \x01(\t\"\x80\x02\n\x10WorkflowTriggers\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x13workflow_version_id\x18\x05 \x01(\t\x12\x11\n\ttenant_id\x18\x06 \x01(\t\x12(\n\x06\x65vents\x18\x07 \x03(\x0b\x32\x18.WorkflowTriggerEventRef\x12&\n\x05\x63rons\x18\x08 \x03(\x0b\x32\x17.WorkflowTriggerCronRef\"?\n\x17WorkflowTriggerEventRef\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x11\n\tevent_key\x18\x02 \x01(\t\"\xaf\x01\n\x16WorkflowTriggerCronRef\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x0c\n\x04\x63ron\x18\x02 \x01(\t\x12\x10\n\x08timezone\x18\x03 \x01(\t\x12\r\n\x05input\x18\x04 \x01(\t\x12\x13\n\x06jitter\x18\x05 \x01(\tH\x00\x88\x01\x01\x12\x33\n\x0fnext_fire_times\x18\x06 \x03(\x0b\x32\x1a.google.protobuf.TimestampB\t\n\x07_jitter\"\xa7\x02\n\x03Job\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\ttenant_id\x18\x05 \x01(\t\x12\x1b\n\x13workflow_version_id\x18\x06 \x01(\t\x12\x0c\n\x04name\x18\x07 \x01(\t\x12\x31\n\x0b\x64\x65scription\x18\x08 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x14\n\x05steps\x18\t \x03(\x0b\x32\x05.Step\x12-\n\x07timeout\x18\n \x01(\x0b\x32\x1c.google.protobuf.StringValue\"\xaa\x02\n\x04Step\x12\n\n\x02id\x18\x01 \x01(\t\x12.\n\ncreated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\x0breadable_id\x18\x05 \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x11\n\ttenant_id\x18\x06 \x01(\t\x12\x0e\n\x06job_id\x18\x07 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x08 \x01(\t\x12-\n\x07timeout\x18\t \x01(\x0b\x32\x1c.google.protobuf.StringValue\x12\x0f\n\x07parents\x18\n \x03(\t\x12\x10\n\x08\x63hildren\x18\x0b \x03(\t\",\n\x15\x44\x65leteWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\"A\n\x14PauseWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\x12\x14\n\x0cqueue_events\x18\x02 \x01(\x08\"C\n\x15ResumeWorkflowRequest\x12\x13\n\x0bworkflow_id\x18\x01 \x01(\t\x12\x15\n\rreplay_events\x18\x02 \x01(\x08\"(\n\x18GetWorkflowByNameRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"5\n\x16TriggerWorkflowRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05input\x18\x02 \x01(\t\"2\n\x17TriggerWorkflowResponse\x12\x17\n\x0fworkflow_run_id\x18\x01 \x01(\t*8\n\x11\x43ronMisfirePolicy\x12\x08\n\x04SKIP\x10\x00\x12\x0c\n\x08RUN_ONCE\x10\x01\x12\x0b\n\x07RUN_ALL\x10\x02*c\n\x17WorkerSelectionStrategy\x12\x10\n\x0cLEAST_LOADED\x10\x00\x12\x0f\n\x0bROUND_ROBIN\x10\x01\x12\n\n\x06RANDOM\x10\x02\x12\x19\n\x15MOST_RECENT_HEARTBEAT\x10\x03*l\n\x18\x43oncurrencyLimitStrategy\x12\x16\n\x12\x43\x41NCEL_IN_PROGRESS\x10\x00\x12\x0f\n\x0b\x44ROP_NEWEST\x10\x01\x12\x10\n\x0cQUEUE_NEWEST\x10\x02\x12\x15\n\x11GROUP_ROUND_ROBIN\x10\x03\x32\xb4\x07\n\x0fWorkflowService\x12>\n\rListWorkflows\x12\x15.ListWorkflowsRequest\x1a\x16.ListWorkflowsResponse\x12\x34\n\x0bPutWorkflow\x12\x13.PutWorkflowRequest\x1a\x10.WorkflowVersion\x12>\n\x10ScheduleWorkflow\x12\x18.ScheduleWorkflowRequest\x1a\x10.WorkflowVersion\x12\x44\n\x0fTriggerWorkflow\x12\x17.TriggerWorkflowRequest\x1a\x18.TriggerWorkflowResponse\x12\x39\n\x11GetWorkflowByName\x12\x19.GetWorkflowByNameRequest\x1a\t.Workflow\x12N\n\x15ListWorkflowsForEvent\x12\x1d.ListWorkflowsForEventRequest\x1a\x16.ListWorkflowsResponse\x12\x33\n\x0e\x44\x65leteWorkflow\x12\x16.DeleteWorkflowRequest\x1a\t.Workflow\x12\x31\n\rPauseWorkflow\x12\x15.PauseWorkflowRequest\x1a\t.Workflow\x12\x33\n\x0eResumeWorkflow\x12\x16.ResumeWorkflowRequest\x1a\t.Workflow\x12Y\n\x16ListScheduledWorkflows\x12\x1e.ListScheduledWorkflowsRequest\x1a\x1f.ListScheduledWorkflowsResponse\x12H\n\x14GetScheduledWorkflow\x12\x1c.GetScheduledWorkflowRequest\x1a\x12.ScheduledWorkflow\x12N\n\x17UpdateScheduledWorkflow\x12\x1f.UpdateScheduledWorkflowRequest\x1a\x12.ScheduledWorkflow\x12N\n\x17\x44\x65leteScheduledWorkflow\x12\x1f.DeleteScheduledWorkflowRequest\x1a\x12.ScheduledWorkflow\x12\x38\n\x0bPreviewCron\x12\x13.PreviewCronRequest\x1a\x14.PreviewCronResponseBBZ@github.com/hatchet-dev/hatchet/internal/services/admin/contractsb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,48 +24,80 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'workflows_pb2', _globals)
 if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['DESCRIPTOR']._options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z@github.com/hatchet-dev/hatchet/internal/services/admin/contracts'
-  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_start=2745
-  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_end=2853
+  _globals['_CRONMISFIREPOLICY']._serialized_start=4932
+  _globals['_CRONMISFIREPOLICY']._serialized_end=4988
+  _globals['_WORKERSELECTIONSTRATEGY']._serialized_start=4990
+  _globals['_WORKERSELECTIONSTRATEGY']._serialized_end=5089
+  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_start=5091
+  _globals['_CONCURRENCYLIMITSTRATEGY']._serialized_end=5199
   _globals['_PUTWORKFLOWREQUEST']._serialized_start=84
-  _globals['_PUTWORKFLOWREQUEST']._serialized_end=146
-  _globals['_CREATEWORKFLOWVERSIONOPTS']._serialized_start=149
-  _globals['_CREATEWORKFLOWVERSIONOPTS']._serialized_end=468
-  _globals['_WORKFLOWCONCURRENCYOPTS']._serialized_start=470
-  _globals['_WORKFLOWCONCURRENCYOPTS']._serialized_end=580
-  _globals['_CREATEWORKFLOWJOBOPTS']._serialized_start=582
-  _globals['_CREATEWORKFLOWJOBOPTS']._serialized_end=697
-  _globals['_CREATEWORKFLOWSTEPOPTS']._serialized_start=700
-  _globals['_CREATEWORKFLOWSTEPOPTS']._serialized_end=847
-  _globals['_LISTWORKFLOWSREQUEST']._serialized_start=849
-  _globals['_LISTWORKFLOWSREQUEST']._serialized_end=871
-  _globals['_SCHEDULEWORKFLOWREQUEST']._serialized_start=873
-  _globals['_SCHEDULEWORKFLOWREQUEST']._serialized_end=981
-  _globals['_LISTWORKFLOWSRESPONSE']._serialized_start=983
-  _globals['_LISTWORKFLOWSRESPONSE']._serialized_end=1036
-  _globals['_LISTWORKFLOWSFOREVENTREQUEST']._serialized_start=1038
-  _globals['_LISTWORKFLOWSFOREVENTREQUEST']._serialized_end=1087
-  _globals['_WORKFLOW']._serialized_start=1090
-  _globals['_WORKFLOW']._serialized_end=1328
-  _globals['_WORKFLOWVERSION']._serialized_start=1331
-  _globals['_WORKFLOWVERSION']._serialized_end=1566
-  _globals['_WORKFLOWTRIGGERS']._serialized_start=1569
-  _globals['_WORKFLOWTRIGGERS']._serialized_end=1825
-  _globals['_WORKFLOWTRIGGEREVENTREF']._serialized_start=1827
-  _globals['_WORKFLOWTRIGGEREVENTREF']._serialized_end=1890
-  _globals['_WORKFLOWTRIGGERCRONREF']._serialized_start=1892
-  _globals['_WORKFLOWTRIGGERCRONREF']._serialized_end=1949
-  _globals['_JOB']._serialized_start=1952
-  _globals['_JOB']._serialized_end=2247
-  _globals['_STEP']._serialized_start=2250
-  _globals['_STEP']._serialized_end=2548
-  _globals['_DELETEWORKFLOWREQUEST']._serialized_start=2550
-  _globals['_DELETEWORKFLOWREQUEST']._serialized_end=2594
-  _globals['_GETWORKFLOWBYNAMEREQUEST']._serialized_start=2596
-  _globals['_GETWORKFLOWBYNAMEREQUEST']._serialized_end=2636
-  _globals['_TRIGGERWORKFLOWREQUEST']._serialized_start=2638
-  _globals['_TRIGGERWORKFLOWREQUEST']._serialized_end=2691
-  _globals['_TRIGGERWORKFLOWRESPONSE']._serialized_start=2693
-  _globals['_TRIGGERWORKFLOWRESPONSE']._serialized_end=2743
-  _globals['_WORKFLOWSERVICE']._serialized_start=2856
-  _globals['_WORKFLOWSERVICE']._serialized_end=3317
+  _globals['_PUTWORKFLOWREQUEST']._serialized_end=168
+  _globals['_CREATEWORKFLOWVERSIONOPTS']._serialized_start=171
+  _globals['_CREATEWORKFLOWVERSIONOPTS']._serialized_end=645
+  _globals['_CREATEWORKFLOWCRONTRIGGEROPTS']._serialized_start=648
+  _globals['_CREATEWORKFLOWCRONTRIGGEROPTS']._serialized_end=870
+  _globals['_WORKFLOWCONCURRENCYOPTS']._serialized_start=873
+  _globals['_WORKFLOWCONCURRENCYOPTS']._serialized_end=1003
+  _globals['_CREATEWORKFLOWJOBOPTS']._serialized_start=1005
+  _globals['_CREATEWORKFLOWJOBOPTS']._serialized_end=1120
+  _globals['_CREATEWORKFLOWSTEPOPTS']._serialized_start=1123
+  _globals['_CREATEWORKFLOWSTEPOPTS']._serialized_end=1313
+  _globals['_STEPCONCURRENCYOPTS']._serialized_start=1315
+  _globals['_STEPCONCURRENCYOPTS']._serialized_end=1367
+  _globals['_LISTWORKFLOWSREQUEST']._serialized_start=1369
+  _globals['_LISTWORKFLOWSREQUEST']._serialized_end=1391
+  _globals['_SCHEDULEWORKFLOWREQUEST']._serialized_start=1393
+  _globals['_SCHEDULEWORKFLOWREQUEST']._serialized_end=1501
+  _globals['_SCHEDULEDWORKFLOW']._serialized_start=1504
+  _globals['_SCHEDULEDWORKFLOW']._serialized_end=1817
+  _globals['_LISTSCHEDULEDWORKFLOWSREQUEST']._serialized_start=1820
+  _globals['_LISTSCHEDULEDWORKFLOWSREQUEST']._serialized_end=1993
+  _globals['_LISTSCHEDULEDWORKFLOWSRESPONSE']._serialized_start=1995
+  _globals['_LISTSCHEDULEDWORKFLOWSRESPONSE']._serialized_end=2091
+  _globals['_GETSCHEDULEDWORKFLOWREQUEST']._serialized_start=2093
+  _globals['_GETSCHEDULEDWORKFLOWREQUEST']._serialized_end=2153
+  _globals['_UPDATESCHEDULEDWORKFLOWREQUEST']._serialized_start=2156
+  _globals['_UPDATESCHEDULEDWORKFLOWREQUEST']._serialized_end=2317
+  _globals['_DELETESCHEDULEDWORKFLOWREQUEST']._serialized_start=2319
+  _globals['_DELETESCHEDULEDWORKFLOWREQUEST']._serialized_end=2382
+  _globals['_PREVIEWCRONREQUEST']._serialized_start=2385
+  _globals['_PREVIEWCRONREQUEST']._serialized_end=2545
+  _globals['_PREVIEWCRONRESPONSE']._serialized_start=2547
+  _globals['_PREVIEWCRONRESPONSE']._serialized_end=2621
+  _globals['_LISTWORKFLOWSRESPONSE']._serialized_start=2623
+  _globals['_LISTWORKFLOWSRESPONSE']._serialized_end=2676
+  _globals['_LISTWORKFLOWSFOREVENTREQUEST']._serialized_start=2678
+  _globals['_LISTWORKFLOWSFOREVENTREQUEST']._serialized_end=2727
+  _globals['_WORKFLOW']._serialized_start=2730
+  _globals['_WORKFLOW']._serialized_end=2987
+  _globals['_WORKFLOWVERSION']._serialized_start=2990
+  _globals['_WORKFLOWVERSION']._serialized_end=3261
+  _globals['_WORKFLOWVERSIONDIFF']._serialized_start=3264
+  _globals['_WORKFLOWVERSIONDIFF']._serialized_end=3392
+  _globals['_WORKFLOWVERSIONCHANGE']._serialized_start=3394
+  _globals['_WORKFLOWVERSIONCHANGE']._serialized_end=3498
+  _globals['_WORKFLOWTRIGGERS']._serialized_start=3501
+  _globals['_WORKFLOWTRIGGERS']._serialized_end=3757
+  _globals['_WORKFLOWTRIGGEREVENTREF']._serialized_start=3759
+  _globals['_WORKFLOWTRIGGEREVENTREF']._serialized_end=3822
+  _globals['_WORKFLOWTRIGGERCRONREF']._serialized_start=3825
+  _globals['_WORKFLOWTRIGGERCRONREF']._serialized_end=4000
+  _globals['_JOB']._serialized_start=4003
+  _globals['_JOB']._serialized_end=4298
+  _globals['_STEP']._serialized_start=4301
+  _globals['_STEP']._serialized_end=4599
+  _globals['_DELETEWORKFLOWREQUEST']._serialized_start=4601
+  _globals['_DELETEWORKFLOWREQUEST']._serialized_end=4645
+  _globals['_PAUSEWORKFLOWREQUEST']._serialized_start=4647
+  _globals['_PAUSEWORKFLOWREQUEST']._serialized_end=4712
+  _globals['_RESUMEWORKFLOWREQUEST']._serialized_start=4714
+  _globals['_RESUMEWORKFLOWREQUEST']._serialized_end=4781
+  _globals['_GETWORKFLOWBYNAMEREQUEST']._serialized_start=4783
+  _globals['_GETWORKFLOWBYNAMEREQUEST']._serialized_end=4823
+  _globals['_TRIGGERWORKFLOWREQUEST']._serialized_start=4825
+  _globals['_TRIGGERWORKFLOWREQUEST']._serialized_end=4878
+  _globals['_TRIGGERWORKFLOWRESPONSE']._serialized_start=4880
+  _globals['_TRIGGERWORKFLOWRESPONSE']._serialized_end=4930
+  _globals['_WORKFLOWSERVICE']._serialized_start=5202
+  _globals['_WORKFLOWSERVICE']._serialized_end=6150
 # @@protoc_insertion_point(module_scope)
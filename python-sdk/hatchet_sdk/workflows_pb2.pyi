@@ -8,25 +8,47 @@ from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Map
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class CronMisfirePolicy(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    SKIP: _ClassVar[CronMisfirePolicy]
+    RUN_ONCE: _ClassVar[CronMisfirePolicy]
+    RUN_ALL: _ClassVar[CronMisfirePolicy]
+
+class WorkerSelectionStrategy(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    LEAST_LOADED: _ClassVar[WorkerSelectionStrategy]
+    ROUND_ROBIN: _ClassVar[WorkerSelectionStrategy]
+    RANDOM: _ClassVar[WorkerSelectionStrategy]
+    MOST_RECENT_HEARTBEAT: _ClassVar[WorkerSelectionStrategy]
+
 class ConcurrencyLimitStrategy(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
     CANCEL_IN_PROGRESS: _ClassVar[ConcurrencyLimitStrategy]
     DROP_NEWEST: _ClassVar[ConcurrencyLimitStrategy]
     QUEUE_NEWEST: _ClassVar[ConcurrencyLimitStrategy]
     GROUP_ROUND_ROBIN: _ClassVar[ConcurrencyLimitStrategy]
+SKIP: CronMisfirePolicy
+RUN_ONCE: CronMisfirePolicy
+RUN_ALL: CronMisfirePolicy
+LEAST_LOADED: WorkerSelectionStrategy
+ROUND_ROBIN: WorkerSelectionStrategy
+RANDOM: WorkerSelectionStrategy
+MOST_RECENT_HEARTBEAT: WorkerSelectionStrategy
 CANCEL_IN_PROGRESS: ConcurrencyLimitStrategy
 DROP_NEWEST: ConcurrencyLimitStrategy
 QUEUE_NEWEST: ConcurrencyLimitStrategy
 GROUP_ROUND_ROBIN: ConcurrencyLimitStrategy
 
 class PutWorkflowRequest(_message.Message):
-    __slots__ = ("opts",)
+    __slots__ = ("opts", "include_diff")
     OPTS_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_DIFF_FIELD_NUMBER: _ClassVar[int]
     opts: CreateWorkflowVersionOpts
-    def __init__(self, opts: _Optional[_Union[CreateWorkflowVersionOpts, _Mapping]] = ...) -> None: ...
+    include_diff: bool
+    def __init__(self, opts: _Optional[_Union[CreateWorkflowVersionOpts, _Mapping]] = ..., include_diff: bool = ...) -> None: ...
 
 class CreateWorkflowVersionOpts(_message.Message):
-    __slots__ = ("name", "description", "version", "event_triggers", "cron_triggers", "scheduled_triggers", "jobs", "concurrency", "schedule_timeout")
+    __slots__ = ("name", "description", "version", "event_triggers", "cron_triggers", "scheduled_triggers", "jobs", "concurrency", "schedule_timeout", "worker_selection_strategy", "cron_trigger_opts")
     NAME_FIELD_NUMBER: _ClassVar[int]
     DESCRIPTION_FIELD_NUMBER: _ClassVar[int]
     VERSION_FIELD_NUMBER: _ClassVar[int]
@@ -36,6 +58,8 @@ class CreateWorkflowVersionOpts(_message.Message):
     JOBS_FIELD_NUMBER: _ClassVar[int]
     CONCURRENCY_FIELD_NUMBER: _ClassVar[int]
     SCHEDULE_TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    WORKER_SELECTION_STRATEGY_FIELD_NUMBER: _ClassVar[int]
+    CRON_TRIGGER_OPTS_FIELD_NUMBER: _ClassVar[int]
     name: str
     description: str
     version: str
@@ -45,17 +69,37 @@ class CreateWorkflowVersionOpts(_message.Message):
     jobs: _containers.RepeatedCompositeFieldContainer[CreateWorkflowJobOpts]
     concurrency: WorkflowConcurrencyOpts
     schedule_timeout: str
-    def __init__(self, name: _Optional[str] = ..., description: _Optional[str] = ..., version: _Optional[str] = ..., event_triggers: _Optional[_Iterable[str]] = ..., cron_triggers: _Optional[_Iterable[str]] = ..., scheduled_triggers: _Optional[_Iterable[_Union[_timestamp_pb2.Timestamp, _Mapping]]] = ..., jobs: _Optional[_Iterable[_Union[CreateWorkflowJobOpts, _Mapping]]] = ..., concurrency: _Optional[_Union[WorkflowConcurrencyOpts, _Mapping]] = ..., schedule_timeout: _Optional[str] = ...) -> None: ...
+    worker_selection_strategy: WorkerSelectionStrategy
+    cron_trigger_opts: _containers.RepeatedCompositeFieldContainer[CreateWorkflowCronTriggerOpts]
+    def __init__(self, name: _Optional[str] = ..., description: _Optional[str] = ..., version: _Optional[str] = ..., event_triggers: _Optional[_Iterable[str]] = ..., cron_triggers: _Optional[_Iterable[str]] = ..., scheduled_triggers: _Optional[_Iterable[_Union[_timestamp_pb2.Timestamp, _Mapping]]] = ..., jobs: _Optional[_Iterable[_Union[CreateWorkflowJobOpts, _Mapping]]] = ..., concurrency: _Optional[_Union[WorkflowConcurrencyOpts, _Mapping]] = ..., schedule_timeout: _Optional[str] = ..., worker_selection_strategy: _Optional[_Union[WorkerSelectionStrategy, str]] = ..., cron_trigger_opts: _Optional[_Iterable[_Union[CreateWorkflowCronTriggerOpts, _Mapping]]] = ...) -> None: ...
+
+class CreateWorkflowCronTriggerOpts(_message.Message):
+    __slots__ = ("cron", "timezone", "input", "misfire_policy", "max_misfires", "jitter")
+    CRON_FIELD_NUMBER: _ClassVar[int]
+    TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    INPUT_FIELD_NUMBER: _ClassVar[int]
+    MISFIRE_POLICY_FIELD_NUMBER: _ClassVar[int]
+    MAX_MISFIRES_FIELD_NUMBER: _ClassVar[int]
+    JITTER_FIELD_NUMBER: _ClassVar[int]
+    cron: str
+    timezone: str
+    input: str
+    misfire_policy: CronMisfirePolicy
+    max_misfires: int
+    jitter: str
+    def __init__(self, cron: _Optional[str] = ..., timezone: _Optional[str] = ..., input: _Optional[str] = ..., misfire_policy: _Optional[_Union[CronMisfirePolicy, str]] = ..., max_misfires: _Optional[int] = ..., jitter: _Optional[str] = ...) -> None: ...
 
 class WorkflowConcurrencyOpts(_message.Message):
-    __slots__ = ("action", "max_runs", "limit_strategy")
+    __slots__ = ("action", "max_runs", "limit_strategy", "expression")
     ACTION_FIELD_NUMBER: _ClassVar[int]
     MAX_RUNS_FIELD_NUMBER: _ClassVar[int]
     LIMIT_STRATEGY_FIELD_NUMBER: _ClassVar[int]
+    EXPRESSION_FIELD_NUMBER: _ClassVar[int]
     action: str
     max_runs: int
     limit_strategy: ConcurrencyLimitStrategy
-    def __init__(self, action: _Optional[str] = ..., max_runs: _Optional[int] = ..., limit_strategy: _Optional[_Union[ConcurrencyLimitStrategy, str]] = ...) -> None: ...
+    expression: str
+    def __init__(self, action: _Optional[str] = ..., max_runs: _Optional[int] = ..., limit_strategy: _Optional[_Union[ConcurrencyLimitStrategy, str]] = ..., expression: _Optional[str] = ...) -> None: ...
 
 class CreateWorkflowJobOpts(_message.Message):
     __slots__ = ("name", "description", "timeout", "steps")
@@ -70,7 +114,7 @@ class CreateWorkflowJobOpts(_message.Message):
     def __init__(self, name: _Optional[str] = ..., description: _Optional[str] = ..., timeout: _Optional[str] = ..., steps: _Optional[_Iterable[_Union[CreateWorkflowStepOpts, _Mapping]]] = ...) -> None: ...
 
 class CreateWorkflowStepOpts(_message.Message):
-    __slots__ = ("readable_id", "action", "timeout", "inputs", "parents", "user_data", "retries")
+    __slots__ = ("readable_id", "action", "timeout", "inputs", "parents", "user_data", "retries", "concurrency")
     READABLE_ID_FIELD_NUMBER: _ClassVar[int]
     ACTION_FIELD_NUMBER: _ClassVar[int]
     TIMEOUT_FIELD_NUMBER: _ClassVar[int]
@@ -78,6 +122,7 @@ class CreateWorkflowStepOpts(_message.Message):
     PARENTS_FIELD_NUMBER: _ClassVar[int]
     USER_DATA_FIELD_NUMBER: _ClassVar[int]
     RETRIES_FIELD_NUMBER: _ClassVar[int]
+    CONCURRENCY_FIELD_NUMBER: _ClassVar[int]
     readable_id: str
     action: str
     timeout: str
@@ -85,7 +130,16 @@ class CreateWorkflowStepOpts(_message.Message):
     parents: _containers.RepeatedScalarFieldContainer[str]
     user_data: str
     retries: int
-    def __init__(self, readable_id: _Optional[str] = ..., action: _Optional[str] = ..., timeout: _Optional[str] = ..., inputs: _Optional[str] = ..., parents: _Optional[_Iterable[str]] = ..., user_data: _Optional[str] = ..., retries: _Optional[int] = ...) -> None: ...
+    concurrency: StepConcurrencyOpts
+    def __init__(self, readable_id: _Optional[str] = ..., action: _Optional[str] = ..., timeout: _Optional[str] = ..., inputs: _Optional[str] = ..., parents: _Optional[_Iterable[str]] = ..., user_data: _Optional[str] = ..., retries: _Optional[int] = ..., concurrency: _Optional[_Union[StepConcurrencyOpts, _Mapping]] = ...) -> None: ...
+
+class StepConcurrencyOpts(_message.Message):
+    __slots__ = ("key", "max_runs")
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    MAX_RUNS_FIELD_NUMBER: _ClassVar[int]
+    key: str
+    max_runs: int
+    def __init__(self, key: _Optional[str] = ..., max_runs: _Optional[int] = ...) -> None: ...
 
 class ListWorkflowsRequest(_message.Message):
     __slots__ = ()
@@ -101,6 +155,90 @@ class ScheduleWorkflowRequest(_message.Message):
     input: str
     def __init__(self, workflow_id: _Optional[str] = ..., schedules: _Optional[_Iterable[_Union[_timestamp_pb2.Timestamp, _Mapping]]] = ..., input: _Optional[str] = ...) -> None: ...
 
+class ScheduledWorkflow(_message.Message):
+    __slots__ = ("id", "created_at", "updated_at", "workflow_id", "workflow_name", "workflow_version_id", "trigger_at", "input", "workflow_run_id")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_NAME_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_VERSION_ID_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_AT_FIELD_NUMBER: _ClassVar[int]
+    INPUT_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_RUN_ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    created_at: _timestamp_pb2.Timestamp
+    updated_at: _timestamp_pb2.Timestamp
+    workflow_id: str
+    workflow_name: str
+    workflow_version_id: str
+    trigger_at: _timestamp_pb2.Timestamp
+    input: str
+    workflow_run_id: str
+    def __init__(self, id: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., workflow_id: _Optional[str] = ..., workflow_name: _Optional[str] = ..., workflow_version_id: _Optional[str] = ..., trigger_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., input: _Optional[str] = ..., workflow_run_id: _Optional[str] = ...) -> None: ...
+
+class ListScheduledWorkflowsRequest(_message.Message):
+    __slots__ = ("workflow_id", "triggered", "offset", "limit")
+    WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    TRIGGERED_FIELD_NUMBER: _ClassVar[int]
+    OFFSET_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    workflow_id: str
+    triggered: bool
+    offset: int
+    limit: int
+    def __init__(self, workflow_id: _Optional[str] = ..., triggered: bool = ..., offset: _Optional[int] = ..., limit: _Optional[int] = ...) -> None: ...
+
+class ListScheduledWorkflowsResponse(_message.Message):
+    __slots__ = ("scheduled_workflows", "count")
+    SCHEDULED_WORKFLOWS_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    scheduled_workflows: _containers.RepeatedCompositeFieldContainer[ScheduledWorkflow]
+    count: int
+    def __init__(self, scheduled_workflows: _Optional[_Iterable[_Union[ScheduledWorkflow, _Mapping]]] = ..., count: _Optional[int] = ...) -> None: ...
+
+class GetScheduledWorkflowRequest(_message.Message):
+    __slots__ = ("scheduled_workflow_id",)
+    SCHEDULED_WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    scheduled_workflow_id: str
+    def __init__(self, scheduled_workflow_id: _Optional[str] = ...) -> None: ...
+
+class UpdateScheduledWorkflowRequest(_message.Message):
+    __slots__ = ("scheduled_workflow_id", "trigger_at", "input")
+    SCHEDULED_WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_AT_FIELD_NUMBER: _ClassVar[int]
+    INPUT_FIELD_NUMBER: _ClassVar[int]
+    scheduled_workflow_id: str
+    trigger_at: _timestamp_pb2.Timestamp
+    input: str
+    def __init__(self, scheduled_workflow_id: _Optional[str] = ..., trigger_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., input: _Optional[str] = ...) -> None: ...
+
+class DeleteScheduledWorkflowRequest(_message.Message):
+    __slots__ = ("scheduled_workflow_id",)
+    SCHEDULED_WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    scheduled_workflow_id: str
+    def __init__(self, scheduled_workflow_id: _Optional[str] = ...) -> None: ...
+
+class PreviewCronRequest(_message.Message):
+    __slots__ = ("cron", "timezone", "jitter", "workflow_name", "count")
+    CRON_FIELD_NUMBER: _ClassVar[int]
+    TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    JITTER_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_NAME_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    cron: str
+    timezone: str
+    jitter: str
+    workflow_name: str
+    count: int
+    def __init__(self, cron: _Optional[str] = ..., timezone: _Optional[str] = ..., jitter: _Optional[str] = ..., workflow_name: _Optional[str] = ..., count: _Optional[int] = ...) -> None: ...
+
+class PreviewCronResponse(_message.Message):
+    __slots__ = ("next_fire_times",)
+    NEXT_FIRE_TIMES_FIELD_NUMBER: _ClassVar[int]
+    next_fire_times: _containers.RepeatedCompositeFieldContainer[_timestamp_pb2.Timestamp]
+    def __init__(self, next_fire_times: _Optional[_Iterable[_Union[_timestamp_pb2.Timestamp, _Mapping]]] = ...) -> None: ...
+
 class ListWorkflowsResponse(_message.Message):
     __slots__ = ("workflows",)
     WORKFLOWS_FIELD_NUMBER: _ClassVar[int]
@@ -114,7 +252,7 @@ class ListWorkflowsForEventRequest(_message.Message):
     def __init__(self, event_key: _Optional[str] = ...) -> None: ...
 
 class Workflow(_message.Message):
-    __slots__ = ("id", "created_at", "updated_at", "tenant_id", "name", "description", "versions")
+    __slots__ = ("id", "created_at", "updated_at", "tenant_id", "name", "description", "versions", "is_paused")
     ID_FIELD_NUMBER: _ClassVar[int]
     CREATED_AT_FIELD_NUMBER: _ClassVar[int]
     UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
@@ -122,6 +260,7 @@ class Workflow(_message.Message):
     NAME_FIELD_NUMBER: _ClassVar[int]
     DESCRIPTION_FIELD_NUMBER: _ClassVar[int]
     VERSIONS_FIELD_NUMBER: _ClassVar[int]
+    IS_PAUSED_FIELD_NUMBER: _ClassVar[int]
     id: str
     created_at: _timestamp_pb2.Timestamp
     updated_at: _timestamp_pb2.Timestamp
@@ -129,10 +268,11 @@ class Workflow(_message.Message):
     name: str
     description: _wrappers_pb2.StringValue
     versions: _containers.RepeatedCompositeFieldContainer[WorkflowVersion]
-    def __init__(self, id: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., tenant_id: _Optional[str] = ..., name: _Optional[str] = ..., description: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., versions: _Optional[_Iterable[_Union[WorkflowVersion, _Mapping]]] = ...) -> None: ...
+    is_paused: bool
+    def __init__(self, id: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., tenant_id: _Optional[str] = ..., name: _Optional[str] = ..., description: _Optional[_Union[_wrappers_pb2.StringValue, _Mapping]] = ..., versions: _Optional[_Iterable[_Union[WorkflowVersion, _Mapping]]] = ..., is_paused: bool = ...) -> None: ...
 
 class WorkflowVersion(_message.Message):
-    __slots__ = ("id", "created_at", "updated_at", "version", "order", "workflow_id", "triggers", "jobs")
+    __slots__ = ("id", "created_at", "updated_at", "version", "order", "workflow_id", "triggers", "jobs", "diff")
     ID_FIELD_NUMBER: _ClassVar[int]
     CREATED_AT_FIELD_NUMBER: _ClassVar[int]
     UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
@@ -141,6 +281,7 @@ class WorkflowVersion(_message.Message):
     WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
     TRIGGERS_FIELD_NUMBER: _ClassVar[int]
     JOBS_FIELD_NUMBER: _ClassVar[int]
+    DIFF_FIELD_NUMBER: _ClassVar[int]
     id: str
     created_at: _timestamp_pb2.Timestamp
     updated_at: _timestamp_pb2.Timestamp
@@ -149,7 +290,36 @@ class WorkflowVersion(_message.Message):
     workflow_id: str
     triggers: WorkflowTriggers
     jobs: _containers.RepeatedCompositeFieldContainer[Job]
-    def __init__(self, id: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., version: _Optional[str] = ..., order: _Optional[int] = ..., workflow_id: _Optional[str] = ..., triggers: _Optional[_Union[WorkflowTriggers, _Mapping]] = ..., jobs: _Optional[_Iterable[_Union[Job, _Mapping]]] = ...) -> None: ...
+    diff: WorkflowVersionDiff
+    def __init__(self, id: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., version: _Optional[str] = ..., order: _Optional[int] = ..., workflow_id: _Optional[str] = ..., triggers: _Optional[_Union[WorkflowTriggers, _Mapping]] = ..., jobs: _Optional[_Iterable[_Union[Job, _Mapping]]] = ..., diff: _Optional[_Union[WorkflowVersionDiff, _Mapping]] = ...) -> None: ...
+
+class WorkflowVersionDiff(_message.Message):
+    __slots__ = ("from_version_id", "to_version_id", "changes", "raw_diff")
+    FROM_VERSION_ID_FIELD_NUMBER: _ClassVar[int]
+    TO_VERSION_ID_FIELD_NUMBER: _ClassVar[int]
+    CHANGES_FIELD_NUMBER: _ClassVar[int]
+    RAW_DIFF_FIELD_NUMBER: _ClassVar[int]
+    from_version_id: str
+    to_version_id: str
+    changes: _containers.RepeatedCompositeFieldContainer[WorkflowVersionChange]
+    raw_diff: str
+    def __init__(self, from_version_id: _Optional[str] = ..., to_version_id: _Optional[str] = ..., changes: _Optional[_Iterable[_Union[WorkflowVersionChange, _Mapping]]] = ..., raw_diff: _Optional[str] = ...) -> None: ...
+
+class WorkflowVersionChange(_message.Message):
+    __slots__ = ("type", "kind", "job", "step", "from", "to")
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    KIND_FIELD_NUMBER: _ClassVar[int]
+    JOB_FIELD_NUMBER: _ClassVar[int]
+    STEP_FIELD_NUMBER: _ClassVar[int]
+    FROM_FIELD_NUMBER: _ClassVar[int]
+    TO_FIELD_NUMBER: _ClassVar[int]
+    type: str
+    kind: str
+    job: str
+    step: str
+    from: str
+    to: str
+    def __init__(self, type: _Optional[str] = ..., kind: _Optional[str] = ..., job: _Optional[str] = ..., step: _Optional[str] = ..., from: _Optional[str] = ..., to: _Optional[str] = ...) -> None: ...
 
 class WorkflowTriggers(_message.Message):
     __slots__ = ("id", "created_at", "updated_at", "workflow_version_id", "tenant_id", "events", "crons")
@@ -178,12 +348,20 @@ class WorkflowTriggerEventRef(_message.Message):
     def __init__(self, parent_id: _Optional[str] = ..., event_key: _Optional[str] = ...) -> None: ...
 
 class WorkflowTriggerCronRef(_message.Message):
-    __slots__ = ("parent_id", "cron")
+    __slots__ = ("parent_id", "cron", "timezone", "input", "jitter", "next_fire_times")
     PARENT_ID_FIELD_NUMBER: _ClassVar[int]
     CRON_FIELD_NUMBER: _ClassVar[int]
+    TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    INPUT_FIELD_NUMBER: _ClassVar[int]
+    JITTER_FIELD_NUMBER: _ClassVar[int]
+    NEXT_FIRE_TIMES_FIELD_NUMBER: _ClassVar[int]
     parent_id: str
     cron: str
-    def __init__(self, parent_id: _Optional[str] = ..., cron: _Optional[str] = ...) -> None: ...
+    timezone: str
+    input: str
+    jitter: str
+    next_fire_times: _containers.RepeatedCompositeFieldContainer[_timestamp_pb2.Timestamp]
+    def __init__(self, parent_id: _Optional[str] = ..., cron: _Optional[str] = ..., timezone: _Optional[str] = ..., input: _Optional[str] = ..., jitter: _Optional[str] = ..., next_fire_times: _Optional[_Iterable[_Union[_timestamp_pb2.Timestamp, _Mapping]]] = ...) -> None: ...
 
 class Job(_message.Message):
     __slots__ = ("id", "created_at", "updated_at", "tenant_id", "workflow_version_id", "name", "description", "steps", "timeout")
@@ -237,6 +415,22 @@ class DeleteWorkflowRequest(_message.Message):
     workflow_id: str
     def __init__(self, workflow_id: _Optional[str] = ...) -> None: ...
 
+class PauseWorkflowRequest(_message.Message):
+    __slots__ = ("workflow_id", "queue_events")
+    WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    QUEUE_EVENTS_FIELD_NUMBER: _ClassVar[int]
+    workflow_id: str
+    queue_events: bool
+    def __init__(self, workflow_id: _Optional[str] = ..., queue_events: bool = ...) -> None: ...
+
+class ResumeWorkflowRequest(_message.Message):
+    __slots__ = ("workflow_id", "replay_events")
+    WORKFLOW_ID_FIELD_NUMBER: _ClassVar[int]
+    REPLAY_EVENTS_FIELD_NUMBER: _ClassVar[int]
+    workflow_id: str
+    replay_events: bool
+    def __init__(self, workflow_id: _Optional[str] = ..., replay_events: bool = ...) -> None: ...
+
 class GetWorkflowByNameRequest(_message.Message):
     __slots__ = ("name",)
     NAME_FIELD_NUMBER: _ClassVar[int]
@@ -50,6 +50,41 @@ class WorkflowServiceStub(object):
                 request_serializer=workflows__pb2.DeleteWorkflowRequest.SerializeToString,
                 response_deserializer=workflows__pb2.Workflow.FromString,
                 )
+        self.PauseWorkflow = channel.unary_unary(
+                '/WorkflowService/PauseWorkflow',
+                request_serializer=workflows__pb2.PauseWorkflowRequest.SerializeToString,
+                response_deserializer=workflows__pb2.Workflow.FromString,
+                )
+        self.ResumeWorkflow = channel.unary_unary(
+                '/WorkflowService/ResumeWorkflow',
+                request_serializer=workflows__pb2.ResumeWorkflowRequest.SerializeToString,
+                response_deserializer=workflows__pb2.Workflow.FromString,
+                )
+        self.ListScheduledWorkflows = channel.unary_unary(
+                '/WorkflowService/ListScheduledWorkflows',
+                request_serializer=workflows__pb2.ListScheduledWorkflowsRequest.SerializeToString,
+                response_deserializer=workflows__pb2.ListScheduledWorkflowsResponse.FromString,
+                )
+        self.GetScheduledWorkflow = channel.unary_unary(
+                '/WorkflowService/GetScheduledWorkflow',
+                request_serializer=workflows__pb2.GetScheduledWorkflowRequest.SerializeToString,
+                response_deserializer=workflows__pb2.ScheduledWorkflow.FromString,
+                )
+        self.UpdateScheduledWorkflow = channel.unary_unary(
+                '/WorkflowService/UpdateScheduledWorkflow',
+                request_serializer=workflows__pb2.UpdateScheduledWorkflowRequest.SerializeToString,
+                response_deserializer=workflows__pb2.ScheduledWorkflow.FromString,
+                )
+        self.DeleteScheduledWorkflow = channel.unary_unary(
+                '/WorkflowService/DeleteScheduledWorkflow',
+                request_serializer=workflows__pb2.DeleteScheduledWorkflowRequest.SerializeToString,
+                response_deserializer=workflows__pb2.ScheduledWorkflow.FromString,
+                )
+        self.PreviewCron = channel.unary_unary(
+                '/WorkflowService/PreviewCron',
+                request_serializer=workflows__pb2.PreviewCronRequest.SerializeToString,
+                response_deserializer=workflows__pb2.PreviewCronResponse.FromString,
+                )
 
 
 class WorkflowServiceServicer(object):
@@ -98,6 +133,48 @@ class WorkflowServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def PauseWorkflow(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResumeWorkflow(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListScheduledWorkflows(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetScheduledWorkflow(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateScheduledWorkflow(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteScheduledWorkflow(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PreviewCron(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_WorkflowServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -136,6 +213,41 @@ def add_WorkflowServiceServicer_to_server(servicer, server):
                     request_deserializer=workflows__pb2.DeleteWorkflowRequest.FromString,
                     response_serializer=workflows__pb2.Workflow.SerializeToString,
             ),
+            'PauseWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.PauseWorkflow,
+                    request_deserializer=workflows__pb2.PauseWorkflowRequest.FromString,
+                    response_serializer=workflows__pb2.Workflow.SerializeToString,
+            ),
+            'ResumeWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResumeWorkflow,
+                    request_deserializer=workflows__pb2.ResumeWorkflowRequest.FromString,
+                    response_serializer=workflows__pb2.Workflow.SerializeToString,
+            ),
+            'ListScheduledWorkflows': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListScheduledWorkflows,
+                    request_deserializer=workflows__pb2.ListScheduledWorkflowsRequest.FromString,
+                    response_serializer=workflows__pb2.ListScheduledWorkflowsResponse.SerializeToString,
+            ),
+            'GetScheduledWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetScheduledWorkflow,
+                    request_deserializer=workflows__pb2.GetScheduledWorkflowRequest.FromString,
+                    response_serializer=workflows__pb2.ScheduledWorkflow.SerializeToString,
+            ),
+            'UpdateScheduledWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateScheduledWorkflow,
+                    request_deserializer=workflows__pb2.UpdateScheduledWorkflowRequest.FromString,
+                    response_serializer=workflows__pb2.ScheduledWorkflow.SerializeToString,
+            ),
+            'DeleteScheduledWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteScheduledWorkflow,
+                    request_deserializer=workflows__pb2.DeleteScheduledWorkflowRequest.FromString,
+                    response_serializer=workflows__pb2.ScheduledWorkflow.SerializeToString,
+            ),
+            'PreviewCron': grpc.unary_unary_rpc_method_handler(
+                    servicer.PreviewCron,
+                    request_deserializer=workflows__pb2.PreviewCronRequest.FromString,
+                    response_serializer=workflows__pb2.PreviewCronResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'WorkflowService', rpc_method_handlers)
@@ -265,3 +377,122 @@ class WorkflowService(object):
             workflows__pb2.Workflow.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PauseWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/PauseWorkflow',
+            workflows__pb2.PauseWorkflowRequest.SerializeToString,
+            workflows__pb2.Workflow.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ResumeWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/ResumeWorkflow',
+            workflows__pb2.ResumeWorkflowRequest.SerializeToString,
+            workflows__pb2.Workflow.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListScheduledWorkflows(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/ListScheduledWorkflows',
+            workflows__pb2.ListScheduledWorkflowsRequest.SerializeToString,
+            workflows__pb2.ListScheduledWorkflowsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetScheduledWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/GetScheduledWorkflow',
+            workflows__pb2.GetScheduledWorkflowRequest.SerializeToString,
+            workflows__pb2.ScheduledWorkflow.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateScheduledWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/UpdateScheduledWorkflow',
+            workflows__pb2.UpdateScheduledWorkflowRequest.SerializeToString,
+            workflows__pb2.ScheduledWorkflow.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteScheduledWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/DeleteScheduledWorkflow',
+            workflows__pb2.DeleteScheduledWorkflowRequest.SerializeToString,
+            workflows__pb2.ScheduledWorkflow.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PreviewCron(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/WorkflowService/PreviewCron',
+            workflows__pb2.PreviewCronRequest.SerializeToString,
+            workflows__pb2.PreviewCronResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)